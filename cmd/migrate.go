@@ -0,0 +1,216 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/psi59/payhere-assignment/internal/db"
+	"github.com/psi59/payhere-assignment/internal/migrate"
+	"github.com/psi59/payhere-assignment/repository/mysql"
+	"github.com/psi59/payhere-assignment/repository/postgres"
+	"github.com/psi59/payhere-assignment/repository/sqlite"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagMigrationDir = "dir"
+)
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Manage database schema migrations",
+}
+
+var migrateUpCmd = &cobra.Command{
+	Use:   "up",
+	Short: "Apply all pending migrations",
+	Args:  cobra.NoArgs,
+	Run:   runMigrateUpCommand,
+}
+
+var migrateDownCmd = &cobra.Command{
+	Use:   "down N",
+	Short: "Roll back the last N applied migrations",
+	Args:  cobra.ExactArgs(1),
+	Run:   runMigrateDownCommand,
+}
+
+var migrateStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show applied and pending migrations",
+	Args:  cobra.NoArgs,
+	Run:   runMigrateStatusCommand,
+}
+
+var migrateCreateCmd = &cobra.Command{
+	Use:   "create NAME",
+	Short: "Create empty up/down migration files",
+	Long: "Create empty up/down migration files with the next version.\n" +
+		"Without --storage, files are created for every SQL storage backend(mysql, sqlite, postgres).",
+	Args: cobra.ExactArgs(1),
+	Run:  runMigrateCreateCommand,
+}
+
+func init() {
+	rootCmd.AddCommand(migrateCmd)
+	migrateCmd.AddCommand(migrateUpCmd, migrateDownCmd, migrateStatusCmd, migrateCreateCmd)
+	migrateCmd.PersistentFlags().StringP(flagConfigPath, "c", "config/server.yaml", "config file path")
+	migrateCmd.PersistentFlags().String(flagStorage, "", "storage backend(mysql, sqlite, postgres), overrides the config file")
+	migrateCreateCmd.Flags().String(flagMigrationDir, "", "migration directory, defaults to repository/<storage>/migrations")
+}
+
+func runMigrateUpCommand(cmd *cobra.Command, _ []string) {
+	ctx, migrator, err := newMigrator(cmd)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create migrator")
+	}
+
+	applied, err := migrator.Up(ctx)
+	for _, m := range applied {
+		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "applied %06d_%s\n", m.Version, m.Name)
+	}
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to apply migrations")
+	}
+	if len(applied) == 0 {
+		_, _ = fmt.Fprintln(cmd.OutOrStdout(), "no pending migrations")
+	}
+}
+
+func runMigrateDownCommand(cmd *cobra.Command, args []string) {
+	n, err := strconv.Atoi(args[0])
+	if err != nil || n < 1 {
+		log.Fatal().Str("n", args[0]).Msg("N must be a positive integer")
+	}
+	ctx, migrator, err := newMigrator(cmd)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create migrator")
+	}
+
+	reverted, err := migrator.Down(ctx, n)
+	for _, m := range reverted {
+		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "reverted %06d_%s\n", m.Version, m.Name)
+	}
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to roll back migrations")
+	}
+}
+
+func runMigrateStatusCommand(cmd *cobra.Command, _ []string) {
+	ctx, migrator, err := newMigrator(cmd)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create migrator")
+	}
+
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to get migration status")
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+	for _, status := range statuses {
+		state, appliedAt := "pending", "-"
+		if status.Applied {
+			state, appliedAt = "applied", status.AppliedAt.Format(time.RFC3339)
+		}
+		_, _ = fmt.Fprintf(w, "%06d\t%s\t%s\t%s\n", status.Version, status.Name, state, appliedAt)
+	}
+	if err := w.Flush(); err != nil {
+		log.Fatal().Err(err).Msg("failed to print migration status")
+	}
+}
+
+func runMigrateCreateCommand(cmd *cobra.Command, args []string) {
+	storage, err := cmd.Flags().GetString(flagStorage)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to get storage flag")
+	}
+	dir, err := cmd.Flags().GetString(flagMigrationDir)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to get dir flag")
+	}
+
+	dirs := []string{dir}
+	if len(dir) == 0 {
+		storages := []string{StorageMySQL, StorageSQLite, StoragePostgres}
+		if len(storage) > 0 {
+			if _, err := migrationsOf(storage); err != nil {
+				log.Fatal().Err(err).Send()
+			}
+			storages = []string{storage}
+		}
+		dirs = dirs[:0]
+		for _, storage := range storages {
+			dirs = append(dirs, filepath.Join("repository", storage, "migrations"))
+		}
+	}
+
+	for _, dir := range dirs {
+		up, down, err := migrate.Create(dir, args[0])
+		if err != nil {
+			log.Fatal().Err(err).Str("dir", dir).Msg("failed to create migration")
+		}
+		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "created %s\ncreated %s\n", up, down)
+	}
+}
+
+// newMigrator 설정 파일의 저장소에 연결된 context와 Migrator를 생성합니다.
+func newMigrator(cmd *cobra.Command) (context.Context, *migrate.Migrator, error) {
+	config, err := loadAPIServerConfigFromFlags(cmd)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	if err := config.Validate(); err != nil {
+		return nil, nil, errors.Wrap(err, "invalid config")
+	}
+	migrations, err := migrationsOf(config.storage())
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	migrator, err := migrate.New(migrations)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	ctx, err := newDBContext(cmd.Context(), config)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	return ctx, migrator, nil
+}
+
+// newDBContext 설정 파일의 저장소에 연결된 context를 생성합니다.
+// 명령어는 복제 지연의 영향을 받지 않도록 모든 쿼리를 primary로 전달합니다.
+func newDBContext(c context.Context, config APIServerConfig) (context.Context, error) {
+	dbConfig := config.DB
+	dbConfig.Driver = config.storage()
+	dbConn, err := db.Connect(dbConfig)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return db.ContextWithPrimary(db.ContextWithConn(c, dbConn)), nil
+}
+
+// migrationsOf 저장소별 마이그레이션 파일을 반환합니다.
+func migrationsOf(storage string) (fs.FS, error) {
+	switch storage {
+	case StorageMySQL:
+		return mysql.Migrations(), nil
+	case StorageSQLite:
+		return sqlite.Migrations(), nil
+	case StoragePostgres:
+		return postgres.Migrations(), nil
+	default:
+		return nil, fmt.Errorf("storage %q does not support migrations", storage)
+	}
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"os"
 	"os/signal"
@@ -12,7 +13,10 @@ import (
 
 	"github.com/psi59/payhere-assignment/usecase/authtoken"
 
+	"github.com/psi59/payhere-assignment/repository/memory"
 	"github.com/psi59/payhere-assignment/repository/mysql"
+	"github.com/psi59/payhere-assignment/repository/postgres"
+	"github.com/psi59/payhere-assignment/repository/sqlite"
 
 	"github.com/gin-contrib/requestid"
 	"github.com/gin-gonic/gin"
@@ -20,6 +24,9 @@ import (
 	"github.com/psi59/payhere-assignment/handler"
 	"github.com/psi59/payhere-assignment/internal/db"
 	"github.com/psi59/payhere-assignment/internal/ginhelper"
+	"github.com/psi59/payhere-assignment/internal/job"
+	"github.com/psi59/payhere-assignment/internal/keyring"
+	"github.com/psi59/payhere-assignment/internal/migrate"
 	"github.com/psi59/payhere-assignment/internal/valid"
 	"github.com/psi59/payhere-assignment/middleware"
 	"github.com/psi59/payhere-assignment/repository"
@@ -30,7 +37,17 @@ import (
 	"gorm.io/gorm"
 )
 
-const flagConfigPath = "config-path"
+const (
+	flagConfigPath = "config-path"
+	flagStorage    = "storage"
+)
+
+const (
+	StorageMySQL    = "mysql"
+	StorageSQLite   = "sqlite"
+	StoragePostgres = "postgres"
+	StorageMemory   = "memory"
+)
 
 // serveCmd represents the serve command
 var serveCmd = &cobra.Command{
@@ -42,15 +59,16 @@ var serveCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(serveCmd)
 	serveCmd.Flags().StringP(flagConfigPath, "c", "config/server.yaml", "config file path")
+	serveCmd.Flags().String(flagStorage, "", "storage backend(mysql, sqlite, postgres, memory), overrides the config file")
 }
 
 func runServeCommand(cmd *cobra.Command, _ []string) {
-	configPath, err := cmd.Flags().GetString(flagConfigPath)
+	config, err := loadAPIServerConfigFromFlags(cmd)
 	if err != nil {
-		log.Fatal().Err(err).Msg("failed to get config-path flag")
+		log.Fatal().Err(err).Msg("failed to load config")
 	}
 
-	apiServer, err := NewAPIServer(configPath)
+	apiServer, err := NewAPIServer(config)
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to create server instance")
 	}
@@ -68,8 +86,9 @@ type APIServer struct {
 	AuthMiddleware *middleware.AuthMiddleware
 
 	// Handlers
-	UserHandler *handler.UserHandler
-	ItemHandler *handler.ItemHandler
+	UserHandler      *handler.UserHandler
+	ItemHandler      *handler.ItemHandler
+	AuthTokenHandler *handler.AuthTokenHandler
 
 	// Usecases
 	UserUsecase      user.Usecase
@@ -79,16 +98,21 @@ type APIServer struct {
 	// Repositories
 	UserRepository           repository.UserRepository
 	TokenBlacklistRepository repository.TokenBlacklistRepository
+	RefreshTokenRepository   repository.RefreshTokenRepository
+	SessionRepository        repository.SessionRepository
 	itemRepository           repository.ItemRepository
+	itemHistoryRepository    repository.ItemHistoryRepository
 
 	// ETC
 	dbConn *gorm.DB
+	// memDB 메모리 저장소이며, 메모리 저장소를 사용하지 않으면 nil입니다.
+	memDB     *memory.DB
+	jobRunner *job.Runner
 }
 
-func NewAPIServer(configPath string) (*APIServer, error) {
-	config, err := loadAPIServerConfig(configPath)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to load config")
+func NewAPIServer(config APIServerConfig) (*APIServer, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
 	}
 
 	engine := gin.New()
@@ -100,7 +124,12 @@ func NewAPIServer(configPath string) (*APIServer, error) {
 	if err := s.initDB(); err != nil {
 		return nil, errors.WithStack(err)
 	}
-	s.initRepositories()
+	if err := s.initSchema(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err := s.initRepositories(); err != nil {
+		return nil, errors.WithStack(err)
+	}
 	if err := s.initUsecase(); err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -110,6 +139,9 @@ func NewAPIServer(configPath string) (*APIServer, error) {
 	if err := s.initMiddleware(); err != nil {
 		return nil, errors.WithStack(err)
 	}
+	if err := s.initJobs(); err != nil {
+		return nil, errors.WithStack(err)
+	}
 	s.initRoutes()
 
 	return s, nil
@@ -125,6 +157,11 @@ func (s *APIServer) Start() error {
 		}
 	}()
 
+	if err := s.jobRunner.Start(s.storageContext(context.Background())); err != nil {
+		return errors.WithStack(err)
+	}
+	defer s.jobRunner.Stop()
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(
 		quit,
@@ -134,6 +171,7 @@ func (s *APIServer) Start() error {
 		syscall.SIGQUIT,
 	)
 	sig := <-quit
+	s.jobRunner.Stop()
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
 	defer cancel()
@@ -147,40 +185,140 @@ func (s *APIServer) Start() error {
 	return nil
 }
 
+// purgeTrash 보관 기간이 지난 휴지통의 아이템을 영구 삭제합니다.
+func (s *APIServer) purgeTrash(c context.Context) error {
+	purgeOutput, err := s.ItemUsecase.PurgeTrash(c, &item.PurgeTrashInput{Retention: s.config.Trash.retention()})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if purgeOutput.PurgedCount > 0 {
+		log.Info().Int("count", purgeOutput.PurgedCount).Msg("trash purged")
+	}
+
+	return nil
+}
+
+// purgeTokenBlacklist 만료된 토큰을 블랙리스트에서 삭제합니다.
+func (s *APIServer) purgeTokenBlacklist(c context.Context) error {
+	purgeOutput, err := s.AuthTokenUsecase.PurgeBlacklist(c, &authtoken.PurgeBlacklistInput{ExpiredBefore: time.Now()})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if purgeOutput.PurgedCount > 0 {
+		log.Info().Int("count", purgeOutput.PurgedCount).Msg("token blacklist purged")
+	}
+
+	return nil
+}
+
+// refreshTokenBlacklist 다른 인스턴스에서 등록한 토큰을 반영하도록 블랙리스트 캐시를 갱신합니다.
+func (s *APIServer) refreshTokenBlacklist(c context.Context) error {
+	if _, err := s.AuthTokenUsecase.RefreshBlacklist(c); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// purgeRefreshTokens 만료된 리프레시 토큰을 삭제합니다.
+func (s *APIServer) purgeRefreshTokens(c context.Context) error {
+	purgeOutput, err := s.AuthTokenUsecase.PurgeRefreshTokens(c, &authtoken.PurgeRefreshTokensInput{ExpiredBefore: time.Now()})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if purgeOutput.PurgedCount > 0 {
+		log.Info().Int("count", purgeOutput.PurgedCount).Msg("refresh tokens purged")
+	}
+
+	return nil
+}
+
+// purgeSessions 만료된 세션을 삭제합니다.
+func (s *APIServer) purgeSessions(c context.Context) error {
+	purgeOutput, err := s.AuthTokenUsecase.PurgeSessions(c, &authtoken.PurgeSessionsInput{ExpiredBefore: time.Now()})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if purgeOutput.PurgedCount > 0 {
+		log.Info().Int("count", purgeOutput.PurgedCount).Msg("sessions purged")
+	}
+
+	return nil
+}
+
+// checkReplicas 응답하지 않는 DB 복제본을 조회 대상에서 제외합니다.
+func (s *APIServer) checkReplicas(c context.Context) error {
+	return errors.WithStack(db.CheckReplicas(c, s.dbConn))
+}
+
 func (s *APIServer) initRoutes() {
 	engine := s.engine
 	engine.GET("/docs", func(c *gin.Context) {
 		c.File(s.config.APIDoc)
 	})
+	engine.GET("/.well-known/jwks.json", s.AuthTokenHandler.JWKS)
 
 	v1 := engine.Group("/v1")
 	v1.Use(
 		requestid.New(),
 		ginhelper.ContextMiddleware(),
 		ginhelper.LoggerMiddleware(),
-		func(c *gin.Context) {
-			ctx := ginhelper.GetContext(c)
-			ctx = db.ContextWithConn(ctx, s.dbConn)
-			ginhelper.SetContext(c, ctx)
-			c.Next()
-		},
 	)
+	v1.Use(func(c *gin.Context) {
+		ginhelper.SetContext(c, s.storageContext(ginhelper.GetContext(c)))
+		c.Next()
+	})
 
 	{
 		v1User := v1.Group("/users")
 		v1User.POST("/signUp", s.UserHandler.SignUp)
 		v1User.POST("/signIn", s.UserHandler.SignIn)
 		v1User.POST("/signOut", s.AuthMiddleware.Auth(), s.UserHandler.SignOut)
+		v1User.POST("/token/refresh", s.UserHandler.RefreshToken)
+		v1User.GET("/me/preferences", s.AuthMiddleware.Auth(), s.UserHandler.GetPreferences)
+		v1User.PUT("/me/preferences", s.AuthMiddleware.Auth(), s.UserHandler.UpdatePreferences)
+		v1User.PUT("/me/password", s.AuthMiddleware.Auth(), s.UserHandler.ChangePassword)
+		v1User.GET("/me/sessions", s.AuthMiddleware.Auth(), s.UserHandler.GetSessions)
+		v1User.DELETE("/me/sessions/:sessionId", s.AuthMiddleware.Auth(), s.UserHandler.DeleteSession)
+		v1User.POST("/me/sessions/revokeAll", s.AuthMiddleware.Auth(), s.UserHandler.RevokeAllSessions)
 	}
 	{
 		v1Item := v1.Group("/items", s.AuthMiddleware.Auth())
 		v1Item.POST("/", s.ItemHandler.Create)
 		v1Item.GET("/", s.ItemHandler.Find)
+		v1Item.GET("/trash", s.ItemHandler.FindTrash)
+		v1Item.GET("/suggest", s.ItemHandler.Suggest)
+		v1Item.GET("/duplicates", s.ItemHandler.FindDuplicates)
 		v1Item.GET("/:itemId", s.ItemHandler.Get)
 		v1Item.DELETE("/:itemId", s.ItemHandler.Delete)
 		v1Item.PUT("/:itemId", s.ItemHandler.Update)
+		v1Item.POST("/:itemId/restore", s.ItemHandler.Restore)
+		v1Item.GET("/:itemId/history", s.ItemHandler.History)
+	}
+
+}
+
+func (s *APIServer) initJobs() error {
+	jobRunner := job.NewRunner()
+	jobs := []job.Job{
+		{Name: "purgeTokenBlacklist", Interval: s.config.TokenBlacklist.purgeInterval(), Run: s.purgeTokenBlacklist},
+		{Name: "refreshTokenBlacklist", Interval: s.config.TokenBlacklist.refreshInterval(), Run: s.refreshTokenBlacklist},
+		{Name: "purgeTrash", Interval: s.config.Trash.purgeInterval(), Run: s.purgeTrash},
+		{Name: "purgeRefreshTokens", Interval: s.config.AuthToken.purgeInterval(), Run: s.purgeRefreshTokens},
+		{Name: "purgeSessions", Interval: s.config.AuthToken.purgeInterval(), Run: s.purgeSessions},
+	}
+	if len(s.config.DB.Replicas) > 0 {
+		jobs = append(jobs, job.Job{Name: "checkReplicas", Interval: s.config.DB.HealthCheckInterval(), Run: s.checkReplicas})
+	}
+	for _, j := range jobs {
+		if err := jobRunner.Add(j); err != nil {
+			return errors.WithStack(err)
+		}
 	}
 
+	s.jobRunner = jobRunner
+
+	return nil
 }
 
 func (s *APIServer) initMiddleware() error {
@@ -199,27 +337,39 @@ func (s *APIServer) initHandler() error {
 	if err != nil {
 		return errors.WithStack(err)
 	}
-	itemHandler, err := handler.NewItemHandler(s.ItemUsecase)
+	itemHandler, err := handler.NewItemHandler(s.ItemUsecase, handler.ItemHandlerConfig{
+		DefaultFindLimit: s.config.ItemList.defaultLimit(),
+		MaxFindLimit:     s.config.ItemList.maxLimit(),
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	authTokenHandler, err := handler.NewAuthTokenHandler(s.AuthTokenUsecase)
 	if err != nil {
 		return errors.WithStack(err)
 	}
 
 	s.UserHandler = userHandler
 	s.ItemHandler = itemHandler
+	s.AuthTokenHandler = authTokenHandler
 
 	return nil
 }
 
 func (s *APIServer) initUsecase() error {
-	userService, err := user.NewService(s.UserRepository)
+	userService, err := user.NewService(s.UserRepository, s.RefreshTokenRepository, s.SessionRepository)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	tokenKeyring, err := s.config.AuthToken.keyring(s.config.JWTSecret)
 	if err != nil {
 		return errors.WithStack(err)
 	}
-	authTokenService, err := authtoken.NewService(s.config.JWTSecret, s.TokenBlacklistRepository)
+	authTokenService, err := authtoken.NewService(tokenKeyring, s.config.AuthToken.serviceConfig(s.config.TokenBlacklist), s.TokenBlacklistRepository, s.RefreshTokenRepository, s.SessionRepository, s.UserRepository)
 	if err != nil {
 		return errors.WithStack(err)
 	}
-	itemService, err := item.NewService(s.itemRepository)
+	itemService, err := item.NewService(s.config.ItemCursorSecret, s.itemRepository, s.itemHistoryRepository)
 	if err != nil {
 		return errors.WithStack(err)
 	}
@@ -231,18 +381,62 @@ func (s *APIServer) initUsecase() error {
 	return nil
 }
 
-func (s *APIServer) initRepositories() {
-	userRepository := mysql.NewUserRepository()
-	tokenBlacklistRepository := mysql.NewTokenBlacklistRepository()
-	itemRepository := mysql.NewItemRepository()
+func (s *APIServer) initRepositories() error {
+	switch s.config.storage() {
+	case StorageMySQL:
+		s.UserRepository = mysql.NewUserRepository()
+		s.TokenBlacklistRepository = mysql.NewTokenBlacklistRepository()
+		s.RefreshTokenRepository = mysql.NewRefreshTokenRepository()
+		s.SessionRepository = mysql.NewSessionRepository()
+		s.itemRepository = mysql.NewItemRepository()
+		s.itemHistoryRepository = mysql.NewItemHistoryRepository()
+	case StoragePostgres:
+		s.UserRepository = postgres.NewUserRepository()
+		s.TokenBlacklistRepository = postgres.NewTokenBlacklistRepository()
+		s.RefreshTokenRepository = postgres.NewRefreshTokenRepository()
+		s.SessionRepository = postgres.NewSessionRepository()
+		s.itemRepository = postgres.NewItemRepository()
+		s.itemHistoryRepository = postgres.NewItemHistoryRepository()
+	case StorageSQLite:
+		s.UserRepository = sqlite.NewUserRepository()
+		s.TokenBlacklistRepository = sqlite.NewTokenBlacklistRepository()
+		s.RefreshTokenRepository = sqlite.NewRefreshTokenRepository()
+		s.SessionRepository = sqlite.NewSessionRepository()
+		s.itemRepository = sqlite.NewItemRepository()
+		s.itemHistoryRepository = sqlite.NewItemHistoryRepository()
+	case StorageMemory:
+		s.memDB = memory.NewDB()
+		s.UserRepository = memory.NewUserRepository(s.memDB)
+		s.TokenBlacklistRepository = memory.NewTokenBlacklistRepository(s.memDB)
+		s.RefreshTokenRepository = memory.NewRefreshTokenRepository(s.memDB)
+		s.SessionRepository = memory.NewSessionRepository(s.memDB)
+		s.itemRepository = memory.NewItemRepository(s.memDB)
+		s.itemHistoryRepository = memory.NewItemHistoryRepository(s.memDB)
+	default:
+		return fmt.Errorf("undefined storage: %q", s.config.Storage)
+	}
 
-	s.UserRepository = userRepository
-	s.TokenBlacklistRepository = tokenBlacklistRepository
-	s.itemRepository = itemRepository
+	return nil
+}
+
+// storageContext 저장소가 사용할 DB 커넥션을 컨텍스트에 추가하며, 메모리 저장소는 커넥션 대신 memory.Transactor를 추가합니다.
+func (s *APIServer) storageContext(c context.Context) context.Context {
+	if s.dbConn == nil {
+		return db.ContextWithTransactor(c, memory.NewTransactor(s.memDB))
+	}
+
+	return db.ContextWithConn(c, s.dbConn)
 }
 
 func (s *APIServer) initDB() error {
-	dbConn, err := db.Connect(s.config.DB)
+	// 메모리 저장소는 DB 연결을 사용하지 않습니다.
+	if s.config.storage() == StorageMemory {
+		return nil
+	}
+
+	dbConfig := s.config.DB
+	dbConfig.Driver = s.config.storage()
+	dbConn, err := db.Connect(dbConfig)
 	if err != nil {
 		return errors.WithStack(err)
 	}
@@ -251,10 +445,311 @@ func (s *APIServer) initDB() error {
 	return nil
 }
 
+// initSchema 적용되지 않은 마이그레이션이 있으면 autoMigrate 설정에 따라 적용하거나 서버 실행을 중단합니다.
+func (s *APIServer) initSchema() error {
+	if s.dbConn == nil {
+		return nil
+	}
+
+	migrations, err := migrationsOf(s.config.storage())
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	migrator, err := migrate.New(migrations)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	ctx := db.ContextWithPrimary(db.ContextWithConn(context.Background(), s.dbConn))
+	pending, err := migrator.Pending(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	if !s.config.AutoMigrate {
+		return fmt.Errorf("%d pending migrations, run `payhere migrate up` or enable autoMigrate", len(pending))
+	}
+
+	applied, err := migrator.Up(ctx)
+	for _, m := range applied {
+		log.Info().Int("version", m.Version).Str("name", m.Name).Msg("migration applied")
+	}
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
 type APIServerConfig struct {
-	APIDoc    string    `yaml:"apiDoc"`
-	JWTSecret string    `yaml:"jwtSecret"`
-	DB        db.Config `yaml:"db"`
+	APIDoc    string `yaml:"apiDoc"`
+	JWTSecret string `yaml:"jwtSecret"`
+	// ItemCursorSecret 아이템 목록 커서의 서명 키이며, jwtSecret과 다른 키를 사용해야 합니다.
+	ItemCursorSecret string    `yaml:"itemCursorSecret" validate:"required"`
+	Storage          string    `yaml:"storage" validate:"omitempty,oneof=mysql sqlite postgres memory"`
+	DB               db.Config `yaml:"db"`
+	// AutoMigrate 서버 시작 시 적용되지 않은 마이그레이션을 자동으로 적용합니다.
+	AutoMigrate    bool                 `yaml:"autoMigrate"`
+	Trash          TrashConfig          `yaml:"trash"`
+	TokenBlacklist TokenBlacklistConfig `yaml:"tokenBlacklist"`
+	AuthToken      AuthTokenConfig      `yaml:"authToken"`
+	ItemList       ItemListConfig       `yaml:"itemList"`
+}
+
+const (
+	defaultTrashRetention     = 30 * 24 * time.Hour
+	defaultTrashPurgeInterval = time.Hour
+
+	defaultTokenBlacklistPurgeInterval   = time.Hour
+	defaultTokenBlacklistRefreshInterval = 10 * time.Second
+	defaultTokenBlacklistCacheSize       = 10000
+
+	defaultAccessTokenTTL            = 30 * time.Minute
+	defaultRefreshTokenTTL           = 14 * 24 * time.Hour
+	defaultRefreshTokenPurgeInterval = time.Hour
+	defaultHMACKeyID                 = "default"
+	defaultTokenIssuer               = "payhere-assignment"
+	defaultTokenAudience             = "payhere-assignment-api"
+	defaultTokenLeeway               = 30 * time.Second
+
+	defaultItemListLimit    = 10
+	defaultItemListMaxLimit = 100
+)
+
+// TrashConfig 휴지통 설정입니다.
+type TrashConfig struct {
+	// Retention 휴지통 보관 기간이며, 보관 기간이 지난 아이템은 영구 삭제됩니다.
+	Retention time.Duration `yaml:"retention" validate:"gte=0"`
+	// PurgeInterval 보관 기간이 지난 아이템을 영구 삭제하는 주기입니다.
+	PurgeInterval time.Duration `yaml:"purgeInterval" validate:"gte=0"`
+}
+
+func (c TrashConfig) retention() time.Duration {
+	if c.Retention == 0 {
+		return defaultTrashRetention
+	}
+
+	return c.Retention
+}
+
+func (c TrashConfig) purgeInterval() time.Duration {
+	if c.PurgeInterval == 0 {
+		return defaultTrashPurgeInterval
+	}
+
+	return c.PurgeInterval
+}
+
+// TokenBlacklistConfig 토큰 블랙리스트 설정입니다.
+type TokenBlacklistConfig struct {
+	// PurgeInterval 만료된 토큰을 블랙리스트에서 삭제하는 주기입니다.
+	PurgeInterval time.Duration `yaml:"purgeInterval" validate:"gte=0"`
+	// RefreshInterval 다른 인스턴스에서 등록한 토큰을 반영하도록 블랙리스트 캐시를 갱신하는 주기입니다.
+	RefreshInterval time.Duration `yaml:"refreshInterval" validate:"gte=0"`
+	// CacheSize 블랙리스트 조회 결과를 캐시하는 최대 토큰 수입니다.
+	CacheSize int `yaml:"cacheSize" validate:"gte=0"`
+}
+
+func (c TokenBlacklistConfig) purgeInterval() time.Duration {
+	if c.PurgeInterval == 0 {
+		return defaultTokenBlacklistPurgeInterval
+	}
+
+	return c.PurgeInterval
+}
+
+func (c TokenBlacklistConfig) refreshInterval() time.Duration {
+	if c.RefreshInterval == 0 {
+		return defaultTokenBlacklistRefreshInterval
+	}
+
+	return c.RefreshInterval
+}
+
+// maxStaleness 블랙리스트 캐시를 갱신하지 못했을 때 캐시를 사용하는 최대 기간이며, 한 번의 갱신 실패는 허용합니다.
+func (c TokenBlacklistConfig) maxStaleness() time.Duration {
+	return 2 * c.refreshInterval()
+}
+
+func (c TokenBlacklistConfig) cacheSize() int {
+	if c.CacheSize == 0 {
+		return defaultTokenBlacklistCacheSize
+	}
+
+	return c.CacheSize
+}
+
+// AuthTokenConfig 액세스 토큰과 리프레시 토큰 설정입니다.
+type AuthTokenConfig struct {
+	// AccessTokenTTL 액세스 토큰의 유효 기간입니다.
+	AccessTokenTTL time.Duration `yaml:"accessTokenTTL" validate:"gte=0"`
+	// RefreshTokenTTL 리프레시 토큰의 유효 기간이며, 액세스 토큰의 유효 기간보다 길어야 합니다.
+	RefreshTokenTTL time.Duration `yaml:"refreshTokenTTL" validate:"gte=0"`
+	// PurgeInterval 만료된 리프레시 토큰과 세션을 삭제하는 주기입니다.
+	PurgeInterval time.Duration `yaml:"purgeInterval" validate:"gte=0"`
+	// SigningKeys 액세스 토큰 서명 키(ES256, EdDSA) 목록이며, 비어 있으면 jwtSecret으로 HS256 서명합니다.
+	SigningKeys []SigningKeyConfig `yaml:"signingKeys" validate:"dive"`
+	// KeyRotationWindow 폐기한 키로 서명된 토큰을 계속 검증하는 기간이며, 기본값은 accessTokenTTL입니다.
+	KeyRotationWindow time.Duration `yaml:"keyRotationWindow" validate:"gte=0"`
+	// Issuer 액세스 토큰의 iss입니다.
+	Issuer string `yaml:"issuer"`
+	// Audience 액세스 토큰의 aud이며, 토큰을 검증하는 다른 서비스도 같은 값을 확인해야 합니다.
+	Audience string `yaml:"audience"`
+	// Leeway 서버 간 시각 차이를 고려해 토큰 검증에 허용하는 오차입니다.
+	Leeway time.Duration `yaml:"leeway" validate:"gte=0"`
+}
+
+// SigningKeyConfig 액세스 토큰 서명 키 설정입니다.
+type SigningKeyConfig struct {
+	// ID JWT 헤더의 kid입니다.
+	ID string `yaml:"id" validate:"required"`
+	// PEMFile P-256 또는 Ed25519 키의 PEM 파일 경로이며, 공개키만 있는 경우 검증에만 사용합니다.
+	PEMFile string `yaml:"pemFile" validate:"required"`
+	// ActivatedAt 서명에 사용하기 시작하는 시각이며, 그 전에는 JWKS로 공개만 합니다.
+	ActivatedAt time.Time `yaml:"activatedAt"`
+	// RetiredAt 서명을 중단하는 시각이며, 이후 keyRotationWindow 동안 검증에만 사용합니다.
+	RetiredAt time.Time `yaml:"retiredAt"`
+}
+
+func (c AuthTokenConfig) accessTokenTTL() time.Duration {
+	if c.AccessTokenTTL == 0 {
+		return defaultAccessTokenTTL
+	}
+
+	return c.AccessTokenTTL
+}
+
+func (c AuthTokenConfig) refreshTokenTTL() time.Duration {
+	if c.RefreshTokenTTL == 0 {
+		return defaultRefreshTokenTTL
+	}
+
+	return c.RefreshTokenTTL
+}
+
+func (c AuthTokenConfig) purgeInterval() time.Duration {
+	if c.PurgeInterval == 0 {
+		return defaultRefreshTokenPurgeInterval
+	}
+
+	return c.PurgeInterval
+}
+
+func (c AuthTokenConfig) issuer() string {
+	if len(c.Issuer) == 0 {
+		return defaultTokenIssuer
+	}
+
+	return c.Issuer
+}
+
+func (c AuthTokenConfig) audience() string {
+	if len(c.Audience) == 0 {
+		return defaultTokenAudience
+	}
+
+	return c.Audience
+}
+
+func (c AuthTokenConfig) leeway() time.Duration {
+	if c.Leeway == 0 {
+		return defaultTokenLeeway
+	}
+
+	return c.Leeway
+}
+
+// serviceConfig authtoken.Service 설정을 반환합니다.
+func (c AuthTokenConfig) serviceConfig(blacklist TokenBlacklistConfig) authtoken.Config {
+	return authtoken.Config{
+		AccessTokenTTL:        c.accessTokenTTL(),
+		RefreshTokenTTL:       c.refreshTokenTTL(),
+		Issuer:                c.issuer(),
+		Audience:              c.audience(),
+		Leeway:                c.leeway(),
+		BlacklistCacheSize:    blacklist.cacheSize(),
+		BlacklistMaxStaleness: blacklist.maxStaleness(),
+	}
+}
+
+func (c AuthTokenConfig) keyRotationWindow() time.Duration {
+	if c.KeyRotationWindow == 0 {
+		return c.accessTokenTTL()
+	}
+
+	return c.KeyRotationWindow
+}
+
+// keyring 설정된 서명 키로 Keyring을 생성합니다.
+func (c AuthTokenConfig) keyring(jwtSecret string) (*keyring.Keyring, error) {
+	if len(c.SigningKeys) == 0 {
+		key, err := keyring.NewHMACKey(defaultHMACKeyID, []byte(jwtSecret))
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		return keyring.New([]*keyring.Key{key}, c.keyRotationWindow())
+	}
+
+	keys := make([]*keyring.Key, 0, len(c.SigningKeys))
+	for _, keyConfig := range c.SigningKeys {
+		key, err := keyring.LoadKey(keyConfig.ID, keyConfig.PEMFile)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		key.ActivatedAt, key.RetiredAt = keyConfig.ActivatedAt, keyConfig.RetiredAt
+		keys = append(keys, key)
+	}
+
+	return keyring.New(keys, c.keyRotationWindow())
+}
+
+// ItemListConfig 아이템 목록 조회 설정입니다.
+type ItemListConfig struct {
+	// DefaultLimit limit 쿼리 파라메터를 생략한 경우 조회할 아이템 수입니다.
+	DefaultLimit int `yaml:"defaultLimit" validate:"gte=0"`
+	// MaxLimit limit 쿼리 파라메터의 최댓값입니다.
+	MaxLimit int `yaml:"maxLimit" validate:"gte=0"`
+}
+
+func (c ItemListConfig) defaultLimit() int {
+	if c.DefaultLimit == 0 {
+		return defaultItemListLimit
+	}
+
+	return c.DefaultLimit
+}
+
+func (c ItemListConfig) maxLimit() int {
+	if c.MaxLimit == 0 {
+		return defaultItemListMaxLimit
+	}
+
+	return c.MaxLimit
+}
+
+// loadAPIServerConfigFromFlags config-path 플래그의 설정 파일을 읽고 storage 플래그를 반영합니다.
+func loadAPIServerConfigFromFlags(cmd *cobra.Command) (APIServerConfig, error) {
+	configPath, err := cmd.Flags().GetString(flagConfigPath)
+	if err != nil {
+		return APIServerConfig{}, errors.Wrap(err, "failed to get config-path flag")
+	}
+	storage, err := cmd.Flags().GetString(flagStorage)
+	if err != nil {
+		return APIServerConfig{}, errors.Wrap(err, "failed to get storage flag")
+	}
+
+	config, err := loadAPIServerConfig(configPath)
+	if err != nil {
+		return APIServerConfig{}, errors.WithStack(err)
+	}
+	if len(storage) > 0 {
+		config.Storage = storage
+	}
+
+	return config, nil
 }
 
 func loadAPIServerConfig(configPath string) (config APIServerConfig, err error) {
@@ -271,14 +766,31 @@ func loadAPIServerConfig(configPath string) (config APIServerConfig, err error)
 		err = errors.WithStack(decodeErr)
 		return
 	}
-	if validateErr := config.Validate(); validateErr != nil {
-		err = errors.WithStack(validateErr)
-		return
-	}
 
 	return
 }
 
 func (c APIServerConfig) Validate() error {
-	return errors.WithStack(valid.ValidateStruct(c))
+	if err := valid.ValidateStruct(c); err != nil {
+		return errors.WithStack(err)
+	}
+	// signingKeys가 없으면 jwtSecret으로 서명하므로 서버 시작 전에 확인합니다.
+	if len(c.AuthToken.SigningKeys) == 0 && len(c.JWTSecret) == 0 {
+		return fmt.Errorf("jwtSecret is required when authToken.signingKeys is empty")
+	}
+	// 커서 서명 키가 노출되어도 액세스 토큰을 위조할 수 없도록 서로 다른 키를 사용합니다.
+	if c.ItemCursorSecret == c.JWTSecret {
+		return fmt.Errorf("itemCursorSecret must differ from jwtSecret")
+	}
+
+	return nil
+}
+
+// storage 설정된 저장소를 반환하며, 설정되지 않은 경우 MySQL을 사용합니다.
+func (c APIServerConfig) storage() string {
+	if len(c.Storage) == 0 {
+		return StorageMySQL
+	}
+
+	return c.Storage
 }
@@ -0,0 +1,209 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/psi59/payhere-assignment/repository"
+	"github.com/psi59/payhere-assignment/repository/mysql"
+	"github.com/psi59/payhere-assignment/repository/postgres"
+	"github.com/psi59/payhere-assignment/repository/sqlite"
+	"github.com/psi59/payhere-assignment/usecase/authtoken"
+	"github.com/psi59/payhere-assignment/usecase/user"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+var tokensCmd = &cobra.Command{
+	Use:   "tokens",
+	Short: "Manage auth tokens",
+}
+
+var tokensPurgeCmd = &cobra.Command{
+	Use:   "purge",
+	Short: "Delete expired tokens from the token blacklist, refresh tokens and sessions",
+	Args:  cobra.NoArgs,
+	Run:   runTokensPurgeCommand,
+}
+
+var tokensRevokeCmd = &cobra.Command{
+	Use:   "revoke",
+	Short: "Invalidate every token and session of a user, e.g. when the account is compromised",
+	Args:  cobra.NoArgs,
+	Run:   runTokensRevokeCommand,
+}
+
+const flagUserID = "user-id"
+
+func init() {
+	rootCmd.AddCommand(tokensCmd)
+	tokensCmd.AddCommand(tokensPurgeCmd)
+	tokensCmd.AddCommand(tokensRevokeCmd)
+	tokensRevokeCmd.Flags().Int(flagUserID, 0, "id of the user whose tokens are invalidated")
+	_ = tokensRevokeCmd.MarkFlagRequired(flagUserID)
+	tokensCmd.PersistentFlags().StringP(flagConfigPath, "c", "config/server.yaml", "config file path")
+	tokensCmd.PersistentFlags().String(flagStorage, "", "storage backend(mysql, sqlite, postgres), overrides the config file")
+}
+
+func runTokensPurgeCommand(cmd *cobra.Command, _ []string) {
+	config, err := loadAPIServerConfigFromFlags(cmd)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to load config")
+	}
+	if err := config.Validate(); err != nil {
+		log.Fatal().Err(err).Msg("invalid config")
+	}
+	authTokenService := newTokensAuthTokenService(config)
+	ctx, err := newDBContext(cmd.Context(), config)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to connect database")
+	}
+
+	purgeOutput, err := authTokenService.PurgeBlacklist(ctx, &authtoken.PurgeBlacklistInput{ExpiredBefore: time.Now()})
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to purge token blacklist")
+	}
+	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "purged %d expired tokens\n", purgeOutput.PurgedCount)
+
+	purgeRefreshTokensOutput, err := authTokenService.PurgeRefreshTokens(ctx, &authtoken.PurgeRefreshTokensInput{ExpiredBefore: time.Now()})
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to purge refresh tokens")
+	}
+	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "purged %d expired refresh tokens\n", purgeRefreshTokensOutput.PurgedCount)
+
+	purgeSessionsOutput, err := authTokenService.PurgeSessions(ctx, &authtoken.PurgeSessionsInput{ExpiredBefore: time.Now()})
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to purge sessions")
+	}
+	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "purged %d expired sessions\n", purgeSessionsOutput.PurgedCount)
+}
+
+func runTokensRevokeCommand(cmd *cobra.Command, _ []string) {
+	userID, err := cmd.Flags().GetInt(flagUserID)
+	if err != nil {
+		log.Fatal().Err(err).Send()
+	}
+	config, err := loadAPIServerConfigFromFlags(cmd)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to load config")
+	}
+	if err := config.Validate(); err != nil {
+		log.Fatal().Err(err).Msg("invalid config")
+	}
+	userRepository, err := userRepositoryOf(config.storage())
+	if err != nil {
+		log.Fatal().Err(err).Send()
+	}
+	refreshTokenRepository, err := refreshTokenRepositoryOf(config.storage())
+	if err != nil {
+		log.Fatal().Err(err).Send()
+	}
+	sessionRepository, err := sessionRepositoryOf(config.storage())
+	if err != nil {
+		log.Fatal().Err(err).Send()
+	}
+	userService, err := user.NewService(userRepository, refreshTokenRepository, sessionRepository)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create user service")
+	}
+	ctx, err := newDBContext(cmd.Context(), config)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to connect database")
+	}
+
+	getUserOutput, err := userService.Get(ctx, &user.GetInput{UserID: userID})
+	if err != nil {
+		log.Fatal().Err(err).Int("userID", userID).Msg("failed to get user")
+	}
+	invalidateOutput, err := userService.InvalidateTokens(ctx, &user.InvalidateTokensInput{User: getUserOutput.User})
+	if err != nil {
+		log.Fatal().Err(err).Int("userID", userID).Msg("failed to invalidate tokens")
+	}
+	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "invalidated tokens of user %d and revoked %d sessions\n", userID, invalidateOutput.RevokedSessionCount)
+}
+
+// newTokensAuthTokenService tokens 명령에서 사용할 authtoken.Service를 생성합니다.
+func newTokensAuthTokenService(config APIServerConfig) *authtoken.Service {
+	tokenBlacklistRepository, err := tokenBlacklistRepositoryOf(config.storage())
+	if err != nil {
+		log.Fatal().Err(err).Send()
+	}
+	refreshTokenRepository, err := refreshTokenRepositoryOf(config.storage())
+	if err != nil {
+		log.Fatal().Err(err).Send()
+	}
+	sessionRepository, err := sessionRepositoryOf(config.storage())
+	if err != nil {
+		log.Fatal().Err(err).Send()
+	}
+	userRepository, err := userRepositoryOf(config.storage())
+	if err != nil {
+		log.Fatal().Err(err).Send()
+	}
+	tokenKeyring, err := config.AuthToken.keyring(config.JWTSecret)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to load signing keys")
+	}
+	authTokenService, err := authtoken.NewService(tokenKeyring, config.AuthToken.serviceConfig(config.TokenBlacklist), tokenBlacklistRepository, refreshTokenRepository, sessionRepository, userRepository)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create auth token service")
+	}
+
+	return authTokenService
+}
+
+// userRepositoryOf 저장소별 UserRepository를 반환합니다.
+func userRepositoryOf(storage string) (repository.UserRepository, error) {
+	switch storage {
+	case StorageMySQL:
+		return mysql.NewUserRepository(), nil
+	case StorageSQLite:
+		return sqlite.NewUserRepository(), nil
+	case StoragePostgres:
+		return postgres.NewUserRepository(), nil
+	default:
+		return nil, fmt.Errorf("storage %q does not support revoking tokens", storage)
+	}
+}
+
+// tokenBlacklistRepositoryOf 저장소별 TokenBlacklistRepository를 반환합니다.
+func tokenBlacklistRepositoryOf(storage string) (repository.TokenBlacklistRepository, error) {
+	switch storage {
+	case StorageMySQL:
+		return mysql.NewTokenBlacklistRepository(), nil
+	case StorageSQLite:
+		return sqlite.NewTokenBlacklistRepository(), nil
+	case StoragePostgres:
+		return postgres.NewTokenBlacklistRepository(), nil
+	default:
+		return nil, fmt.Errorf("storage %q does not support purging tokens", storage)
+	}
+}
+
+// refreshTokenRepositoryOf 저장소별 RefreshTokenRepository를 반환합니다.
+func refreshTokenRepositoryOf(storage string) (repository.RefreshTokenRepository, error) {
+	switch storage {
+	case StorageMySQL:
+		return mysql.NewRefreshTokenRepository(), nil
+	case StorageSQLite:
+		return sqlite.NewRefreshTokenRepository(), nil
+	case StoragePostgres:
+		return postgres.NewRefreshTokenRepository(), nil
+	default:
+		return nil, fmt.Errorf("storage %q does not support purging tokens", storage)
+	}
+}
+
+// sessionRepositoryOf 저장소별 SessionRepository를 반환합니다.
+func sessionRepositoryOf(storage string) (repository.SessionRepository, error) {
+	switch storage {
+	case StorageMySQL:
+		return mysql.NewSessionRepository(), nil
+	case StorageSQLite:
+		return sqlite.NewSessionRepository(), nil
+	case StoragePostgres:
+		return postgres.NewSessionRepository(), nil
+	default:
+		return nil, fmt.Errorf("storage %q does not support purging tokens", storage)
+	}
+}
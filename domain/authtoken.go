@@ -5,16 +5,18 @@ import (
 	"time"
 )
 
+// AuthToken 블랙리스트에 등록된 액세스 토큰입니다.
 type AuthToken struct {
-	Token     string
+	// ID 토큰의 jti입니다.
+	ID        string
 	ExpiresAt time.Time
 }
 
 const ErrNilAuthToken ConstantError = "nil AuthToken"
 
 func (t *AuthToken) Validate() error {
-	if len(t.Token) == 0 {
-		return fmt.Errorf("empty token")
+	if len(t.ID) == 0 {
+		return fmt.Errorf("empty id")
 	}
 	if t.ExpiresAt.IsZero() {
 		return fmt.Errorf("zero expiresAt")
@@ -1,5 +1,26 @@
 package domain
 
+import "context"
+
+type (
+	ctxKeyRequestID struct{}
+	ctxKeySessionID struct{}
+)
+
 var (
 	CtxKeyUser = struct{}{}
+	// CtxKeyRequestID CtxKeyUser와 키가 겹치지 않도록 별도의 타입을 사용합니다.
+	CtxKeyRequestID = ctxKeyRequestID{}
+	// CtxKeySessionID 인증에 사용한 액세스 토큰의 세션 아이디입니다.
+	CtxKeySessionID = ctxKeySessionID{}
 )
+
+// RequestIDFromContext 컨텍스트에 저장된 요청 ID를 반환하며, 없는 경우 빈 문자열을 반환합니다.
+func RequestIDFromContext(c context.Context) string {
+	if c == nil {
+		return ""
+	}
+	requestID, _ := c.Value(CtxKeyRequestID).(string)
+
+	return requestID
+}
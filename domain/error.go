@@ -12,6 +12,26 @@ const (
 	ErrUserAlreadyExists           ConstantError = "UserAlreadyExists"
 	ErrTokenBlacklistAlreadyExists ConstantError = "TokenBlacklistAlreadyExists"
 	ErrItemAlreadyExists           ConstantError = "ItemAlreadyExists"
+	ErrItemVersionMismatch         ConstantError = "ItemVersionMismatch"
+	ErrInvalidItemCursor           ConstantError = "InvalidItemCursor"
+	ErrInvalidItemQuery            ConstantError = "InvalidItemQuery"
+	ErrSimilarItemExists           ConstantError = "SimilarItemExists"
+	ErrRefreshTokenNotFound        ConstantError = "RefreshTokenNotFound"
+	ErrRefreshTokenAlreadyRotated  ConstantError = "RefreshTokenAlreadyRotated"
+	ErrInvalidRefreshToken         ConstantError = "InvalidRefreshToken"
+	ErrRefreshTokenReused          ConstantError = "RefreshTokenReused"
+	ErrMalformedToken              ConstantError = "MalformedToken"
+	ErrTokenAlgorithmNotAllowed    ConstantError = "TokenAlgorithmNotAllowed"
+	ErrUnknownTokenKey             ConstantError = "UnknownTokenKey"
+	ErrInvalidTokenSignature       ConstantError = "InvalidTokenSignature"
+	ErrInvalidTokenIssuer          ConstantError = "InvalidTokenIssuer"
+	ErrInvalidTokenAudience        ConstantError = "InvalidTokenAudience"
+	ErrTokenIssuedInFuture         ConstantError = "TokenIssuedInFuture"
+	ErrTokenNotYetValid            ConstantError = "TokenNotYetValid"
+	ErrTokenClaimMissing           ConstantError = "TokenClaimMissing"
+	ErrSessionNotFound             ConstantError = "SessionNotFound"
+	ErrSessionRevoked              ConstantError = "SessionRevoked"
+	ErrTokenInvalidated            ConstantError = "TokenInvalidated"
 )
 
 type ConstantError string
@@ -21,6 +21,10 @@ type Item struct {
 	ExpiryAt    time.Time `validate:"required"`
 	Size        ItemSize  `validate:"required"`
 	CreatedAt   time.Time `validate:"required"`
+	// Version 낙관적 동시성 제어를 위한 버전이며, 아이템이 변경될 때마다 1씩 증가합니다.
+	Version int
+	// DeletedAt 휴지통으로 이동된 시각이며, 삭제되지 않은 아이템은 nil 입니다.
+	DeletedAt *time.Time
 }
 
 const ErrNilItem ConstantError = "nil Item"
@@ -67,6 +71,7 @@ func NewItem(
 		ExpiryAt:    expiryAt,
 		Size:        size,
 		CreatedAt:   time.Now(),
+		Version:     1,
 	}
 
 	return item, nil
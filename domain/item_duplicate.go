@@ -0,0 +1,39 @@
+package domain
+
+// ItemDuplicateReason 두 아이템의 이름이 비슷하다고 판단한 이유이며, 앞에 있을수록 같은 아이템일 가능성이 높습니다.
+type ItemDuplicateReason string
+
+const (
+	// ItemDuplicateReasonExact 공백, 유니코드 정규화 형식(NFC/NFD), 대소문자를 무시하면 이름이 같은 경우입니다.
+	ItemDuplicateReasonExact ItemDuplicateReason = "exact"
+	// ItemDuplicateReasonChosung 이름의 초성이 같고 자모 단위의 편집 거리가 가까운 경우입니다.
+	ItemDuplicateReasonChosung ItemDuplicateReason = "chosung"
+	// ItemDuplicateReasonSimilar 이름의 자모 단위 편집 거리가 가까운 경우입니다.
+	ItemDuplicateReasonSimilar ItemDuplicateReason = "similar"
+)
+
+// Rank 같은 아이템일 가능성이 높을수록 큰 값을 반환합니다.
+func (r ItemDuplicateReason) Rank() int {
+	switch r {
+	case ItemDuplicateReasonExact:
+		return 3
+	case ItemDuplicateReasonChosung:
+		return 2
+	case ItemDuplicateReasonSimilar:
+		return 1
+	}
+
+	return 0
+}
+
+// SimilarItem 생성, 수정하려는 아이템과 이름이 비슷한 기존 아이템입니다.
+type SimilarItem struct {
+	Item   Item
+	Reason ItemDuplicateReason
+}
+
+// ItemDuplicateCluster 서로 이름이 비슷한 아이템 묶음이며, Reason은 묶음 안에서 가장 가까운 이유입니다.
+type ItemDuplicateCluster struct {
+	Items  []Item
+	Reason ItemDuplicateReason
+}
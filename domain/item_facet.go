@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"cmp"
+	"slices"
+	"sort"
+)
+
+// ItemPriceBucketBounds 가격 구간의 경계이며, 각 구간은 하한을 포함하고 상한을 포함하지 않습니다.
+// 첫 구간은 0부터, 마지막 구간은 상한 없이 마지막 경계부터입니다.
+var ItemPriceBucketBounds = []int{1000, 3000, 5000, 10000, 20000}
+
+// ItemPriceBucketIndex 가격이 속한 구간의 위치를 반환합니다.
+func ItemPriceBucketIndex(price int) int {
+	return sort.SearchInts(ItemPriceBucketBounds, price+1)
+}
+
+// ItemFacets 조회 조건을 만족하는 아이템의 카테고리, 크기, 가격 구간별 아이템 수입니다.
+type ItemFacets struct {
+	// Categories 아이템이 있는 카테고리만 아이템 수가 많은 순으로 포함합니다.
+	Categories []ItemFacetCount
+	// Sizes, PriceBuckets 아이템이 없는 값도 정해진 순서로 모두 포함합니다.
+	Sizes        []ItemFacetCount
+	PriceBuckets []ItemPriceBucket
+}
+
+type ItemFacetCount struct {
+	Value string
+	Count int
+}
+
+type ItemPriceBucket struct {
+	Min int
+	// Max 구간의 상한이며, nil이면 상한이 없습니다.
+	Max   *int
+	Count int
+}
+
+// ItemFacetCounter 아이템 수를 세어 ItemFacets를 만듭니다.
+type ItemFacetCounter struct {
+	categories   map[string]int
+	sizes        map[ItemSize]int
+	priceBuckets []int
+}
+
+func NewItemFacetCounter() *ItemFacetCounter {
+	return &ItemFacetCounter{
+		categories:   make(map[string]int),
+		sizes:        make(map[ItemSize]int),
+		priceBuckets: make([]int, len(ItemPriceBucketBounds)+1),
+	}
+}
+
+func (c *ItemFacetCounter) Add(item *Item) {
+	c.AddCategory(item.Category, 1)
+	c.AddSize(item.Size, 1)
+	c.AddPriceBucket(ItemPriceBucketIndex(item.Price), 1)
+}
+
+func (c *ItemFacetCounter) AddCategory(category string, count int) {
+	c.categories[category] += count
+}
+
+func (c *ItemFacetCounter) AddSize(size ItemSize, count int) {
+	c.sizes[size] += count
+}
+
+// AddPriceBucket index는 ItemPriceBucketIndex로 구한 구간의 위치이며, 범위를 벗어나면 무시합니다.
+func (c *ItemFacetCounter) AddPriceBucket(index, count int) {
+	if index < 0 || index >= len(c.priceBuckets) {
+		return
+	}
+	c.priceBuckets[index] += count
+}
+
+func (c *ItemFacetCounter) Facets() *ItemFacets {
+	facets := &ItemFacets{
+		Categories:   make([]ItemFacetCount, 0, len(c.categories)),
+		Sizes:        make([]ItemFacetCount, 0, 2),
+		PriceBuckets: make([]ItemPriceBucket, len(c.priceBuckets)),
+	}
+	for category, count := range c.categories {
+		facets.Categories = append(facets.Categories, ItemFacetCount{Value: category, Count: count})
+	}
+	slices.SortFunc(facets.Categories, func(a, b ItemFacetCount) int {
+		if a.Count != b.Count {
+			return b.Count - a.Count
+		}
+		return cmp.Compare(a.Value, b.Value)
+	})
+	for _, size := range []ItemSize{ItemSizeSmall, ItemSizeLarge} {
+		facets.Sizes = append(facets.Sizes, ItemFacetCount{Value: string(size), Count: c.sizes[size]})
+	}
+	for i, count := range c.priceBuckets {
+		bucket := ItemPriceBucket{Count: count}
+		if i > 0 {
+			bucket.Min = ItemPriceBucketBounds[i-1]
+		}
+		if i < len(ItemPriceBucketBounds) {
+			upper := ItemPriceBucketBounds[i]
+			bucket.Max = &upper
+		}
+		facets.PriceBuckets[i] = bucket
+	}
+
+	return facets
+}
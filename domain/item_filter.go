@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/psi59/payhere-assignment/internal/valid"
+)
+
+// MaxItemFilterCategories 한 번에 조회할 수 있는 최대 카테고리 수입니다.
+const MaxItemFilterCategories = 20
+
+// ItemFilter 아이템 목록 조회 조건이며, 설정되지 않은 조건은 적용하지 않습니다.
+// 범위 조건의 최솟값, 최댓값과 After는 경계를 포함하고, Before는 경계를 포함하지 않습니다.
+type ItemFilter struct {
+	// Categories 카테고리 중 하나와 일치하는 아이템을 조회합니다.
+	Categories []string `validate:"max=20,dive,min=1,max=100"`
+	Size       ItemSize `validate:"omitempty,oneof=small large"`
+	MinPrice   *int     `validate:"omitempty,gte=0"`
+	MaxPrice   *int     `validate:"omitempty,gte=0"`
+	MinCost    *int     `validate:"omitempty,gte=0"`
+	MaxCost    *int     `validate:"omitempty,gte=0"`
+	// ExpiresAfter 유통기한이 ExpiresAfter 이후인 아이템을 조회합니다.
+	ExpiresAfter *time.Time
+	// ExpiresBefore 유통기한이 ExpiresBefore 이전인 아이템을 조회합니다.
+	ExpiresBefore *time.Time
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	// Barcode 바코드가 정확히 일치하는 아이템을 조회합니다.
+	Barcode string `validate:"max=100"`
+}
+
+func (f *ItemFilter) Validate() error {
+	if err := valid.ValidateStruct(f); err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch {
+	case f.MinPrice != nil && f.MaxPrice != nil && *f.MinPrice > *f.MaxPrice:
+		return fmt.Errorf("minPrice(%d) > maxPrice(%d)", *f.MinPrice, *f.MaxPrice)
+	case f.MinCost != nil && f.MaxCost != nil && *f.MinCost > *f.MaxCost:
+		return fmt.Errorf("minCost(%d) > maxCost(%d)", *f.MinCost, *f.MaxCost)
+	case f.ExpiresAfter != nil && f.ExpiresBefore != nil && !f.ExpiresAfter.Before(*f.ExpiresBefore):
+		return fmt.Errorf("expiresAfter(%s) >= expiresBefore(%s)", f.ExpiresAfter, f.ExpiresBefore)
+	case f.CreatedAfter != nil && f.CreatedBefore != nil && !f.CreatedAfter.Before(*f.CreatedBefore):
+		return fmt.Errorf("createdAfter(%s) >= createdBefore(%s)", f.CreatedAfter, f.CreatedBefore)
+	}
+
+	return nil
+}
+
+// Match 아이템이 모든 조회 조건을 만족하는지 반환합니다.
+func (f *ItemFilter) Match(item *Item) bool {
+	switch {
+	case len(f.Categories) > 0 && !slices.Contains(f.Categories, item.Category),
+		len(f.Size) > 0 && f.Size != item.Size,
+		f.MinPrice != nil && item.Price < *f.MinPrice,
+		f.MaxPrice != nil && item.Price > *f.MaxPrice,
+		f.MinCost != nil && item.Cost < *f.MinCost,
+		f.MaxCost != nil && item.Cost > *f.MaxCost,
+		f.ExpiresAfter != nil && item.ExpiryAt.Before(*f.ExpiresAfter),
+		f.ExpiresBefore != nil && !item.ExpiryAt.Before(*f.ExpiresBefore),
+		f.CreatedAfter != nil && item.CreatedAt.Before(*f.CreatedAfter),
+		f.CreatedBefore != nil && !item.CreatedAt.Before(*f.CreatedBefore),
+		len(f.Barcode) > 0 && f.Barcode != item.Barcode:
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,15 @@
+package domain
+
+// ItemHighlight 아이템 이름에서 검색 키워드와 일치한 부분입니다.
+type ItemHighlight struct {
+	// Chosung 이름의 초성으로 일치한 경우 true입니다.
+	Chosung bool
+	// Spans 일치한 범위이며, 이름의 문자(rune) 위치로 나타냅니다.
+	Spans []TextSpan
+}
+
+// TextSpan 문자열에서 Start 이상 End 미만의 문자(rune) 위치입니다.
+type TextSpan struct {
+	Start int
+	End   int
+}
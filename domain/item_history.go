@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/psi59/payhere-assignment/internal/valid"
+)
+
+// ItemHistory 아이템 변경 이력입니다.
+type ItemHistory struct {
+	ID        int
+	ItemID    int               `validate:"gt=0"`
+	UserID    int               `validate:"gt=0"`
+	ActorID   int               `validate:"gt=0"`
+	RequestID string            `validate:"lte=64"`
+	Action    ItemHistoryAction `validate:"required"`
+	Changes   []ItemFieldChange `validate:"dive"`
+	CreatedAt time.Time         `validate:"required"`
+}
+
+const ErrNilItemHistory ConstantError = "nil ItemHistory"
+
+// MaxRequestIDLength 클라이언트가 전달한 요청 ID는 길이 제한이 없으므로 저장 가능한 길이로 자릅니다.
+const MaxRequestIDLength = 64
+
+func NewItemHistory(
+	userID int,
+	itemID int,
+	actorID int,
+	requestID string,
+	action ItemHistoryAction,
+	changes []ItemFieldChange,
+) (*ItemHistory, error) {
+	switch {
+	case userID < 1:
+		return nil, fmt.Errorf("invalid userID: %d", userID)
+	case itemID < 1:
+		return nil, fmt.Errorf("invalid itemID: %d", itemID)
+	case actorID < 1:
+		return nil, fmt.Errorf("invalid actorID: %d", actorID)
+	}
+	if err := action.Validate(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if r := []rune(requestID); len(r) > MaxRequestIDLength {
+		requestID = string(r[:MaxRequestIDLength])
+	}
+	if changes == nil {
+		changes = make([]ItemFieldChange, 0)
+	}
+
+	history := &ItemHistory{
+		ItemID:    itemID,
+		UserID:    userID,
+		ActorID:   actorID,
+		RequestID: requestID,
+		Action:    action,
+		Changes:   changes,
+		CreatedAt: time.Now(),
+	}
+
+	return history, nil
+}
+
+func (h *ItemHistory) Validate() error {
+	if err := valid.ValidateStruct(h); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := h.Action.Validate(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+type ItemHistoryAction string
+
+const (
+	ItemHistoryActionCreate  ItemHistoryAction = "create"
+	ItemHistoryActionUpdate  ItemHistoryAction = "update"
+	ItemHistoryActionDelete  ItemHistoryAction = "delete"
+	ItemHistoryActionRestore ItemHistoryAction = "restore"
+	ItemHistoryActionPurge   ItemHistoryAction = "purge"
+)
+
+func (a ItemHistoryAction) Validate() error {
+	switch a {
+	case ItemHistoryActionCreate,
+		ItemHistoryActionUpdate,
+		ItemHistoryActionDelete,
+		ItemHistoryActionRestore,
+		ItemHistoryActionPurge:
+		return nil
+	default:
+		return fmt.Errorf("undefined ItemHistoryAction")
+	}
+}
+
+// ItemFieldChange 변경된 필드의 이전 값과 새 값이며, 값이 없는 경우 nil 입니다.
+// 저장소에 JSON으로 저장되므로 json 태그를 사용합니다.
+type ItemFieldChange struct {
+	Field    string  `json:"field" validate:"required"`
+	OldValue *string `json:"old"`
+	NewValue *string `json:"new"`
+}
@@ -0,0 +1,211 @@
+package domain
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ItemSortKey 아이템 목록의 정렬 기준입니다.
+type ItemSortKey string
+
+const (
+	ItemSortKeyName      ItemSortKey = "name"
+	ItemSortKeyPrice     ItemSortKey = "price"
+	ItemSortKeyCost      ItemSortKey = "cost"
+	ItemSortKeyExpiryAt  ItemSortKey = "expiryAt"
+	ItemSortKeyCreatedAt ItemSortKey = "createdAt"
+	// ItemSortKeyRelevance 검색 키워드와 일치한 정도이며, 오름차순은 키워드와 가깝게 일치한 아이템부터 정렬합니다.
+	// 키워드로 검색한 경우에만 사용할 수 있습니다.
+	ItemSortKeyRelevance ItemSortKey = "relevance"
+)
+
+func (k ItemSortKey) Validate() error {
+	switch k {
+	case ItemSortKeyName, ItemSortKeyPrice, ItemSortKeyCost, ItemSortKeyExpiryAt, ItemSortKeyCreatedAt, ItemSortKeyRelevance:
+		return nil
+	}
+
+	return fmt.Errorf("invalid ItemSortKey: %q", k)
+}
+
+type ItemSort struct {
+	Key  ItemSortKey
+	Desc bool
+}
+
+func (s ItemSort) String() string {
+	if s.Desc {
+		return "-" + string(s.Key)
+	}
+
+	return string(s.Key)
+}
+
+// Compare 정렬 기준에서 a가 b보다 앞이면 음수, 뒤면 양수, 같으면 0을 반환합니다.
+// 이름은 DB의 정렬 규칙과 동일하게 대소문자를 구분하지 않으며, 일치 정도는 아이템만으로 비교할 수 없으므로 항상 0을 반환합니다.
+func (s ItemSort) Compare(a, b *Item) int {
+	var c int
+	switch s.Key {
+	case ItemSortKeyName:
+		c = cmp.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	case ItemSortKeyPrice:
+		c = cmp.Compare(a.Price, b.Price)
+	case ItemSortKeyCost:
+		c = cmp.Compare(a.Cost, b.Cost)
+	case ItemSortKeyExpiryAt:
+		c = a.ExpiryAt.Compare(b.ExpiryAt)
+	case ItemSortKeyCreatedAt:
+		c = a.CreatedAt.Compare(b.CreatedAt)
+	}
+	if s.Desc {
+		c = -c
+	}
+
+	return c
+}
+
+// ItemSortOrder 아이템 목록의 정렬 순서입니다.
+// 정렬 기준이 모두 같은 아이템은 아이템 ID의 오름차순으로 정렬합니다.
+type ItemSortOrder []ItemSort
+
+// ParseItemSortOrder "price,-createdAt"처럼 쉼표로 구분된 정렬 기준을 파싱하며, 앞에 '-'가 붙은 기준은 내림차순으로 정렬합니다.
+func ParseItemSortOrder(s string) (ItemSortOrder, error) {
+	if len(s) == 0 {
+		return nil, nil
+	}
+
+	fields := strings.Split(s, ",")
+	order := make(ItemSortOrder, 0, len(fields))
+	for _, field := range fields {
+		field = strings.TrimSpace(field)
+		sort := ItemSort{Key: ItemSortKey(strings.TrimPrefix(field, "+"))}
+		if strings.HasPrefix(field, "-") {
+			sort = ItemSort{Key: ItemSortKey(field[1:]), Desc: true}
+		}
+		order = append(order, sort)
+	}
+	if err := order.Validate(); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+func (o ItemSortOrder) Validate() error {
+	for i, s := range o {
+		if err := s.Key.Validate(); err != nil {
+			return err
+		}
+		for _, prev := range o[:i] {
+			if prev.Key == s.Key {
+				return fmt.Errorf("duplicate ItemSortKey: %q", s.Key)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (o ItemSortOrder) String() string {
+	fields := make([]string, len(o))
+	for i, s := range o {
+		fields[i] = s.String()
+	}
+
+	return strings.Join(fields, ",")
+}
+
+// Has 정렬 순서에 key가 포함되어 있는지 확인합니다.
+func (o ItemSortOrder) Has(key ItemSortKey) bool {
+	for _, s := range o {
+		if s.Key == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Compare 정렬 순서에서 a가 b보다 앞이면 음수, 뒤면 양수, 같은 위치면 0을 반환합니다.
+func (o ItemSortOrder) Compare(a, b *Item) int {
+	for _, s := range o {
+		if c := s.Compare(a, b); c != 0 {
+			return c
+		}
+	}
+
+	return cmp.Compare(a.ID, b.ID)
+}
+
+// ItemCursor 아이템 목록에서 페이지를 나누는 위치이며, 기준 아이템의 정렬 기준 값과 아이템 ID를 저장합니다.
+// Backward가 true이면 기준 아이템의 앞쪽 페이지를, false이면 뒤쪽 페이지를 조회합니다.
+type ItemCursor struct {
+	// Sort 커서를 생성한 정렬 순서이며, 다른 정렬 순서로는 커서를 사용할 수 없습니다.
+	Sort      string
+	ID        int
+	Name      string
+	Price     int
+	Cost      int
+	ExpiryAt  time.Time
+	CreatedAt time.Time
+	// Score 키워드 검색 결과의 일치 점수이며, 일치 점수 순으로 정렬한 검색 결과에서만 사용합니다.
+	Score    int
+	Backward bool
+}
+
+// NewItemCursor item 위치의 커서를 생성하며, 정렬 순서에 포함된 기준 값만 저장합니다.
+func NewItemCursor(order ItemSortOrder, item *Item, backward bool) *ItemCursor {
+	cursor := &ItemCursor{
+		Sort:     order.String(),
+		ID:       item.ID,
+		Backward: backward,
+	}
+	for _, s := range order {
+		switch s.Key {
+		case ItemSortKeyName:
+			cursor.Name = item.Name
+		case ItemSortKeyPrice:
+			cursor.Price = item.Price
+		case ItemSortKeyCost:
+			cursor.Cost = item.Cost
+		case ItemSortKeyExpiryAt:
+			cursor.ExpiryAt = item.ExpiryAt
+		case ItemSortKeyCreatedAt:
+			cursor.CreatedAt = item.CreatedAt
+		}
+	}
+
+	return cursor
+}
+
+// Value 정렬 기준의 값을 반환합니다.
+func (c *ItemCursor) Value(key ItemSortKey) any {
+	switch key {
+	case ItemSortKeyName:
+		return c.Name
+	case ItemSortKeyPrice:
+		return c.Price
+	case ItemSortKeyCost:
+		return c.Cost
+	case ItemSortKeyExpiryAt:
+		return c.ExpiryAt
+	case ItemSortKeyCreatedAt:
+		return c.CreatedAt
+	}
+
+	return nil
+}
+
+// Item 정렬 순서에서 커서와 같은 위치의 아이템을 반환합니다.
+func (c *ItemCursor) Item() *Item {
+	return &Item{
+		ID:        c.ID,
+		Name:      c.Name,
+		Price:     c.Price,
+		Cost:      c.Cost,
+		ExpiryAt:  c.ExpiryAt,
+		CreatedAt: c.CreatedAt,
+	}
+}
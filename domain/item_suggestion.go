@@ -0,0 +1,15 @@
+package domain
+
+// ItemSuggestionType 자동 완성 결과의 종류입니다.
+type ItemSuggestionType string
+
+const (
+	ItemSuggestionTypeName     ItemSuggestionType = "name"
+	ItemSuggestionTypeCategory ItemSuggestionType = "category"
+)
+
+// ItemSuggestion 입력 중인 검색어의 자동 완성 결과이며, 아이템 이름 또는 카테고리입니다.
+type ItemSuggestion struct {
+	Text string
+	Type ItemSuggestionType
+}
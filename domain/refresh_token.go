@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// RefreshToken 액세스 토큰을 재발급하는 데 사용하는 토큰이며, 유출되더라도 사용할 수 없도록 토큰 대신 해시를 저장합니다.
+type RefreshToken struct {
+	TokenHash string
+	// FamilyID 로그인할 때 발급한 토큰과 그 토큰으로 재발급한 토큰들이 공유하는 아이디입니다.
+	FamilyID   string
+	Identifier string
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+	// RotatedAt 재발급에 사용된 시각이며, 재발급에 사용된 토큰을 다시 사용하면 family의 토큰을 모두 폐기합니다.
+	RotatedAt *time.Time
+	RevokedAt *time.Time
+}
+
+const ErrNilRefreshToken ConstantError = "nil RefreshToken"
+
+func (t *RefreshToken) Validate() error {
+	switch {
+	case len(t.TokenHash) == 0:
+		return fmt.Errorf("empty tokenHash")
+	case len(t.FamilyID) == 0:
+		return fmt.Errorf("empty familyID")
+	case len(t.Identifier) == 0:
+		return fmt.Errorf("empty identifier")
+	case t.ExpiresAt.IsZero():
+		return fmt.Errorf("zero expiresAt")
+	case t.CreatedAt.IsZero():
+		return fmt.Errorf("zero createdAt")
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Session 액세스 토큰을 발급한 기기의 로그인 기록이며, 토큰의 jti를 아이디로 사용합니다.
+type Session struct {
+	ID string
+	// FamilyID 함께 발급한 리프레시 토큰의 family 아이디이며, 같은 기기에서 재발급한 세션들이 공유합니다.
+	FamilyID   string
+	Identifier string
+	IP         string
+	UserAgent  string
+	CreatedAt  time.Time
+	// ExpiresAt 함께 발급한 리프레시 토큰의 만료 시각이며, 이후에는 재발급할 수 없으므로 로그아웃된 것으로 봅니다.
+	ExpiresAt time.Time
+	RevokedAt *time.Time
+}
+
+const ErrNilSession ConstantError = "nil Session"
+
+func (s *Session) Validate() error {
+	switch {
+	case len(s.ID) == 0:
+		return fmt.Errorf("empty id")
+	case len(s.FamilyID) == 0:
+		return fmt.Errorf("empty familyID")
+	case len(s.Identifier) == 0:
+		return fmt.Errorf("empty identifier")
+	case s.ExpiresAt.IsZero():
+		return fmt.Errorf("zero expiresAt")
+	case s.CreatedAt.IsZero():
+		return fmt.Errorf("zero createdAt")
+	}
+
+	return nil
+}
+
+// IsActive now 기준으로 폐기되거나 만료되지 않은 세션인지 반환합니다.
+func (s *Session) IsActive(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
@@ -16,6 +16,34 @@ type User struct {
 	PhoneNumber string
 	Password    string
 	CreatedAt   time.Time
+	// DuplicateItemPolicy 비슷한 이름의 아이템이 이미 있을 때 아이템 생성, 수정을 처리하는 방식입니다.
+	DuplicateItemPolicy DuplicateItemPolicy
+	// TokensValidAfter 이 시각 이전에 발급된 토큰은 모두 거부합니다.
+	// 토큰의 iat와 비교하므로 TokenTimePrecision 단위로 저장합니다.
+	TokensValidAfter *time.Time
+}
+
+// TokenTimePrecision 토큰의 발급 시각(iat)과 토큰 무효화 시각의 정밀도입니다.
+// 토큰을 무효화한 직후 같은 초에 발급한 토큰도 구분할 수 있도록 DB에 저장할 수 있는 가장 작은 단위인 마이크로초를 사용합니다.
+const TokenTimePrecision = time.Microsecond
+
+// DuplicateItemPolicy 비슷한 이름의 아이템이 이미 있을 때 아이템 생성, 수정을 처리하는 방식입니다.
+type DuplicateItemPolicy string
+
+const (
+	// DuplicateItemPolicyWarn 아이템을 저장하고 비슷한 아이템 목록을 함께 반환합니다.
+	DuplicateItemPolicyWarn DuplicateItemPolicy = "warn"
+	// DuplicateItemPolicyBlock 아이템을 저장하지 않고 ErrSimilarItemExists를 반환합니다.
+	DuplicateItemPolicyBlock DuplicateItemPolicy = "block"
+)
+
+func (p DuplicateItemPolicy) Validate() error {
+	switch p {
+	case DuplicateItemPolicyWarn, DuplicateItemPolicyBlock:
+		return nil
+	}
+
+	return fmt.Errorf("invalid DuplicateItemPolicy: %q", p)
 }
 
 const (
@@ -34,15 +62,17 @@ func NewUser(phoneNumber, password string, createdAt time.Time) (*User, error) {
 		return nil, fmt.Errorf("zero createdAt")
 	}
 
-	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	hashed, err := HashPassword(password)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to generate hashed password")
+		return nil, errors.WithStack(err)
 	}
 	u := &User{
 		ID:          0,
 		PhoneNumber: phoneNumber,
-		Password:    string(hashed),
+		Password:    hashed,
 		CreatedAt:   createdAt,
+
+		DuplicateItemPolicy: DuplicateItemPolicyWarn,
 	}
 
 	return u, nil
@@ -72,3 +102,21 @@ func (u *User) ComparePassword(password string) error {
 
 	return nil
 }
+
+// IsTokenInvalidated issuedAt에 발급된 토큰이 TokensValidAfter 이전에 발급되어 무효화됐는지 반환합니다.
+func (u *User) IsTokenInvalidated(issuedAt time.Time) bool {
+	return u.TokensValidAfter != nil && issuedAt.Before(*u.TokensValidAfter)
+}
+
+// HashPassword 비밀번호를 검증하고 저장할 해시를 반환합니다.
+func HashPassword(password string) (string, error) {
+	if err := valid.ValidatePassword(password); err != nil {
+		return "", errors.WithStack(err)
+	}
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to generate hashed password")
+	}
+
+	return string(hashed), nil
+}
@@ -192,3 +192,15 @@ func TestUser_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestUser_IsTokenInvalidated(t *testing.T) {
+	tokensValidAfter := time.Unix(time.Now().Unix(), 0)
+
+	user := &User{}
+	require.False(t, user.IsTokenInvalidated(tokensValidAfter.Add(-time.Hour)))
+
+	user.TokensValidAfter = &tokensValidAfter
+	require.True(t, user.IsTokenInvalidated(tokensValidAfter.Add(-time.Second)))
+	require.False(t, user.IsTokenInvalidated(tokensValidAfter))
+	require.False(t, user.IsTokenInvalidated(tokensValidAfter.Add(time.Second)))
+}
@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/psi59/payhere-assignment/internal/ginhelper"
+	"github.com/psi59/payhere-assignment/internal/valid"
+	"github.com/psi59/payhere-assignment/usecase/authtoken"
+)
+
+// jwksCacheControl JWKS 응답의 캐시 정책입니다.
+// 새 키는 활성화 전에 미리 공개되므로 키 교체 간격보다 짧게만 유지하면 됩니다.
+const jwksCacheControl = "public, max-age=300"
+
+type AuthTokenHandler struct {
+	authTokenUsecase authtoken.Usecase
+}
+
+func NewAuthTokenHandler(authTokenUsecase authtoken.Usecase) (*AuthTokenHandler, error) {
+	if valid.IsNil(authTokenUsecase) {
+		return nil, authtoken.ErrNilUsecase
+	}
+
+	return &AuthTokenHandler{
+		authTokenUsecase: authTokenUsecase,
+	}, nil
+}
+
+// JWKS 액세스 토큰 검증용 공개키를 JWK Set 형식으로 응답합니다.
+// 다른 서비스가 표준 형식 그대로 사용할 수 있도록 공통 응답 형식으로 감싸지 않습니다.
+func (h *AuthTokenHandler) JWKS(ginCtx *gin.Context) {
+	ctx := ginhelper.GetContext(ginCtx)
+
+	output, err := h.authTokenUsecase.GetKeySet(ctx)
+	if err != nil {
+		ginhelper.Error(ginCtx, errors.WithStack(err))
+		return
+	}
+
+	ginCtx.Header("Cache-Control", jwksCacheControl)
+	ginCtx.JSON(http.StatusOK, output.KeySet)
+}
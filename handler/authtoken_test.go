@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/psi59/payhere-assignment/internal/keyring"
+	"github.com/psi59/payhere-assignment/internal/mocks/ucmocks"
+	"github.com/psi59/payhere-assignment/usecase/authtoken"
+)
+
+func TestNewAuthTokenHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	got, err := NewAuthTokenHandler(ucmocks.NewMockAuthTokenUsecase(ctrl))
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	got, err = NewAuthTokenHandler(nil)
+	require.Error(t, err)
+	require.Nil(t, got)
+}
+
+func TestAuthTokenHandler_JWKS(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authTokenUsecase := ucmocks.NewMockAuthTokenUsecase(ctrl)
+
+	r := gin.New()
+	handler, err := NewAuthTokenHandler(authTokenUsecase)
+	require.NoError(t, err)
+	r.GET("/", handler.JWKS)
+
+	t.Run("OK", func(t *testing.T) {
+		keySet := keyring.JWKSet{Keys: []keyring.JWK{{
+			KeyType:   "OKP",
+			Use:       "sig",
+			Algorithm: "EdDSA",
+			KeyID:     gofakeit.UUID(),
+			Curve:     "Ed25519",
+			X:         gofakeit.LetterN(43),
+		}}}
+		authTokenUsecase.EXPECT().GetKeySet(gomock.Any()).Return(&authtoken.GetKeySetOutput{KeySet: keySet}, nil)
+
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodGet, "/", nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		var got keyring.JWKSet
+		err = json.NewDecoder(responseWriter.Body).Decode(&got)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, responseWriter.Code)
+		assert.Equal(t, jwksCacheControl, responseWriter.Header().Get("Cache-Control"))
+		assert.Equal(t, keySet, got)
+	})
+
+	t.Run("예상하지 못한 에러", func(t *testing.T) {
+		authTokenUsecase.EXPECT().GetKeySet(gomock.Any()).Return(nil, gofakeit.Error())
+
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodGet, "/", nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
+	})
+}
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+
+	"github.com/psi59/payhere-assignment/domain"
+)
+
+// itemETag 아이템 버전으로 강한 ETag를 생성합니다.
+func itemETag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// itemsETag 목록에 포함된 아이템의 아이디와 버전으로 약한 ETag를 생성합니다.
+func itemsETag(items []domain.Item) string {
+	h := fnv.New64a()
+	for i := 0; i < len(items); i++ {
+		_, _ = fmt.Fprintf(h, "%d:%d;", items[i].ID, items[i].Version)
+	}
+
+	return fmt.Sprintf("W/%q", strconv.FormatUint(h.Sum64(), 16))
+}
+
+// parseIfMatch If-Match 헤더에서 아이템 버전을 추출합니다.
+// 헤더가 없거나 "*"인 경우 버전을 확인하지 않도록 0을 반환합니다.
+func parseIfMatch(header string) (int, error) {
+	header = strings.TrimSpace(header)
+	if len(header) == 0 || header == "*" {
+		return 0, nil
+	}
+
+	// If-Match는 강한 비교를 사용하므로 약한 ETag는 허용하지 않습니다.
+	rawVersion, err := strconv.Unquote(header)
+	if err != nil || !strings.HasPrefix(header, `"`) {
+		return 0, fmt.Errorf("invalid If-Match: %s", header)
+	}
+	version, err := strconv.Atoi(rawVersion)
+	if err != nil || version < 1 {
+		return 0, fmt.Errorf("invalid If-Match: %s", header)
+	}
+
+	return version, nil
+}
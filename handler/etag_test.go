@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/psi59/payhere-assignment/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseIfMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  string
+		want    int
+		wantErr bool
+	}{
+		{name: "empty", header: "", want: 0},
+		{name: "any", header: "*", want: 0},
+		{name: "OK", header: `"12"`, want: 12},
+		{name: "weak", header: `W/"12"`, wantErr: true},
+		{name: "unquoted", header: "12", wantErr: true},
+		{name: "not a number", header: `"abc"`, wantErr: true},
+		{name: "zero", header: `"0"`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseIfMatch(tt.header)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_itemsETag(t *testing.T) {
+	items := []domain.Item{{ID: 1, Version: 1}, {ID: 2, Version: 1}}
+	etag := itemsETag(items)
+	assert.Equal(t, etag, itemsETag([]domain.Item{{ID: 1, Version: 1}, {ID: 2, Version: 1}}))
+	assert.NotEqual(t, etag, itemsETag([]domain.Item{{ID: 1, Version: 2}, {ID: 2, Version: 1}}))
+	assert.Regexp(t, `^W/".+"$`, etag)
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/psi59/payhere-assignment/usecase/item"
@@ -21,14 +22,26 @@ import (
 
 type ItemHandler struct {
 	itemUsecase item.Usecase
+	config      ItemHandlerConfig
 }
 
-func NewItemHandler(itemUsecase item.Usecase) (*ItemHandler, error) {
+// ItemHandlerConfig 아이템 핸들러 설정입니다.
+type ItemHandlerConfig struct {
+	// DefaultFindLimit limit 쿼리 파라메터를 생략한 경우 조회할 아이템 수입니다.
+	DefaultFindLimit int `validate:"gt=0,ltefield=MaxFindLimit"`
+	// MaxFindLimit limit 쿼리 파라메터의 최댓값입니다.
+	MaxFindLimit int `validate:"gt=0"`
+}
+
+func NewItemHandler(itemUsecase item.Usecase, config ItemHandlerConfig) (*ItemHandler, error) {
 	if valid.IsNil(itemUsecase) {
 		return nil, item.ErrNilUsecase
 	}
+	if err := valid.ValidateStruct(config); err != nil {
+		return nil, errors.WithStack(err)
+	}
 
-	return &ItemHandler{itemUsecase: itemUsecase}, nil
+	return &ItemHandler{itemUsecase: itemUsecase, config: config}, nil
 }
 
 func (h *ItemHandler) Create(ginCtx *gin.Context) {
@@ -70,6 +83,10 @@ func (h *ItemHandler) Create(ginCtx *gin.Context) {
 			ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusConflict, i18n.ItemAlreadyExists, errors.WithStack(err)))
 			return
 		}
+		if httpErr := similarItemsHTTPError(err); httpErr != nil {
+			ginhelper.Error(ginCtx, httpErr)
+			return
+		}
 
 		ginhelper.Error(ginCtx, errors.WithStack(err))
 		return
@@ -77,17 +94,20 @@ func (h *ItemHandler) Create(ginCtx *gin.Context) {
 	itemDomain := createItemOutput.Item
 
 	// 4. 응답 반환
+	ginCtx.Header("ETag", itemETag(itemDomain.Version))
 	ginhelper.Success(ginCtx, CreateItemResponse{
-		ID:          itemDomain.ID,
-		Name:        itemDomain.Name,
-		Description: itemDomain.Description,
-		Price:       itemDomain.Price,
-		Cost:        itemDomain.Cost,
-		Category:    itemDomain.Category,
-		Barcode:     itemDomain.Barcode,
-		Size:        itemDomain.Size,
-		ExpiryAt:    itemDomain.ExpiryAt,
-		CreatedAt:   itemDomain.CreatedAt,
+		ID:           itemDomain.ID,
+		Name:         itemDomain.Name,
+		Description:  itemDomain.Description,
+		Price:        itemDomain.Price,
+		Cost:         itemDomain.Cost,
+		Category:     itemDomain.Category,
+		Barcode:      itemDomain.Barcode,
+		Size:         itemDomain.Size,
+		ExpiryAt:     itemDomain.ExpiryAt,
+		CreatedAt:    itemDomain.CreatedAt,
+		Version:      itemDomain.Version,
+		SimilarItems: newSimilarItemResponses(createItemOutput.SimilarItems),
 	})
 }
 
@@ -123,6 +143,7 @@ func (h *ItemHandler) Get(ginCtx *gin.Context) {
 	}
 	itemDomain := getItemOutput.Item
 
+	ginCtx.Header("ETag", itemETag(itemDomain.Version))
 	ginhelper.Success(ginCtx, GetItemResponse{
 		ID:          itemDomain.ID,
 		Name:        itemDomain.Name,
@@ -134,6 +155,7 @@ func (h *ItemHandler) Get(ginCtx *gin.Context) {
 		Size:        itemDomain.Size,
 		ExpiryAt:    itemDomain.ExpiryAt,
 		CreatedAt:   itemDomain.CreatedAt,
+		Version:     itemDomain.Version,
 	})
 }
 
@@ -154,11 +176,60 @@ func (h *ItemHandler) Delete(ginCtx *gin.Context) {
 		return
 	}
 
-	if err := h.itemUsecase.Delete(ctx, &item.DeleteInput{User: user, ItemID: itemID}); err != nil {
+	var req DeleteItemRequest
+	if err := ginCtx.BindQuery(&req); err != nil {
+		ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusBadRequest, i18n.InvalidRequest, errors.WithStack(err)))
+		return
+	}
+	version, err := parseIfMatch(ginCtx.GetHeader("If-Match"))
+	if err != nil {
+		ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusBadRequest, i18n.InvalidRequest, errors.WithStack(err)))
+		return
+	}
+
+	if err := h.itemUsecase.Delete(ctx, &item.DeleteInput{User: user, ItemID: itemID, Permanent: req.Permanent, Version: version}); err != nil {
 		if errors.Is(err, domain.ErrItemNotFound) {
 			ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusNotFound, i18n.ItemNotFound, errors.WithStack(err)))
 			return
 		}
+		if errors.Is(err, domain.ErrItemVersionMismatch) {
+			ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusPreconditionFailed, i18n.ItemVersionMismatch, errors.WithStack(err)))
+			return
+		}
+
+		ginhelper.Error(ginCtx, errors.WithStack(err))
+		return
+	}
+
+	ginCtx.Status(http.StatusNoContent)
+}
+
+func (h *ItemHandler) Restore(ginCtx *gin.Context) {
+	ctx := ginhelper.GetContext(ginCtx)
+
+	// 1. 인증된 유저 확인
+	user, ok := ctx.Value(domain.CtxKeyUser).(*domain.User)
+	if !ok {
+		ginhelper.Error(ginCtx, errors.New("unauthenticated request"))
+		return
+	}
+
+	itemIDParam := ginCtx.Param("itemId")
+	itemID, err := strconv.Atoi(itemIDParam)
+	if err != nil {
+		ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusNotFound, i18n.ItemNotFound, errors.WithStack(err)))
+		return
+	}
+
+	if err := h.itemUsecase.Restore(ctx, &item.RestoreInput{User: user, ItemID: itemID}); err != nil {
+		if errors.Is(err, domain.ErrItemNotFound) {
+			ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusNotFound, i18n.ItemNotFound, errors.WithStack(err)))
+			return
+		}
+		if errors.Is(err, domain.ErrItemAlreadyExists) {
+			ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusConflict, i18n.ItemAlreadyExists, errors.WithStack(err)))
+			return
+		}
 
 		ginhelper.Error(ginCtx, errors.WithStack(err))
 		return
@@ -197,8 +268,13 @@ func (h *ItemHandler) Update(ginCtx *gin.Context) {
 		ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusBadRequest, i18n.InvalidRequest, errors.WithStack(err)))
 		return
 	}
+	version, err := parseIfMatch(ginCtx.GetHeader("If-Match"))
+	if err != nil {
+		ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusBadRequest, i18n.InvalidRequest, errors.WithStack(err)))
+		return
+	}
 
-	if err := h.itemUsecase.Update(ctx, &item.UpdateInput{
+	updateOutput, err := h.itemUsecase.Update(ctx, &item.UpdateInput{
 		User:        user,
 		ItemID:      itemID,
 		Name:        req.Name,
@@ -209,20 +285,37 @@ func (h *ItemHandler) Update(ginCtx *gin.Context) {
 		Barcode:     req.Barcode,
 		Size:        req.Size,
 		ExpiryAt:    req.ExpiryAt,
-	}); err != nil {
+		Version:     version,
+	})
+	if err != nil {
 		if errors.Is(err, domain.ErrItemNotFound) {
 			ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusNotFound, i18n.ItemNotFound, errors.WithStack(err)))
 			return
 		}
+		if errors.Is(err, domain.ErrItemVersionMismatch) {
+			ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusPreconditionFailed, i18n.ItemVersionMismatch, errors.WithStack(err)))
+			return
+		}
 		if errors.Is(err, domain.ErrItemAlreadyExists) {
 			ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusConflict, i18n.ItemAlreadyExists, errors.WithStack(err)))
 			return
 		}
+		if httpErr := similarItemsHTTPError(err); httpErr != nil {
+			ginhelper.Error(ginCtx, httpErr)
+			return
+		}
 
 		ginhelper.Error(ginCtx, errors.WithStack(err))
 		return
 	}
 
+	// 다음 수정 요청에서 다시 조회하지 않도록 수정된 버전을 응답합니다.
+	ginCtx.Header("ETag", itemETag(updateOutput.Version))
+	// 이름이 비슷한 아이템이 있는 경우에만 응답 본문에 포함합니다.
+	if len(updateOutput.SimilarItems) > 0 {
+		ginhelper.Success(ginCtx, UpdateItemResponse{SimilarItems: newSimilarItemResponses(updateOutput.SimilarItems)})
+		return
+	}
 	ginCtx.Status(http.StatusNoContent)
 }
 
@@ -242,19 +335,133 @@ func (h *ItemHandler) Find(ginCtx *gin.Context) {
 		return
 	}
 
+	filter := req.Filter()
+	if err := filter.Validate(); err != nil {
+		ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusBadRequest, i18n.InvalidRequest, errors.WithStack(err)))
+		return
+	}
+	sortOrder, err := domain.ParseItemSortOrder(req.Sort)
+	if err != nil {
+		ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusBadRequest, i18n.InvalidRequest, errors.WithStack(err)))
+		return
+	}
+	if sortOrder.Has(domain.ItemSortKeyRelevance) && len(strings.TrimSpace(req.Keyword)) == 0 {
+		err := errors.New("relevance sort requires keyword")
+		ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusBadRequest, i18n.InvalidRequest, err))
+		return
+	}
+	limit, err := h.findLimit(req.Limit)
+	if err != nil {
+		ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusBadRequest, i18n.InvalidRequest, errors.WithStack(err)))
+		return
+	}
+
 	findOutput, err := h.itemUsecase.Find(ctx, &item.FindInput{
-		User:        user,
-		Keyword:     req.Keyword,
-		SearchAfter: req.SearchAfter,
+		User:       user,
+		Keyword:    req.Keyword,
+		Filter:     filter,
+		Sort:       sortOrder,
+		Cursor:     req.Cursor,
+		WithTotal:  req.WithTotal,
+		WithFacets: req.WithFacets,
+		Limit:      limit,
 	})
 	if err != nil {
+		if errors.Is(err, domain.ErrInvalidItemCursor) {
+			ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusBadRequest, i18n.InvalidRequest, errors.WithStack(err)))
+			return
+		}
+		var queryErr *item.QueryError
+		if errors.As(err, &queryErr) {
+			details := InvalidQueryDetails{Position: queryErr.Position, Reason: queryErr.Reason}
+			ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusBadRequest, i18n.InvalidRequest, errors.WithStack(err)).WithDetails(details))
+			return
+		}
+
 		ginhelper.Error(ginCtx, errors.WithStack(err))
 		return
 	}
 
-	items := make([]GetItemResponse, len(findOutput.Items))
+	items := make([]FindItemResponseItem, len(findOutput.Items))
 	for i := 0; i < len(findOutput.Items); i++ {
-		items[i] = GetItemResponse{
+		items[i] = FindItemResponseItem{
+			GetItemResponse: GetItemResponse{
+				ID:          findOutput.Items[i].ID,
+				Name:        findOutput.Items[i].Name,
+				Description: findOutput.Items[i].Description,
+				Price:       findOutput.Items[i].Price,
+				Cost:        findOutput.Items[i].Cost,
+				Category:    findOutput.Items[i].Category,
+				Barcode:     findOutput.Items[i].Barcode,
+				Size:        findOutput.Items[i].Size,
+				ExpiryAt:    findOutput.Items[i].ExpiryAt,
+				CreatedAt:   findOutput.Items[i].CreatedAt,
+				Version:     findOutput.Items[i].Version,
+			},
+		}
+		if i < len(findOutput.Highlights) {
+			items[i].Highlight = newItemHighlightResponse(findOutput.Highlights[i])
+		}
+	}
+
+	ginCtx.Header("ETag", itemsETag(findOutput.Items))
+	resp := FindItemResponse{
+		Items:      items,
+		HasNext:    findOutput.HasNext,
+		HasPrev:    findOutput.HasPrev,
+		NextCursor: findOutput.NextCursor,
+		PrevCursor: findOutput.PrevCursor,
+	}
+	if req.WithTotal {
+		resp.TotalCount = &findOutput.TotalCount
+	}
+	if req.WithFacets && findOutput.Facets != nil {
+		resp.Facets = newItemFacetsResponse(findOutput.Facets)
+	}
+	ginhelper.Success(ginCtx, resp)
+}
+
+func (h *ItemHandler) FindTrash(ginCtx *gin.Context) {
+	ctx := ginhelper.GetContext(ginCtx)
+
+	// 1. 인증된 유저 확인
+	user, ok := ctx.Value(domain.CtxKeyUser).(*domain.User)
+	if !ok {
+		ginhelper.Error(ginCtx, errors.New("unauthenticated request"))
+		return
+	}
+
+	var req FindTrashRequest
+	if err := ginCtx.BindQuery(&req); err != nil {
+		ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusBadRequest, i18n.InvalidRequest, errors.WithStack(err)))
+		return
+	}
+
+	limit, err := h.findLimit(req.Limit)
+	if err != nil {
+		ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusBadRequest, i18n.InvalidRequest, errors.WithStack(err)))
+		return
+	}
+
+	findOutput, err := h.itemUsecase.FindTrash(ctx, &item.FindTrashInput{
+		User:      user,
+		Cursor:    req.Cursor,
+		WithTotal: req.WithTotal,
+		Limit:     limit,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidItemCursor) {
+			ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusBadRequest, i18n.InvalidRequest, errors.WithStack(err)))
+			return
+		}
+
+		ginhelper.Error(ginCtx, errors.WithStack(err))
+		return
+	}
+
+	items := make([]TrashItemResponse, len(findOutput.Items))
+	for i := 0; i < len(findOutput.Items); i++ {
+		items[i] = TrashItemResponse{
 			ID:          findOutput.Items[i].ID,
 			Name:        findOutput.Items[i].Name,
 			Description: findOutput.Items[i].Description,
@@ -265,17 +472,195 @@ func (h *ItemHandler) Find(ginCtx *gin.Context) {
 			Size:        findOutput.Items[i].Size,
 			ExpiryAt:    findOutput.Items[i].ExpiryAt,
 			CreatedAt:   findOutput.Items[i].CreatedAt,
+			Version:     findOutput.Items[i].Version,
+			DeletedAt:   findOutput.Items[i].DeletedAt,
 		}
 	}
 
-	ginhelper.Success(ginCtx, FindItemResponse{
-		TotalCount:  findOutput.TotalCount,
-		Items:       items,
-		HasNext:     findOutput.HasNext,
-		SearchAfter: findOutput.SearchAfter,
+	resp := FindTrashResponse{
+		Items:      items,
+		HasNext:    findOutput.HasNext,
+		HasPrev:    findOutput.HasPrev,
+		NextCursor: findOutput.NextCursor,
+		PrevCursor: findOutput.PrevCursor,
+	}
+	if req.WithTotal {
+		resp.TotalCount = &findOutput.TotalCount
+	}
+	ginhelper.Success(ginCtx, resp)
+}
+
+// findLimit 요청한 한 페이지의 아이템 수를 반환하며, 생략한 경우 기본값을 사용합니다.
+func (h *ItemHandler) findLimit(limit *int) (int, error) {
+	if limit == nil {
+		return h.config.DefaultFindLimit, nil
+	}
+	if *limit < 1 || *limit > h.config.MaxFindLimit {
+		return 0, errors.Errorf("limit must be between 1 and %d: %d", h.config.MaxFindLimit, *limit)
+	}
+
+	return *limit, nil
+}
+
+func (h *ItemHandler) History(ginCtx *gin.Context) {
+	ctx := ginhelper.GetContext(ginCtx)
+
+	// 1. 인증된 유저 확인
+	user, ok := ctx.Value(domain.CtxKeyUser).(*domain.User)
+	if !ok {
+		ginhelper.Error(ginCtx, errors.New("unauthenticated request"))
+		return
+	}
+
+	itemIDParam := ginCtx.Param("itemId")
+	itemID, err := strconv.Atoi(itemIDParam)
+	if err != nil {
+		ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusNotFound, i18n.ItemNotFound, errors.WithStack(err)))
+		return
+	}
+
+	var req ItemHistoryRequest
+	if err := ginCtx.BindQuery(&req); err != nil {
+		ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusBadRequest, i18n.InvalidRequest, errors.WithStack(err)))
+		return
+	}
+
+	historyOutput, err := h.itemUsecase.History(ctx, &item.HistoryInput{
+		User:        user,
+		ItemID:      itemID,
+		SearchAfter: req.SearchAfter,
+	})
+	if err != nil {
+		ginhelper.Error(ginCtx, errors.WithStack(err))
+		return
+	}
+
+	histories := make([]ItemHistoryResponse, len(historyOutput.Histories))
+	for i := 0; i < len(historyOutput.Histories); i++ {
+		history := historyOutput.Histories[i]
+		changes := make([]ItemFieldChangeResponse, len(history.Changes))
+		for j := 0; j < len(history.Changes); j++ {
+			changes[j] = ItemFieldChangeResponse{
+				Field:    history.Changes[j].Field,
+				OldValue: history.Changes[j].OldValue,
+				NewValue: history.Changes[j].NewValue,
+			}
+		}
+		histories[i] = ItemHistoryResponse{
+			ID:        history.ID,
+			ItemID:    history.ItemID,
+			ActorID:   history.ActorID,
+			RequestID: history.RequestID,
+			Action:    history.Action,
+			Changes:   changes,
+			CreatedAt: history.CreatedAt,
+		}
+	}
+
+	ginhelper.Success(ginCtx, ItemHistoryListResponse{
+		Histories:   histories,
+		HasNext:     historyOutput.HasNext,
+		SearchAfter: historyOutput.SearchAfter,
 	})
 }
 
+func (h *ItemHandler) Suggest(ginCtx *gin.Context) {
+	ctx := ginhelper.GetContext(ginCtx)
+
+	// 1. 인증된 유저 확인
+	user, ok := ctx.Value(domain.CtxKeyUser).(*domain.User)
+	if !ok {
+		ginhelper.Error(ginCtx, errors.New("unauthenticated request"))
+		return
+	}
+
+	var req SuggestItemRequest
+	if err := ginCtx.BindQuery(&req); err != nil {
+		ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusBadRequest, i18n.InvalidRequest, errors.WithStack(err)))
+		return
+	}
+	limit := item.DefaultSuggestLimit
+	if req.Limit != nil {
+		limit = *req.Limit
+	}
+	if limit < 1 || limit > item.MaxSuggestLimit {
+		err := errors.Errorf("limit must be between 1 and %d: %d", item.MaxSuggestLimit, limit)
+		ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusBadRequest, i18n.InvalidRequest, err))
+		return
+	}
+
+	suggestOutput, err := h.itemUsecase.Suggest(ctx, &item.SuggestInput{
+		User:  user,
+		Query: req.Q,
+		Limit: limit,
+	})
+	if err != nil {
+		ginhelper.Error(ginCtx, errors.WithStack(err))
+		return
+	}
+
+	suggestions := make([]ItemSuggestionResponse, len(suggestOutput.Suggestions))
+	for i, suggestion := range suggestOutput.Suggestions {
+		suggestions[i] = ItemSuggestionResponse{
+			Text: suggestion.Text,
+			Type: suggestion.Type,
+		}
+	}
+
+	ginhelper.Success(ginCtx, SuggestItemResponse{Suggestions: suggestions})
+}
+
+func (h *ItemHandler) FindDuplicates(ginCtx *gin.Context) {
+	ctx := ginhelper.GetContext(ginCtx)
+
+	// 1. 인증된 유저 확인
+	user, ok := ctx.Value(domain.CtxKeyUser).(*domain.User)
+	if !ok {
+		ginhelper.Error(ginCtx, errors.New("unauthenticated request"))
+		return
+	}
+
+	findDuplicatesOutput, err := h.itemUsecase.FindDuplicates(ctx, &item.FindDuplicatesInput{User: user})
+	if err != nil {
+		ginhelper.Error(ginCtx, errors.WithStack(err))
+		return
+	}
+
+	clusters := make([]ItemDuplicateClusterResponse, len(findDuplicatesOutput.Clusters))
+	for i, cluster := range findDuplicatesOutput.Clusters {
+		items := make([]GetItemResponse, len(cluster.Items))
+		for j, itemDomain := range cluster.Items {
+			items[j] = GetItemResponse{
+				ID:          itemDomain.ID,
+				Name:        itemDomain.Name,
+				Description: itemDomain.Description,
+				Price:       itemDomain.Price,
+				Cost:        itemDomain.Cost,
+				Category:    itemDomain.Category,
+				Barcode:     itemDomain.Barcode,
+				Size:        itemDomain.Size,
+				ExpiryAt:    itemDomain.ExpiryAt,
+				CreatedAt:   itemDomain.CreatedAt,
+				Version:     itemDomain.Version,
+			}
+		}
+		clusters[i] = ItemDuplicateClusterResponse{Reason: cluster.Reason, Items: items}
+	}
+
+	ginhelper.Success(ginCtx, FindDuplicatesResponse{Clusters: clusters})
+}
+
+// similarItemsHTTPError 이름이 비슷한 아이템이 있어 저장하지 않은 경우 비슷한 아이템 목록을 포함한 에러를 반환합니다.
+func similarItemsHTTPError(err error) *ginhelper.HTTPError {
+	var similarErr *item.SimilarItemsError
+	if !errors.As(err, &similarErr) {
+		return nil
+	}
+	details := SimilarItemsDetails{SimilarItems: newSimilarItemResponses(similarErr.Items)}
+
+	return ginhelper.NewHTTPError(http.StatusConflict, i18n.SimilarItemExists, errors.WithStack(err)).WithDetails(details)
+}
+
 type CreateItemRequest struct {
 	Name        string          `json:"name" validate:"required,gte=1,lte=100"`
 	Description string          `json:"description" validate:"required"`
@@ -298,6 +683,46 @@ type CreateItemResponse struct {
 	Size        domain.ItemSize `json:"size"`
 	ExpiryAt    time.Time       `json:"expiryAt"`
 	CreatedAt   time.Time       `json:"createdAt"`
+	Version     int             `json:"version"`
+	// SimilarItems 이름이 비슷한 기존 아이템이 있는 경우에만 응답합니다.
+	SimilarItems []SimilarItemResponse `json:"similarItems,omitempty"`
+}
+
+type UpdateItemResponse struct {
+	SimilarItems []SimilarItemResponse `json:"similarItems"`
+}
+
+// SimilarItemResponse 이름이 비슷한 기존 아이템입니다.
+type SimilarItemResponse struct {
+	ID     int                        `json:"id"`
+	Name   string                     `json:"name"`
+	Reason domain.ItemDuplicateReason `json:"reason"`
+}
+
+func newSimilarItemResponses(similarItems []domain.SimilarItem) []SimilarItemResponse {
+	if len(similarItems) == 0 {
+		return nil
+	}
+	resp := make([]SimilarItemResponse, len(similarItems))
+	for i, similar := range similarItems {
+		resp[i] = SimilarItemResponse{ID: similar.Item.ID, Name: similar.Item.Name, Reason: similar.Reason}
+	}
+
+	return resp
+}
+
+// SimilarItemsDetails 이름이 비슷한 아이템이 있어 저장하지 않은 경우 에러 응답에 포함되는 정보입니다.
+type SimilarItemsDetails struct {
+	SimilarItems []SimilarItemResponse `json:"similarItems"`
+}
+
+type ItemDuplicateClusterResponse struct {
+	Reason domain.ItemDuplicateReason `json:"reason"`
+	Items  []GetItemResponse          `json:"items"`
+}
+
+type FindDuplicatesResponse struct {
+	Clusters []ItemDuplicateClusterResponse `json:"clusters"`
 }
 
 type GetItemResponse struct {
@@ -311,6 +736,7 @@ type GetItemResponse struct {
 	Size        domain.ItemSize `json:"size"`
 	ExpiryAt    time.Time       `json:"expiryAt"`
 	CreatedAt   time.Time       `json:"createdAt"`
+	Version     int             `json:"version"`
 }
 
 type UpdateItemRequest struct {
@@ -336,13 +762,211 @@ func (r *UpdateItemRequest) ShouldUpdate() bool {
 }
 
 type FindItemRequest struct {
-	Keyword     string `form:"keyword"`
-	SearchAfter int    `form:"searchAfter"`
+	Keyword string `form:"keyword"`
+	// Sort "price,-createdAt"처럼 쉼표로 구분된 정렬 기준이며, 앞에 '-'가 붙은 기준은 내림차순으로 정렬합니다.
+	Sort      string `form:"sort"`
+	Cursor    string `form:"cursor"`
+	WithTotal bool   `form:"withTotal"`
+	// WithFacets true인 경우 카테고리, 크기, 가격 구간별 아이템 수를 함께 조회합니다.
+	WithFacets bool `form:"withFacets"`
+	// Limit 한 페이지의 아이템 수이며, 생략하면 서버의 기본값을 사용합니다.
+	Limit         *int            `form:"limit"`
+	Categories    []string        `form:"category"`
+	Size          domain.ItemSize `form:"size"`
+	MinPrice      *int            `form:"minPrice"`
+	MaxPrice      *int            `form:"maxPrice"`
+	MinCost       *int            `form:"minCost"`
+	MaxCost       *int            `form:"maxCost"`
+	ExpiresAfter  *time.Time      `form:"expiresAfter" time_format:"2006-01-02T15:04:05Z07:00"`
+	ExpiresBefore *time.Time      `form:"expiresBefore" time_format:"2006-01-02T15:04:05Z07:00"`
+	CreatedAfter  *time.Time      `form:"createdAfter" time_format:"2006-01-02T15:04:05Z07:00"`
+	CreatedBefore *time.Time      `form:"createdBefore" time_format:"2006-01-02T15:04:05Z07:00"`
+	Barcode       string          `form:"barcode"`
+}
+
+func (r *FindItemRequest) Filter() domain.ItemFilter {
+	return domain.ItemFilter{
+		Categories:    r.Categories,
+		Size:          r.Size,
+		MinPrice:      r.MinPrice,
+		MaxPrice:      r.MaxPrice,
+		MinCost:       r.MinCost,
+		MaxCost:       r.MaxCost,
+		ExpiresAfter:  r.ExpiresAfter,
+		ExpiresBefore: r.ExpiresBefore,
+		CreatedAfter:  r.CreatedAfter,
+		CreatedBefore: r.CreatedBefore,
+		Barcode:       r.Barcode,
+	}
 }
 
 type FindItemResponse struct {
-	TotalCount  int               `json:"totalCount"`
-	Items       []GetItemResponse `json:"items"`
-	HasNext     bool              `json:"hasNext"`
-	SearchAfter int               `json:"searchAfter"`
+	// TotalCount withTotal 쿼리 파라메터가 true인 경우에만 응답합니다.
+	TotalCount *int `json:"totalCount,omitempty"`
+	// Facets withFacets 쿼리 파라메터가 true인 경우에만 응답합니다.
+	Facets  *ItemFacetsResponse    `json:"facets,omitempty"`
+	Items   []FindItemResponseItem `json:"items"`
+	HasNext bool                   `json:"hasNext"`
+	HasPrev bool                   `json:"hasPrev"`
+	// NextCursor 다음 페이지가 없으면 빈 문자열입니다.
+	NextCursor string `json:"nextCursor"`
+	// PrevCursor 이전 페이지가 없으면 빈 문자열입니다.
+	PrevCursor string `json:"prevCursor"`
+}
+
+// ItemFacetsResponse 페이지와 관계없이 조회 조건을 만족하는 아이템 전체의 집계입니다.
+type ItemFacetsResponse struct {
+	Categories   []ItemFacetCountResponse  `json:"categories"`
+	Sizes        []ItemFacetCountResponse  `json:"sizes"`
+	PriceBuckets []ItemPriceBucketResponse `json:"priceBuckets"`
+}
+
+type ItemFacetCountResponse struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// ItemPriceBucketResponse min 이상 max 미만의 가격 구간이며, max가 없으면 상한이 없습니다.
+type ItemPriceBucketResponse struct {
+	Min   int  `json:"min"`
+	Max   *int `json:"max,omitempty"`
+	Count int  `json:"count"`
+}
+
+func newItemFacetsResponse(facets *domain.ItemFacets) *ItemFacetsResponse {
+	resp := &ItemFacetsResponse{
+		Categories:   make([]ItemFacetCountResponse, len(facets.Categories)),
+		Sizes:        make([]ItemFacetCountResponse, len(facets.Sizes)),
+		PriceBuckets: make([]ItemPriceBucketResponse, len(facets.PriceBuckets)),
+	}
+	for i, facet := range facets.Categories {
+		resp.Categories[i] = ItemFacetCountResponse{Value: facet.Value, Count: facet.Count}
+	}
+	for i, facet := range facets.Sizes {
+		resp.Sizes[i] = ItemFacetCountResponse{Value: facet.Value, Count: facet.Count}
+	}
+	for i, bucket := range facets.PriceBuckets {
+		resp.PriceBuckets[i] = ItemPriceBucketResponse{Min: bucket.Min, Max: bucket.Max, Count: bucket.Count}
+	}
+
+	return resp
+}
+
+// InvalidQueryDetails keyword의 문법이 올바르지 않은 경우 에러 응답에 포함되는 정보입니다.
+type InvalidQueryDetails struct {
+	// Position 에러가 발생한 keyword의 문자(rune) 위치입니다.
+	Position int    `json:"position"`
+	Reason   string `json:"reason"`
+}
+
+type FindItemResponseItem struct {
+	GetItemResponse
+	// Highlight keyword로 검색한 경우에만 응답합니다.
+	Highlight *ItemHighlightResponse `json:"highlight,omitempty"`
+}
+
+// ItemHighlightResponse 아이템 이름에서 검색 키워드와 일치한 부분입니다.
+type ItemHighlightResponse struct {
+	// Chosung 이름의 초성으로 일치한 경우 true입니다.
+	Chosung bool `json:"chosung"`
+	// Spans 이름에서 일치한 범위이며, 문자(rune) 단위의 위치입니다.
+	Spans []TextSpanResponse `json:"spans"`
+}
+
+func newItemHighlightResponse(highlight domain.ItemHighlight) *ItemHighlightResponse {
+	resp := &ItemHighlightResponse{
+		Chosung: highlight.Chosung,
+		Spans:   make([]TextSpanResponse, len(highlight.Spans)),
+	}
+	for i, span := range highlight.Spans {
+		resp.Spans[i] = TextSpanResponse{Start: span.Start, End: span.End}
+	}
+
+	return resp
+}
+
+// TextSpanResponse start 이상 end 미만의 범위입니다.
+type TextSpanResponse struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+type DeleteItemRequest struct {
+	Permanent bool `form:"permanent"`
+}
+
+type FindTrashRequest struct {
+	Cursor    string `form:"cursor"`
+	WithTotal bool   `form:"withTotal"`
+	// Limit 한 페이지의 아이템 수이며, 생략하면 서버의 기본값을 사용합니다.
+	Limit *int `form:"limit"`
+}
+
+type TrashItemResponse struct {
+	ID          int             `json:"id"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Price       int             `json:"price"`
+	Cost        int             `json:"cost"`
+	Category    string          `json:"category"`
+	Barcode     string          `json:"barcode"`
+	Size        domain.ItemSize `json:"size"`
+	ExpiryAt    time.Time       `json:"expiryAt"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	Version     int             `json:"version"`
+	DeletedAt   *time.Time      `json:"deletedAt"`
+}
+
+type FindTrashResponse struct {
+	// TotalCount withTotal 쿼리 파라메터가 true인 경우에만 응답합니다.
+	TotalCount *int                `json:"totalCount,omitempty"`
+	Items      []TrashItemResponse `json:"items"`
+	HasNext    bool                `json:"hasNext"`
+	HasPrev    bool                `json:"hasPrev"`
+	// NextCursor 다음 페이지가 없으면 빈 문자열입니다.
+	NextCursor string `json:"nextCursor"`
+	// PrevCursor 이전 페이지가 없으면 빈 문자열입니다.
+	PrevCursor string `json:"prevCursor"`
+}
+
+type SuggestItemRequest struct {
+	// Q 입력 중인 검색어입니다.
+	Q string `form:"q"`
+	// Limit 최대 결과 수이며, 생략하면 item.DefaultSuggestLimit를 사용합니다.
+	Limit *int `form:"limit"`
+}
+
+type ItemSuggestionResponse struct {
+	Text string                    `json:"text"`
+	Type domain.ItemSuggestionType `json:"type"`
+}
+
+type SuggestItemResponse struct {
+	Suggestions []ItemSuggestionResponse `json:"suggestions"`
+}
+
+type ItemHistoryRequest struct {
+	SearchAfter int `form:"searchAfter"`
+}
+
+type ItemFieldChangeResponse struct {
+	Field    string  `json:"field"`
+	OldValue *string `json:"old"`
+	NewValue *string `json:"new"`
+}
+
+type ItemHistoryResponse struct {
+	ID        int                       `json:"id"`
+	ItemID    int                       `json:"itemId"`
+	ActorID   int                       `json:"actorId"`
+	RequestID string                    `json:"requestId"`
+	Action    domain.ItemHistoryAction  `json:"action"`
+	Changes   []ItemFieldChangeResponse `json:"changes"`
+	CreatedAt time.Time                 `json:"createdAt"`
+}
+
+type ItemHistoryListResponse struct {
+	Histories   []ItemHistoryResponse `json:"histories"`
+	HasNext     bool                  `json:"hasNext"`
+	SearchAfter int                   `json:"searchAfter"`
 }
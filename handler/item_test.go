@@ -23,16 +23,20 @@ import (
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/psi59/payhere-assignment/domain"
 
+	"github.com/gin-contrib/requestid"
 	"github.com/gin-gonic/gin"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+var testItemHandlerConfig = ItemHandlerConfig{DefaultFindLimit: 10, MaxFindLimit: 50}
+
 func TestNewItemHandler(t *testing.T) {
 	itemUsecase := &item.Service{}
 	type args struct {
 		itemUsecase item.Usecase
+		config      ItemHandlerConfig
 	}
 	tests := []struct {
 		name    string
@@ -43,6 +47,7 @@ func TestNewItemHandler(t *testing.T) {
 			name: "OK",
 			args: args{
 				itemUsecase: itemUsecase,
+				config:      testItemHandlerConfig,
 			},
 			wantErr: false,
 		},
@@ -50,13 +55,29 @@ func TestNewItemHandler(t *testing.T) {
 			name: "nil itemUsecase",
 			args: args{
 				itemUsecase: nil,
+				config:      testItemHandlerConfig,
+			},
+			wantErr: true,
+		},
+		{
+			name: "default limit > max limit",
+			args: args{
+				itemUsecase: itemUsecase,
+				config:      ItemHandlerConfig{DefaultFindLimit: 20, MaxFindLimit: 10},
+			},
+			wantErr: true,
+		},
+		{
+			name: "zero limit",
+			args: args{
+				itemUsecase: itemUsecase,
 			},
 			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := NewItemHandler(tt.args.itemUsecase)
+			got, err := NewItemHandler(tt.args.itemUsecase, tt.args.config)
 			if tt.wantErr {
 				assert.Error(t, err)
 				assert.Nil(t, got)
@@ -74,7 +95,7 @@ func TestItemHandler_Create(t *testing.T) {
 
 	itemUsecase := ucmocks.NewMockItemTokenUsecase(ctrl)
 	r := gin.New()
-	handler, err := NewItemHandler(itemUsecase)
+	handler, err := NewItemHandler(itemUsecase, testItemHandlerConfig)
 	assert.NoError(t, err)
 	assert.NotNil(t, handler)
 
@@ -110,6 +131,7 @@ func TestItemHandler_Create(t *testing.T) {
 			ExpiryAt:    createItemRequest.ExpiryAt,
 			Size:        createItemRequest.Size,
 			CreatedAt:   time.Unix(gofakeit.FutureDate().Unix(), 0).UTC(),
+			Version:     1,
 		}
 
 		itemUsecase.EXPECT().Create(gomock.Any(), &item.CreateInput{
@@ -148,7 +170,9 @@ func TestItemHandler_Create(t *testing.T) {
 			Size:        itemDomain.Size,
 			ExpiryAt:    itemDomain.ExpiryAt,
 			CreatedAt:   itemDomain.CreatedAt,
+			Version:     itemDomain.Version,
 		}, resp.Data)
+		assert.Equal(t, `"1"`, responseWriter.Header().Get("ETag"))
 	})
 
 	t.Run("binding error", func(t *testing.T) {
@@ -309,6 +333,41 @@ func TestItemHandler_Create(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, resp.Meta.Code)
 		assert.Equal(t, i18n.T(language.English, i18n.InternalError, nil), resp.Meta.Message)
 	})
+
+	t.Run("이름이 비슷한 아이템이 있는 경우", func(t *testing.T) {
+		createItemRequest := &CreateItemRequest{
+			Name:        "아이스아메리카노",
+			Description: gofakeit.SentenceSimple(),
+			Price:       gofakeit.Number(1, 10000),
+			Cost:        gofakeit.Number(1, 10000),
+			Category:    "coffee",
+			Barcode:     gofakeit.Numerify("################"),
+			Size:        domain.ItemSizeSmall,
+			ExpiryAt:    time.Unix(gofakeit.FutureDate().Unix(), 0).UTC(),
+		}
+		similarItem := domain.Item{ID: gofakeit.Number(1, 10), Name: "아이스 아메리카노"}
+		itemUsecase.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, &item.SimilarItemsError{
+			Items: []domain.SimilarItem{{Item: similarItem, Reason: domain.ItemDuplicateReasonExact}},
+		})
+
+		responseWriter := httptest.NewRecorder()
+		buf := bytes.NewBuffer(nil)
+		err := json.NewEncoder(buf).Encode(createItemRequest)
+		require.NoError(t, err)
+		httpRequest, err := http.NewRequest(http.MethodPost, "/", buf)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		details := &SimilarItemsDetails{}
+		resp := ginhelper.Response{Meta: ginhelper.ResponseMeta{Details: details}}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusConflict, responseWriter.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.SimilarItemExists, nil), resp.Meta.Message)
+		assert.Equal(t, &SimilarItemsDetails{SimilarItems: []SimilarItemResponse{
+			{ID: similarItem.ID, Name: similarItem.Name, Reason: domain.ItemDuplicateReasonExact},
+		}}, details)
+	})
 }
 
 func TestItemHandler_Get(t *testing.T) {
@@ -317,7 +376,7 @@ func TestItemHandler_Get(t *testing.T) {
 
 	itemUsecase := ucmocks.NewMockItemTokenUsecase(ctrl)
 	r := gin.New()
-	handler, err := NewItemHandler(itemUsecase)
+	handler, err := NewItemHandler(itemUsecase, testItemHandlerConfig)
 	assert.NoError(t, err)
 	assert.NotNil(t, handler)
 
@@ -357,7 +416,9 @@ func TestItemHandler_Get(t *testing.T) {
 			Size:        itemDomain.Size,
 			ExpiryAt:    itemDomain.ExpiryAt,
 			CreatedAt:   itemDomain.CreatedAt,
+			Version:     itemDomain.Version,
 		}, responseData)
+		assert.Equal(t, `"1"`, responseWriter.Header().Get("ETag"))
 	})
 
 	t.Run("invalid itemID", func(t *testing.T) {
@@ -438,7 +499,7 @@ func TestItemHandler_Delete(t *testing.T) {
 
 	itemUsecase := ucmocks.NewMockItemTokenUsecase(ctrl)
 	r := gin.New()
-	handler, err := NewItemHandler(itemUsecase)
+	handler, err := NewItemHandler(itemUsecase, testItemHandlerConfig)
 	assert.NoError(t, err)
 	assert.NotNil(t, handler)
 
@@ -465,6 +526,57 @@ func TestItemHandler_Delete(t *testing.T) {
 		assert.Equal(t, http.StatusNoContent, responseWriter.Code)
 	})
 
+	t.Run("If-Match", func(t *testing.T) {
+		itemDomain := newTestItem(t, userDomain.ID)
+		itemUsecase.EXPECT().Delete(gomock.Any(), &item.DeleteInput{
+			User:    userDomain,
+			ItemID:  itemDomain.ID,
+			Version: 3,
+		}).Return(nil)
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("/items/%d", itemDomain.ID), nil)
+		require.NoError(t, err)
+		httpRequest.Header.Set("If-Match", `"3"`)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		assert.Equal(t, http.StatusNoContent, responseWriter.Code)
+	})
+
+	t.Run("invalid If-Match", func(t *testing.T) {
+		itemDomain := newTestItem(t, userDomain.ID)
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("/items/%d", itemDomain.ID), nil)
+		require.NoError(t, err)
+		httpRequest.Header.Set("If-Match", `W/"3"`)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		resp := ginhelper.Response{}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, responseWriter.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.InvalidRequest, nil), resp.Meta.Message)
+	})
+
+	t.Run("version mismatch", func(t *testing.T) {
+		itemDomain := newTestItem(t, userDomain.ID)
+		itemUsecase.EXPECT().Delete(gomock.Any(), &item.DeleteInput{
+			User:    userDomain,
+			ItemID:  itemDomain.ID,
+			Version: 1,
+		}).Return(domain.ErrItemVersionMismatch)
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("/items/%d", itemDomain.ID), nil)
+		require.NoError(t, err)
+		httpRequest.Header.Set("If-Match", `"1"`)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		resp := ginhelper.Response{}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusPreconditionFailed, responseWriter.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.ItemVersionMismatch, nil), resp.Meta.Message)
+	})
+
 	t.Run("invalid itemID", func(t *testing.T) {
 		responseWriter := httptest.NewRecorder()
 		httpRequest, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("/items/%s", gofakeit.UUID()), nil)
@@ -535,44 +647,66 @@ func TestItemHandler_Delete(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, resp.Meta.Code)
 		assert.Equal(t, i18n.T(language.English, i18n.InternalError, nil), resp.Meta.Message)
 	})
+
+	t.Run("영구 삭제", func(t *testing.T) {
+		itemDomain := newTestItem(t, userDomain.ID)
+		itemUsecase.EXPECT().Delete(gomock.Any(), &item.DeleteInput{
+			User:      userDomain,
+			ItemID:    itemDomain.ID,
+			Permanent: true,
+		}).Return(nil)
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("/items/%d?permanent=true", itemDomain.ID), nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		assert.Equal(t, http.StatusNoContent, responseWriter.Code)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		itemDomain := newTestItem(t, userDomain.ID)
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("/items/%d?permanent=%s", itemDomain.ID, gofakeit.UUID()), nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		responseData := &GetItemResponse{}
+		resp := ginhelper.Response{Data: responseData}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, responseWriter.Code)
+		assert.Equal(t, http.StatusBadRequest, resp.Meta.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.InvalidRequest, nil), resp.Meta.Message)
+	})
 }
 
-func TestItemHandler_Update(t *testing.T) {
+func TestItemHandler_Restore(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	itemUsecase := ucmocks.NewMockItemTokenUsecase(ctrl)
 	r := gin.New()
-	handler, err := NewItemHandler(itemUsecase)
+	handler, err := NewItemHandler(itemUsecase, testItemHandlerConfig)
 	assert.NoError(t, err)
 	assert.NotNil(t, handler)
 
-	name := gofakeit.Drink()
 	userDomain := newTestUser(t, gofakeit.Password(true, true, true, true, true, 10))
-	r.PUT("/items/:itemId", ginhelper.ContextMiddleware(), func(ginCtx *gin.Context) {
+	r.POST("/items/:itemId/restore", ginhelper.ContextMiddleware(), func(ginCtx *gin.Context) {
 		ctx := ginhelper.GetContext(ginCtx)
 		ctx = context.WithValue(ctx, domain.CtxKeyUser, userDomain)
 		ginhelper.SetContext(ginCtx, ctx)
 		ginCtx.Next()
-	}, handler.Update)
-	r.PUT("/unauthorized/:itemId", handler.Update)
+	}, handler.Restore)
+	r.POST("/unauthorized/:itemId/restore", handler.Restore)
 
 	t.Run("OK", func(t *testing.T) {
-		updateItemRequest := &UpdateItemRequest{
-			Name: &name,
-		}
 		itemDomain := newTestItem(t, userDomain.ID)
-		itemUsecase.EXPECT().Update(gomock.Any(), &item.UpdateInput{
+		itemUsecase.EXPECT().Restore(gomock.Any(), &item.RestoreInput{
 			User:   userDomain,
 			ItemID: itemDomain.ID,
-			Name:   &name,
 		}).Return(nil)
-
 		responseWriter := httptest.NewRecorder()
-		buf := bytes.NewBuffer(nil)
-		err := json.NewEncoder(buf).Encode(updateItemRequest)
-		require.NoError(t, err)
-		httpRequest, err := http.NewRequest(http.MethodPut, fmt.Sprintf("/items/%d", itemDomain.ID), buf)
+		httpRequest, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/items/%d/restore", itemDomain.ID), nil)
 		require.NoError(t, err)
 		r.ServeHTTP(responseWriter, httpRequest)
 
@@ -580,107 +714,108 @@ func TestItemHandler_Update(t *testing.T) {
 	})
 
 	t.Run("invalid itemID", func(t *testing.T) {
-		updateItemRequest := &UpdateItemRequest{
-			Name: &name,
-		}
 		responseWriter := httptest.NewRecorder()
-		buf := bytes.NewBuffer(nil)
-		err := json.NewEncoder(buf).Encode(updateItemRequest)
-		require.NoError(t, err)
-		httpRequest, err := http.NewRequest(http.MethodPut, fmt.Sprintf("/items/%s", gofakeit.UUID()), buf)
+		httpRequest, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/items/%s/restore", gofakeit.UUID()), nil)
 		require.NoError(t, err)
 		r.ServeHTTP(responseWriter, httpRequest)
 
-		responseData := &GetItemResponse{}
-		resp := ginhelper.Response{Data: responseData}
+		resp := ginhelper.Response{}
 		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
 		require.NoError(t, err)
 		assert.Equal(t, http.StatusNotFound, responseWriter.Code)
-		assert.Equal(t, http.StatusNotFound, resp.Meta.Code)
 		assert.Equal(t, i18n.T(language.English, i18n.ItemNotFound, nil), resp.Meta.Message)
 	})
 
 	t.Run("unauthorized", func(t *testing.T) {
 		itemDomain := newTestItem(t, userDomain.ID)
 		responseWriter := httptest.NewRecorder()
-		httpRequest, err := http.NewRequest(http.MethodPut, fmt.Sprintf("/unauthorized/%d", itemDomain.ID), nil)
+		httpRequest, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/unauthorized/%d/restore", itemDomain.ID), nil)
 		require.NoError(t, err)
 		r.ServeHTTP(responseWriter, httpRequest)
 
-		responseData := &GetItemResponse{}
-		resp := ginhelper.Response{Data: responseData}
+		resp := ginhelper.Response{}
 		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
 		require.NoError(t, err)
 		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
-		assert.Equal(t, http.StatusInternalServerError, resp.Meta.Code)
 		assert.Equal(t, i18n.T(language.English, i18n.InternalError, nil), resp.Meta.Message)
 	})
 
-	t.Run("binding error", func(t *testing.T) {
+	t.Run("item not found", func(t *testing.T) {
 		itemDomain := newTestItem(t, userDomain.ID)
+		itemUsecase.EXPECT().Restore(gomock.Any(), &item.RestoreInput{
+			User:   userDomain,
+			ItemID: itemDomain.ID,
+		}).Return(domain.ErrItemNotFound)
 		responseWriter := httptest.NewRecorder()
-		buf := bytes.NewBuffer(nil)
-		err := json.NewEncoder(buf).Encode(map[string]any{
-			"name": 1,
-		})
-		require.NoError(t, err)
-		httpRequest, err := http.NewRequest(http.MethodPut, fmt.Sprintf("/items/%d", itemDomain.ID), buf)
+		httpRequest, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/items/%d/restore", itemDomain.ID), nil)
 		require.NoError(t, err)
 		r.ServeHTTP(responseWriter, httpRequest)
 
-		responseData := &GetItemResponse{}
-		resp := ginhelper.Response{Data: responseData}
+		resp := ginhelper.Response{}
 		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
 		require.NoError(t, err)
-		assert.Equal(t, http.StatusBadRequest, responseWriter.Code)
-		assert.Equal(t, http.StatusBadRequest, resp.Meta.Code)
-		assert.Equal(t, i18n.T(language.English, i18n.InvalidRequest, nil), resp.Meta.Message)
+		assert.Equal(t, http.StatusNotFound, responseWriter.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.ItemNotFound, nil), resp.Meta.Message)
 	})
 
-	t.Run("invalid request", func(t *testing.T) {
-		emptyName := ""
+	t.Run("중복된 아이템", func(t *testing.T) {
 		itemDomain := newTestItem(t, userDomain.ID)
-		updateItemRequest := &UpdateItemRequest{
-			Name: &emptyName,
-		}
+		itemUsecase.EXPECT().Restore(gomock.Any(), &item.RestoreInput{
+			User:   userDomain,
+			ItemID: itemDomain.ID,
+		}).Return(domain.ErrItemAlreadyExists)
 		responseWriter := httptest.NewRecorder()
-		buf := bytes.NewBuffer(nil)
-		err := json.NewEncoder(buf).Encode(updateItemRequest)
-		require.NoError(t, err)
-		httpRequest, err := http.NewRequest(http.MethodPut, fmt.Sprintf("/items/%d", itemDomain.ID), buf)
+		httpRequest, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/items/%d/restore", itemDomain.ID), nil)
 		require.NoError(t, err)
 		r.ServeHTTP(responseWriter, httpRequest)
 
-		responseData := &GetItemResponse{}
-		resp := ginhelper.Response{Data: responseData}
+		resp := ginhelper.Response{}
 		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
 		require.NoError(t, err)
-		assert.Equal(t, http.StatusBadRequest, responseWriter.Code)
-		assert.Equal(t, http.StatusBadRequest, resp.Meta.Code)
-		assert.Equal(t, i18n.T(language.English, i18n.InvalidRequest, nil), resp.Meta.Message)
+		assert.Equal(t, http.StatusConflict, responseWriter.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.ItemAlreadyExists, nil), resp.Meta.Message)
 	})
 
-	t.Run("invalid request", func(t *testing.T) {
+	t.Run("unexpected error", func(t *testing.T) {
 		itemDomain := newTestItem(t, userDomain.ID)
-		updateItemRequest := &UpdateItemRequest{}
+		itemUsecase.EXPECT().Restore(gomock.Any(), &item.RestoreInput{
+			User:   userDomain,
+			ItemID: itemDomain.ID,
+		}).Return(gofakeit.Error())
 		responseWriter := httptest.NewRecorder()
-		buf := bytes.NewBuffer(nil)
-		err := json.NewEncoder(buf).Encode(updateItemRequest)
-		require.NoError(t, err)
-		httpRequest, err := http.NewRequest(http.MethodPut, fmt.Sprintf("/items/%d", itemDomain.ID), buf)
+		httpRequest, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/items/%d/restore", itemDomain.ID), nil)
 		require.NoError(t, err)
 		r.ServeHTTP(responseWriter, httpRequest)
 
-		responseData := &GetItemResponse{}
-		resp := ginhelper.Response{Data: responseData}
+		resp := ginhelper.Response{}
 		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
 		require.NoError(t, err)
-		assert.Equal(t, http.StatusBadRequest, responseWriter.Code)
-		assert.Equal(t, http.StatusBadRequest, resp.Meta.Code)
-		assert.Equal(t, i18n.T(language.English, i18n.InvalidRequest, nil), resp.Meta.Message)
+		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.InternalError, nil), resp.Meta.Message)
 	})
+}
 
-	t.Run("item not found", func(t *testing.T) {
+func TestItemHandler_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemUsecase := ucmocks.NewMockItemTokenUsecase(ctrl)
+	r := gin.New()
+	handler, err := NewItemHandler(itemUsecase, testItemHandlerConfig)
+	assert.NoError(t, err)
+	assert.NotNil(t, handler)
+
+	name := gofakeit.Drink()
+	userDomain := newTestUser(t, gofakeit.Password(true, true, true, true, true, 10))
+	r.PUT("/items/:itemId", ginhelper.ContextMiddleware(), func(ginCtx *gin.Context) {
+		ctx := ginhelper.GetContext(ginCtx)
+		ctx = context.WithValue(ctx, domain.CtxKeyUser, userDomain)
+		ginhelper.SetContext(ginCtx, ctx)
+		ginCtx.Next()
+	}, handler.Update)
+	r.PUT("/unauthorized/:itemId", handler.Update)
+
+	t.Run("OK", func(t *testing.T) {
 		updateItemRequest := &UpdateItemRequest{
 			Name: &name,
 		}
@@ -689,7 +824,7 @@ func TestItemHandler_Update(t *testing.T) {
 			User:   userDomain,
 			ItemID: itemDomain.ID,
 			Name:   &name,
-		}).Return(domain.ErrItemNotFound)
+		}).Return(&item.UpdateOutput{Version: itemDomain.Version + 1}, nil)
 
 		responseWriter := httptest.NewRecorder()
 		buf := bytes.NewBuffer(nil)
@@ -699,25 +834,23 @@ func TestItemHandler_Update(t *testing.T) {
 		require.NoError(t, err)
 		r.ServeHTTP(responseWriter, httpRequest)
 
-		responseData := &GetItemResponse{}
-		resp := ginhelper.Response{Data: responseData}
-		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
-		require.NoError(t, err)
-		assert.Equal(t, http.StatusNotFound, responseWriter.Code)
-		assert.Equal(t, http.StatusNotFound, resp.Meta.Code)
-		assert.Equal(t, i18n.T(language.English, i18n.ItemNotFound, nil), resp.Meta.Message)
+		assert.Equal(t, http.StatusNoContent, responseWriter.Code)
+		assert.Equal(t, itemETag(itemDomain.Version+1), responseWriter.Header().Get("ETag"))
 	})
 
-	t.Run("중복된 아이템", func(t *testing.T) {
+	t.Run("이름이 비슷한 아이템 경고", func(t *testing.T) {
 		updateItemRequest := &UpdateItemRequest{
 			Name: &name,
 		}
 		itemDomain := newTestItem(t, userDomain.ID)
+		similarItem := newTestItem(t, userDomain.ID)
 		itemUsecase.EXPECT().Update(gomock.Any(), &item.UpdateInput{
 			User:   userDomain,
 			ItemID: itemDomain.ID,
 			Name:   &name,
-		}).Return(domain.ErrItemAlreadyExists)
+		}).Return(&item.UpdateOutput{
+			SimilarItems: []domain.SimilarItem{{Item: *similarItem, Reason: domain.ItemDuplicateReasonSimilar}},
+		}, nil)
 
 		responseWriter := httptest.NewRecorder()
 		buf := bytes.NewBuffer(nil)
@@ -727,25 +860,27 @@ func TestItemHandler_Update(t *testing.T) {
 		require.NoError(t, err)
 		r.ServeHTTP(responseWriter, httpRequest)
 
-		responseData := &GetItemResponse{}
+		responseData := &UpdateItemResponse{}
 		resp := ginhelper.Response{Data: responseData}
 		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
 		require.NoError(t, err)
-		assert.Equal(t, http.StatusConflict, responseWriter.Code)
-		assert.Equal(t, http.StatusConflict, resp.Meta.Code)
-		assert.Equal(t, i18n.T(language.English, i18n.ItemAlreadyExists, nil), resp.Meta.Message)
+		assert.Equal(t, http.StatusOK, responseWriter.Code)
+		assert.Equal(t, &UpdateItemResponse{SimilarItems: []SimilarItemResponse{
+			{ID: similarItem.ID, Name: similarItem.Name, Reason: domain.ItemDuplicateReasonSimilar},
+		}}, responseData)
 	})
 
-	t.Run("unexpected error", func(t *testing.T) {
+	t.Run("version mismatch", func(t *testing.T) {
 		updateItemRequest := &UpdateItemRequest{
 			Name: &name,
 		}
 		itemDomain := newTestItem(t, userDomain.ID)
 		itemUsecase.EXPECT().Update(gomock.Any(), &item.UpdateInput{
-			User:   userDomain,
-			ItemID: itemDomain.ID,
-			Name:   &name,
-		}).Return(gofakeit.Error())
+			User:    userDomain,
+			ItemID:  itemDomain.ID,
+			Name:    &name,
+			Version: 2,
+		}).Return(nil, domain.ErrItemVersionMismatch)
 
 		responseWriter := httptest.NewRecorder()
 		buf := bytes.NewBuffer(nil)
@@ -753,29 +888,234 @@ func TestItemHandler_Update(t *testing.T) {
 		require.NoError(t, err)
 		httpRequest, err := http.NewRequest(http.MethodPut, fmt.Sprintf("/items/%d", itemDomain.ID), buf)
 		require.NoError(t, err)
+		httpRequest.Header.Set("If-Match", `"2"`)
 		r.ServeHTTP(responseWriter, httpRequest)
 
-		responseData := &GetItemResponse{}
-		resp := ginhelper.Response{Data: responseData}
+		resp := ginhelper.Response{}
 		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
 		require.NoError(t, err)
-		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
-		assert.Equal(t, http.StatusInternalServerError, resp.Meta.Code)
-		assert.Equal(t, i18n.T(language.English, i18n.InternalError, nil), resp.Meta.Message)
+		assert.Equal(t, http.StatusPreconditionFailed, responseWriter.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.ItemVersionMismatch, nil), resp.Meta.Message)
 	})
-}
-
-func TestItemHandler_Find(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
 
-	itemUsecase := ucmocks.NewMockItemTokenUsecase(ctrl)
-	r := gin.New()
-	handler, err := NewItemHandler(itemUsecase)
-	assert.NoError(t, err)
-	assert.NotNil(t, handler)
+	t.Run("invalid If-Match", func(t *testing.T) {
+		updateItemRequest := &UpdateItemRequest{
+			Name: &name,
+		}
+		itemDomain := newTestItem(t, userDomain.ID)
+		responseWriter := httptest.NewRecorder()
+		buf := bytes.NewBuffer(nil)
+		err := json.NewEncoder(buf).Encode(updateItemRequest)
+		require.NoError(t, err)
+		httpRequest, err := http.NewRequest(http.MethodPut, fmt.Sprintf("/items/%d", itemDomain.ID), buf)
+		require.NoError(t, err)
+		httpRequest.Header.Set("If-Match", "abc")
+		r.ServeHTTP(responseWriter, httpRequest)
 
-	userDomain := newTestUser(t, gofakeit.Password(true, true, true, true, true, 10))
+		resp := ginhelper.Response{}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, responseWriter.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.InvalidRequest, nil), resp.Meta.Message)
+	})
+
+	t.Run("invalid itemID", func(t *testing.T) {
+		updateItemRequest := &UpdateItemRequest{
+			Name: &name,
+		}
+		responseWriter := httptest.NewRecorder()
+		buf := bytes.NewBuffer(nil)
+		err := json.NewEncoder(buf).Encode(updateItemRequest)
+		require.NoError(t, err)
+		httpRequest, err := http.NewRequest(http.MethodPut, fmt.Sprintf("/items/%s", gofakeit.UUID()), buf)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		responseData := &GetItemResponse{}
+		resp := ginhelper.Response{Data: responseData}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, responseWriter.Code)
+		assert.Equal(t, http.StatusNotFound, resp.Meta.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.ItemNotFound, nil), resp.Meta.Message)
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		itemDomain := newTestItem(t, userDomain.ID)
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodPut, fmt.Sprintf("/unauthorized/%d", itemDomain.ID), nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		responseData := &GetItemResponse{}
+		resp := ginhelper.Response{Data: responseData}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
+		assert.Equal(t, http.StatusInternalServerError, resp.Meta.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.InternalError, nil), resp.Meta.Message)
+	})
+
+	t.Run("binding error", func(t *testing.T) {
+		itemDomain := newTestItem(t, userDomain.ID)
+		responseWriter := httptest.NewRecorder()
+		buf := bytes.NewBuffer(nil)
+		err := json.NewEncoder(buf).Encode(map[string]any{
+			"name": 1,
+		})
+		require.NoError(t, err)
+		httpRequest, err := http.NewRequest(http.MethodPut, fmt.Sprintf("/items/%d", itemDomain.ID), buf)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		responseData := &GetItemResponse{}
+		resp := ginhelper.Response{Data: responseData}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, responseWriter.Code)
+		assert.Equal(t, http.StatusBadRequest, resp.Meta.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.InvalidRequest, nil), resp.Meta.Message)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		emptyName := ""
+		itemDomain := newTestItem(t, userDomain.ID)
+		updateItemRequest := &UpdateItemRequest{
+			Name: &emptyName,
+		}
+		responseWriter := httptest.NewRecorder()
+		buf := bytes.NewBuffer(nil)
+		err := json.NewEncoder(buf).Encode(updateItemRequest)
+		require.NoError(t, err)
+		httpRequest, err := http.NewRequest(http.MethodPut, fmt.Sprintf("/items/%d", itemDomain.ID), buf)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		responseData := &GetItemResponse{}
+		resp := ginhelper.Response{Data: responseData}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, responseWriter.Code)
+		assert.Equal(t, http.StatusBadRequest, resp.Meta.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.InvalidRequest, nil), resp.Meta.Message)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		itemDomain := newTestItem(t, userDomain.ID)
+		updateItemRequest := &UpdateItemRequest{}
+		responseWriter := httptest.NewRecorder()
+		buf := bytes.NewBuffer(nil)
+		err := json.NewEncoder(buf).Encode(updateItemRequest)
+		require.NoError(t, err)
+		httpRequest, err := http.NewRequest(http.MethodPut, fmt.Sprintf("/items/%d", itemDomain.ID), buf)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		responseData := &GetItemResponse{}
+		resp := ginhelper.Response{Data: responseData}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, responseWriter.Code)
+		assert.Equal(t, http.StatusBadRequest, resp.Meta.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.InvalidRequest, nil), resp.Meta.Message)
+	})
+
+	t.Run("item not found", func(t *testing.T) {
+		updateItemRequest := &UpdateItemRequest{
+			Name: &name,
+		}
+		itemDomain := newTestItem(t, userDomain.ID)
+		itemUsecase.EXPECT().Update(gomock.Any(), &item.UpdateInput{
+			User:   userDomain,
+			ItemID: itemDomain.ID,
+			Name:   &name,
+		}).Return(nil, domain.ErrItemNotFound)
+
+		responseWriter := httptest.NewRecorder()
+		buf := bytes.NewBuffer(nil)
+		err := json.NewEncoder(buf).Encode(updateItemRequest)
+		require.NoError(t, err)
+		httpRequest, err := http.NewRequest(http.MethodPut, fmt.Sprintf("/items/%d", itemDomain.ID), buf)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		responseData := &GetItemResponse{}
+		resp := ginhelper.Response{Data: responseData}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, responseWriter.Code)
+		assert.Equal(t, http.StatusNotFound, resp.Meta.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.ItemNotFound, nil), resp.Meta.Message)
+	})
+
+	t.Run("중복된 아이템", func(t *testing.T) {
+		updateItemRequest := &UpdateItemRequest{
+			Name: &name,
+		}
+		itemDomain := newTestItem(t, userDomain.ID)
+		itemUsecase.EXPECT().Update(gomock.Any(), &item.UpdateInput{
+			User:   userDomain,
+			ItemID: itemDomain.ID,
+			Name:   &name,
+		}).Return(nil, domain.ErrItemAlreadyExists)
+
+		responseWriter := httptest.NewRecorder()
+		buf := bytes.NewBuffer(nil)
+		err := json.NewEncoder(buf).Encode(updateItemRequest)
+		require.NoError(t, err)
+		httpRequest, err := http.NewRequest(http.MethodPut, fmt.Sprintf("/items/%d", itemDomain.ID), buf)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		responseData := &GetItemResponse{}
+		resp := ginhelper.Response{Data: responseData}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusConflict, responseWriter.Code)
+		assert.Equal(t, http.StatusConflict, resp.Meta.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.ItemAlreadyExists, nil), resp.Meta.Message)
+	})
+
+	t.Run("unexpected error", func(t *testing.T) {
+		updateItemRequest := &UpdateItemRequest{
+			Name: &name,
+		}
+		itemDomain := newTestItem(t, userDomain.ID)
+		itemUsecase.EXPECT().Update(gomock.Any(), &item.UpdateInput{
+			User:   userDomain,
+			ItemID: itemDomain.ID,
+			Name:   &name,
+		}).Return(nil, gofakeit.Error())
+
+		responseWriter := httptest.NewRecorder()
+		buf := bytes.NewBuffer(nil)
+		err := json.NewEncoder(buf).Encode(updateItemRequest)
+		require.NoError(t, err)
+		httpRequest, err := http.NewRequest(http.MethodPut, fmt.Sprintf("/items/%d", itemDomain.ID), buf)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		responseData := &GetItemResponse{}
+		resp := ginhelper.Response{Data: responseData}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
+		assert.Equal(t, http.StatusInternalServerError, resp.Meta.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.InternalError, nil), resp.Meta.Message)
+	})
+}
+
+func TestItemHandler_Find(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemUsecase := ucmocks.NewMockItemTokenUsecase(ctrl)
+	r := gin.New()
+	handler, err := NewItemHandler(itemUsecase, testItemHandlerConfig)
+	assert.NoError(t, err)
+	assert.NotNil(t, handler)
+
+	userDomain := newTestUser(t, gofakeit.Password(true, true, true, true, true, 10))
 	r.GET("/items", ginhelper.ContextMiddleware(), func(ginCtx *gin.Context) {
 		ctx := ginhelper.GetContext(ginCtx)
 		ctx = context.WithValue(ctx, domain.CtxKeyUser, userDomain)
@@ -786,22 +1126,31 @@ func TestItemHandler_Find(t *testing.T) {
 
 	t.Run("OK", func(t *testing.T) {
 		findOutput := &item.FindOutput{
-			TotalCount:  10,
-			Items:       []domain.Item{},
-			HasNext:     false,
-			SearchAfter: 0,
+			TotalCount: 10,
+			Items:      []domain.Item{*newTestItem(t, userDomain.ID)},
+			HasNext:    true,
+			HasPrev:    true,
+			NextCursor: "next",
+			PrevCursor: "prev",
+			Highlights: []domain.ItemHighlight{{Chosung: true, Spans: []domain.TextSpan{{Start: 0, End: 2}}}},
 		}
 		itemUsecase.EXPECT().Find(gomock.Any(), &item.FindInput{
-			User:        userDomain,
-			Keyword:     "ㄹㄸ",
-			SearchAfter: 10,
+			User:      userDomain,
+			Keyword:   "ㄹㄸ",
+			Sort:      domain.ItemSortOrder{{Key: domain.ItemSortKeyRelevance}, {Key: domain.ItemSortKeyPrice, Desc: true}},
+			Cursor:    "cursor",
+			WithTotal: true,
+			Limit:     30,
 		}).Return(findOutput, nil)
 
 		u, err := url.Parse("/items")
 		assert.NoError(t, err)
 		query := u.Query()
 		query.Set("keyword", "ㄹㄸ")
-		query.Set("searchAfter", "10")
+		query.Set("sort", "relevance,-price")
+		query.Set("cursor", "cursor")
+		query.Set("withTotal", "true")
+		query.Set("limit", "30")
 		u.RawQuery = query.Encode()
 		responseWriter := httptest.NewRecorder()
 		require.NoError(t, err)
@@ -814,21 +1163,49 @@ func TestItemHandler_Find(t *testing.T) {
 		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
 		require.NoError(t, err)
 		assert.Equal(t, http.StatusOK, responseWriter.Code)
-		assert.Equal(t, findOutput.TotalCount, responseData.TotalCount)
+		require.NotNil(t, responseData.TotalCount)
+		assert.Equal(t, findOutput.TotalCount, *responseData.TotalCount)
 		assert.Equal(t, findOutput.HasNext, responseData.HasNext)
-		assert.Equal(t, findOutput.SearchAfter, responseData.SearchAfter)
+		assert.Equal(t, findOutput.HasPrev, responseData.HasPrev)
+		assert.Equal(t, findOutput.NextCursor, responseData.NextCursor)
+		assert.Equal(t, findOutput.PrevCursor, responseData.PrevCursor)
+		require.Len(t, responseData.Items, 1)
+		assert.Equal(t, findOutput.Items[0].ID, responseData.Items[0].ID)
+		assert.Equal(t, &ItemHighlightResponse{Chosung: true, Spans: []TextSpanResponse{{Start: 0, End: 2}}}, responseData.Items[0].Highlight)
+		assert.Equal(t, itemsETag(findOutput.Items), responseWriter.Header().Get("ETag"))
 	})
 
-	t.Run("invalid request", func(t *testing.T) {
-		u, err := url.Parse("/items")
-		assert.NoError(t, err)
-		query := u.Query()
-		query.Set("keyword", "ㄹㄸ")
-		query.Set("searchAfter", "ㄹㄸ")
-		u.RawQuery = query.Encode()
+	t.Run("전체 아이템 수 생략", func(t *testing.T) {
+		itemUsecase.EXPECT().Find(gomock.Any(), &item.FindInput{User: userDomain, Limit: testItemHandlerConfig.DefaultFindLimit}).Return(&item.FindOutput{Items: []domain.Item{}}, nil)
+
 		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodGet, "/items", nil)
 		require.NoError(t, err)
-		httpRequest, err := http.NewRequest(http.MethodGet, u.String(), nil)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		assert.Equal(t, http.StatusOK, responseWriter.Code)
+		assert.NotContains(t, responseWriter.Body.String(), "totalCount")
+		assert.NotContains(t, responseWriter.Body.String(), "facets")
+	})
+
+	t.Run("집계 조회", func(t *testing.T) {
+		maxPrice := 1000
+		findOutput := &item.FindOutput{
+			Items: []domain.Item{},
+			Facets: &domain.ItemFacets{
+				Categories:   []domain.ItemFacetCount{{Value: "coffee", Count: 2}},
+				Sizes:        []domain.ItemFacetCount{{Value: "small", Count: 2}, {Value: "large", Count: 0}},
+				PriceBuckets: []domain.ItemPriceBucket{{Min: 0, Max: &maxPrice, Count: 2}, {Min: 1000, Count: 0}},
+			},
+		}
+		itemUsecase.EXPECT().Find(gomock.Any(), &item.FindInput{
+			User:       userDomain,
+			WithFacets: true,
+			Limit:      testItemHandlerConfig.DefaultFindLimit,
+		}).Return(findOutput, nil)
+
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodGet, "/items?withFacets=true", nil)
 		require.NoError(t, err)
 		r.ServeHTTP(responseWriter, httpRequest)
 
@@ -836,60 +1213,332 @@ func TestItemHandler_Find(t *testing.T) {
 		resp := ginhelper.Response{Data: responseData}
 		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
 		require.NoError(t, err)
-		assert.Equal(t, http.StatusBadRequest, responseWriter.Code)
-		assert.Equal(t, http.StatusBadRequest, resp.Meta.Code)
-		assert.Equal(t, i18n.T(language.English, i18n.InvalidRequest, nil), resp.Meta.Message)
+		assert.Equal(t, http.StatusOK, responseWriter.Code)
+		assert.Equal(t, &ItemFacetsResponse{
+			Categories:   []ItemFacetCountResponse{{Value: "coffee", Count: 2}},
+			Sizes:        []ItemFacetCountResponse{{Value: "small", Count: 2}, {Value: "large", Count: 0}},
+			PriceBuckets: []ItemPriceBucketResponse{{Min: 0, Max: &maxPrice, Count: 2}, {Min: 1000, Count: 0}},
+		}, responseData.Facets)
 	})
 
-	t.Run("unauthorized", func(t *testing.T) {
-		u, err := url.Parse("/unauthorized")
+	t.Run("invalid cursor", func(t *testing.T) {
+		itemUsecase.EXPECT().Find(gomock.Any(), gomock.Any()).Return(nil, domain.ErrInvalidItemCursor)
+
+		u, err := url.Parse("/items")
 		assert.NoError(t, err)
 		query := u.Query()
-		query.Set("keyword", "ㄹㄸ")
-		query.Set("searchAfter", "ㄹㄸ")
+		query.Set("cursor", "invalid")
 		u.RawQuery = query.Encode()
 		responseWriter := httptest.NewRecorder()
-		require.NoError(t, err)
 		httpRequest, err := http.NewRequest(http.MethodGet, u.String(), nil)
 		require.NoError(t, err)
 		r.ServeHTTP(responseWriter, httpRequest)
 
-		responseData := &FindItemResponse{}
-		resp := ginhelper.Response{Data: responseData}
+		resp := ginhelper.Response{}
 		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
 		require.NoError(t, err)
-		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
-		assert.Equal(t, http.StatusInternalServerError, resp.Meta.Code)
-		assert.Equal(t, i18n.T(language.English, i18n.InternalError, nil), resp.Meta.Message)
+		assert.Equal(t, http.StatusBadRequest, responseWriter.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.InvalidRequest, nil), resp.Meta.Message)
 	})
 
-	t.Run("unexpected error", func(t *testing.T) {
-		itemUsecase.EXPECT().Find(gomock.Any(), &item.FindInput{
-			User:        userDomain,
-			Keyword:     "ㄹㄸ",
-			SearchAfter: 10,
-		}).Return(nil, gofakeit.Error())
+	t.Run("잘못된 검색어", func(t *testing.T) {
+		itemUsecase.EXPECT().Find(gomock.Any(), gomock.Any()).Return(nil, &item.QueryError{Position: 3, Reason: "unexpected ')'"})
 
 		u, err := url.Parse("/items")
 		assert.NoError(t, err)
 		query := u.Query()
-		query.Set("keyword", "ㄹㄸ")
-		query.Set("searchAfter", "10")
+		query.Set("keyword", "라떼 )")
 		u.RawQuery = query.Encode()
 		responseWriter := httptest.NewRecorder()
-		require.NoError(t, err)
 		httpRequest, err := http.NewRequest(http.MethodGet, u.String(), nil)
 		require.NoError(t, err)
 		r.ServeHTTP(responseWriter, httpRequest)
 
-		responseData := &FindItemResponse{}
-		resp := ginhelper.Response{Data: responseData}
+		details := &InvalidQueryDetails{}
+		resp := ginhelper.Response{Meta: ginhelper.ResponseMeta{Details: details}}
 		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
 		require.NoError(t, err)
-		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
-		assert.Equal(t, http.StatusInternalServerError, resp.Meta.Code)
-		assert.Equal(t, i18n.T(language.English, i18n.InternalError, nil), resp.Meta.Message)
-	})
+		assert.Equal(t, http.StatusBadRequest, responseWriter.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.InvalidRequest, nil), resp.Meta.Message)
+		assert.Equal(t, &InvalidQueryDetails{Position: 3, Reason: "unexpected ')'"}, details)
+	})
+
+	t.Run("조회 조건", func(t *testing.T) {
+		minPrice, maxPrice := 1000, 5000
+		expiresAfter := time.Date(2030, 1, 1, 0, 0, 0, 0, time.FixedZone("", 9*60*60))
+		findOutput := &item.FindOutput{Items: []domain.Item{}}
+		itemUsecase.EXPECT().Find(gomock.Any(), gomock.Cond(func(x any) bool {
+			input, ok := x.(*item.FindInput)
+			return ok && assert.Equal(t, domain.ItemFilter{
+				Categories:   []string{"coffee", "tea"},
+				Size:         domain.ItemSizeLarge,
+				MinPrice:     &minPrice,
+				MaxPrice:     &maxPrice,
+				ExpiresAfter: &expiresAfter,
+				Barcode:      "1234",
+			}, input.Filter)
+		})).Return(findOutput, nil)
+
+		u, err := url.Parse("/items")
+		assert.NoError(t, err)
+		query := u.Query()
+		query.Add("category", "coffee")
+		query.Add("category", "tea")
+		query.Set("size", "large")
+		query.Set("minPrice", "1000")
+		query.Set("maxPrice", "5000")
+		query.Set("expiresAfter", "2030-01-01T00:00:00+09:00")
+		query.Set("barcode", "1234")
+		u.RawQuery = query.Encode()
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodGet, u.String(), nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		assert.Equal(t, http.StatusOK, responseWriter.Code)
+	})
+
+	t.Run("잘못된 조회 조건", func(t *testing.T) {
+		for _, q := range []url.Values{
+			{"minPrice": {"5000"}, "maxPrice": {"1000"}},
+			{"createdAfter": {"2030-01-02T00:00:00Z"}, "createdBefore": {"2030-01-01T00:00:00Z"}},
+			{"size": {"medium"}},
+			{"minCost": {"-1"}},
+			{"expiresBefore": {"2030-01-01"}},
+			{"sort": {"barcode"}},
+			{"sort": {"price,-price"}},
+			{"sort": {"relevance"}},
+			{"sort": {"relevance"}, "keyword": {" "}},
+			{"limit": {"0"}},
+			{"limit": {"-1"}},
+			{"limit": {"51"}},
+			{"limit": {"ten"}},
+		} {
+			u, err := url.Parse("/items")
+			assert.NoError(t, err)
+			u.RawQuery = q.Encode()
+			responseWriter := httptest.NewRecorder()
+			httpRequest, err := http.NewRequest(http.MethodGet, u.String(), nil)
+			require.NoError(t, err)
+			r.ServeHTTP(responseWriter, httpRequest)
+
+			resp := ginhelper.Response{}
+			err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, responseWriter.Code, q.Encode())
+			assert.Equal(t, i18n.T(language.English, i18n.InvalidRequest, nil), resp.Meta.Message)
+		}
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		u, err := url.Parse("/items")
+		assert.NoError(t, err)
+		query := u.Query()
+		query.Set("keyword", "ㄹㄸ")
+		query.Set("minPrice", "ㄹㄸ")
+		u.RawQuery = query.Encode()
+		responseWriter := httptest.NewRecorder()
+		require.NoError(t, err)
+		httpRequest, err := http.NewRequest(http.MethodGet, u.String(), nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		responseData := &FindItemResponse{}
+		resp := ginhelper.Response{Data: responseData}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, responseWriter.Code)
+		assert.Equal(t, http.StatusBadRequest, resp.Meta.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.InvalidRequest, nil), resp.Meta.Message)
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		u, err := url.Parse("/unauthorized")
+		assert.NoError(t, err)
+		query := u.Query()
+		query.Set("keyword", "ㄹㄸ")
+		query.Set("minPrice", "ㄹㄸ")
+		u.RawQuery = query.Encode()
+		responseWriter := httptest.NewRecorder()
+		require.NoError(t, err)
+		httpRequest, err := http.NewRequest(http.MethodGet, u.String(), nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		responseData := &FindItemResponse{}
+		resp := ginhelper.Response{Data: responseData}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
+		assert.Equal(t, http.StatusInternalServerError, resp.Meta.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.InternalError, nil), resp.Meta.Message)
+	})
+
+	t.Run("unexpected error", func(t *testing.T) {
+		itemUsecase.EXPECT().Find(gomock.Any(), &item.FindInput{
+			User:    userDomain,
+			Keyword: "ㄹㄸ",
+			Cursor:  "cursor",
+			Limit:   testItemHandlerConfig.DefaultFindLimit,
+		}).Return(nil, gofakeit.Error())
+
+		u, err := url.Parse("/items")
+		assert.NoError(t, err)
+		query := u.Query()
+		query.Set("keyword", "ㄹㄸ")
+		query.Set("cursor", "cursor")
+		u.RawQuery = query.Encode()
+		responseWriter := httptest.NewRecorder()
+		require.NoError(t, err)
+		httpRequest, err := http.NewRequest(http.MethodGet, u.String(), nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		responseData := &FindItemResponse{}
+		resp := ginhelper.Response{Data: responseData}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
+		assert.Equal(t, http.StatusInternalServerError, resp.Meta.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.InternalError, nil), resp.Meta.Message)
+	})
+}
+
+func TestItemHandler_FindTrash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemUsecase := ucmocks.NewMockItemTokenUsecase(ctrl)
+	r := gin.New()
+	handler, err := NewItemHandler(itemUsecase, testItemHandlerConfig)
+	assert.NoError(t, err)
+	assert.NotNil(t, handler)
+
+	userDomain := newTestUser(t, gofakeit.Password(true, true, true, true, true, 10))
+	r.GET("/items/trash", ginhelper.ContextMiddleware(), func(ginCtx *gin.Context) {
+		ctx := ginhelper.GetContext(ginCtx)
+		ctx = context.WithValue(ctx, domain.CtxKeyUser, userDomain)
+		ginhelper.SetContext(ginCtx, ctx)
+		ginCtx.Next()
+	}, handler.FindTrash)
+	r.GET("/unauthorized", handler.FindTrash)
+
+	t.Run("OK", func(t *testing.T) {
+		deletedAt := time.Unix(time.Now().Unix(), 0).UTC()
+		itemDomain := newTestItem(t, userDomain.ID)
+		itemDomain.DeletedAt = &deletedAt
+		findOutput := &item.FindOutput{
+			TotalCount: 1,
+			Items:      []domain.Item{*itemDomain},
+			HasNext:    true,
+			NextCursor: "next",
+		}
+		itemUsecase.EXPECT().FindTrash(gomock.Any(), &item.FindTrashInput{
+			User:      userDomain,
+			Cursor:    "cursor",
+			WithTotal: true,
+			Limit:     5,
+		}).Return(findOutput, nil)
+
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodGet, "/items/trash?cursor=cursor&withTotal=true&limit=5", nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		responseData := &FindTrashResponse{}
+		resp := ginhelper.Response{Data: responseData}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, responseWriter.Code)
+		require.NotNil(t, responseData.TotalCount)
+		assert.Equal(t, findOutput.TotalCount, *responseData.TotalCount)
+		assert.True(t, responseData.HasNext)
+		assert.False(t, responseData.HasPrev)
+		assert.Equal(t, "next", responseData.NextCursor)
+		assert.Empty(t, responseData.PrevCursor)
+		require.Len(t, responseData.Items, 1)
+		assert.Equal(t, itemDomain.ID, responseData.Items[0].ID)
+		assert.Equal(t, deletedAt, responseData.Items[0].DeletedAt.UTC())
+	})
+
+	t.Run("기본 limit", func(t *testing.T) {
+		itemUsecase.EXPECT().FindTrash(gomock.Any(), &item.FindTrashInput{
+			User:  userDomain,
+			Limit: testItemHandlerConfig.DefaultFindLimit,
+		}).Return(&item.FindOutput{}, nil)
+
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodGet, "/items/trash", nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		responseData := &FindTrashResponse{}
+		resp := ginhelper.Response{Data: responseData}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, responseWriter.Code)
+		assert.Nil(t, responseData.TotalCount)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		for _, query := range []string{"limit=abc", "limit=0", fmt.Sprintf("limit=%d", testItemHandlerConfig.MaxFindLimit+1)} {
+			responseWriter := httptest.NewRecorder()
+			httpRequest, err := http.NewRequest(http.MethodGet, "/items/trash?"+query, nil)
+			require.NoError(t, err)
+			r.ServeHTTP(responseWriter, httpRequest)
+
+			resp := ginhelper.Response{}
+			err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, responseWriter.Code, query)
+			assert.Equal(t, i18n.T(language.English, i18n.InvalidRequest, nil), resp.Meta.Message)
+		}
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		itemUsecase.EXPECT().FindTrash(gomock.Any(), gomock.Any()).Return(nil, domain.ErrInvalidItemCursor)
+
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodGet, "/items/trash?cursor=invalid", nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		resp := ginhelper.Response{}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, responseWriter.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.InvalidRequest, nil), resp.Meta.Message)
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodGet, "/unauthorized", nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		resp := ginhelper.Response{}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.InternalError, nil), resp.Meta.Message)
+	})
+
+	t.Run("unexpected error", func(t *testing.T) {
+		itemUsecase.EXPECT().FindTrash(gomock.Any(), &item.FindTrashInput{
+			User:  userDomain,
+			Limit: testItemHandlerConfig.DefaultFindLimit,
+		}).Return(nil, gofakeit.Error())
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodGet, "/items/trash", nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		resp := ginhelper.Response{}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.InternalError, nil), resp.Meta.Message)
+	})
 }
 
 func newTestItem(t *testing.T, userID int) *domain.Item {
@@ -910,3 +1559,327 @@ func newTestItem(t *testing.T, userID int) *domain.Item {
 
 	return itemDomain
 }
+
+func TestItemHandler_History(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemUsecase := ucmocks.NewMockItemTokenUsecase(ctrl)
+	r := gin.New()
+	handler, err := NewItemHandler(itemUsecase, testItemHandlerConfig)
+	assert.NoError(t, err)
+	assert.NotNil(t, handler)
+
+	userDomain := newTestUser(t, gofakeit.Password(true, true, true, true, true, 10))
+	r.GET("/items/:itemId/history", requestid.New(), ginhelper.ContextMiddleware(), func(ginCtx *gin.Context) {
+		ctx := ginhelper.GetContext(ginCtx)
+		ctx = context.WithValue(ctx, domain.CtxKeyUser, userDomain)
+		ginhelper.SetContext(ginCtx, ctx)
+		ginCtx.Next()
+	}, handler.History)
+	r.GET("/unauthorized/:itemId", handler.History)
+
+	t.Run("OK", func(t *testing.T) {
+		itemDomain := newTestItem(t, userDomain.ID)
+		requestID := gofakeit.UUID()
+		oldPrice, newPrice := "1000", "2000"
+		history, err := domain.NewItemHistory(
+			userDomain.ID,
+			itemDomain.ID,
+			userDomain.ID,
+			requestID,
+			domain.ItemHistoryActionUpdate,
+			[]domain.ItemFieldChange{{Field: "price", OldValue: &oldPrice, NewValue: &newPrice}},
+		)
+		require.NoError(t, err)
+		history.ID = gofakeit.Number(1, 100)
+		historyOutput := &item.HistoryOutput{
+			Histories:   []domain.ItemHistory{*history},
+			HasNext:     true,
+			SearchAfter: history.ID,
+		}
+		itemUsecase.EXPECT().History(gomock.Any(), &item.HistoryInput{
+			User:        userDomain,
+			ItemID:      itemDomain.ID,
+			SearchAfter: 10,
+		}).DoAndReturn(func(c context.Context, _ *item.HistoryInput) (*item.HistoryOutput, error) {
+			assert.Equal(t, requestID, domain.RequestIDFromContext(c))
+			return historyOutput, nil
+		})
+
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/items/%d/history?searchAfter=10", itemDomain.ID), nil)
+		require.NoError(t, err)
+		httpRequest.Header.Set("X-Request-ID", requestID)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		responseData := &ItemHistoryListResponse{}
+		resp := ginhelper.Response{Data: responseData}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, responseWriter.Code)
+		assert.Equal(t, historyOutput.HasNext, responseData.HasNext)
+		assert.Equal(t, historyOutput.SearchAfter, responseData.SearchAfter)
+		require.Len(t, responseData.Histories, 1)
+		assert.Equal(t, history.ID, responseData.Histories[0].ID)
+		assert.Equal(t, requestID, responseData.Histories[0].RequestID)
+		assert.Equal(t, domain.ItemHistoryActionUpdate, responseData.Histories[0].Action)
+		require.Len(t, responseData.Histories[0].Changes, 1)
+		assert.Equal(t, oldPrice, *responseData.Histories[0].Changes[0].OldValue)
+		assert.Equal(t, newPrice, *responseData.Histories[0].Changes[0].NewValue)
+	})
+
+	t.Run("invalid itemId", func(t *testing.T) {
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodGet, "/items/abc/history", nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		resp := ginhelper.Response{}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, responseWriter.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.ItemNotFound, nil), resp.Meta.Message)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodGet, "/items/1/history?searchAfter=abc", nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		resp := ginhelper.Response{}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, responseWriter.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.InvalidRequest, nil), resp.Meta.Message)
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodGet, "/unauthorized/1", nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		resp := ginhelper.Response{}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.InternalError, nil), resp.Meta.Message)
+	})
+
+	t.Run("unexpected error", func(t *testing.T) {
+		itemUsecase.EXPECT().History(gomock.Any(), gomock.Any()).Return(nil, gofakeit.Error())
+
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodGet, "/items/1/history", nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		resp := ginhelper.Response{}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.InternalError, nil), resp.Meta.Message)
+	})
+}
+
+func TestItemHandler_Suggest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemUsecase := ucmocks.NewMockItemTokenUsecase(ctrl)
+	r := gin.New()
+	handler, err := NewItemHandler(itemUsecase, testItemHandlerConfig)
+	assert.NoError(t, err)
+	assert.NotNil(t, handler)
+
+	userDomain := newTestUser(t, gofakeit.Password(true, true, true, true, true, 10))
+	r.GET("/items/suggest", requestid.New(), ginhelper.ContextMiddleware(), func(ginCtx *gin.Context) {
+		ctx := ginhelper.GetContext(ginCtx)
+		ctx = context.WithValue(ctx, domain.CtxKeyUser, userDomain)
+		ginhelper.SetContext(ginCtx, ctx)
+		ginCtx.Next()
+	}, handler.Suggest)
+	r.GET("/unauthorized", handler.Suggest)
+
+	t.Run("OK", func(t *testing.T) {
+		suggestOutput := &item.SuggestOutput{
+			Suggestions: []domain.ItemSuggestion{
+				{Text: "아메리카노", Type: domain.ItemSuggestionTypeName},
+				{Text: "음료", Type: domain.ItemSuggestionTypeCategory},
+			},
+		}
+		itemUsecase.EXPECT().Suggest(gomock.Any(), &item.SuggestInput{
+			User:  userDomain,
+			Query: "ㅇㅁ",
+			Limit: 5,
+		}).Return(suggestOutput, nil)
+
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodGet, "/items/suggest?q="+url.QueryEscape("ㅇㅁ")+"&limit=5", nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		responseData := &SuggestItemResponse{}
+		resp := ginhelper.Response{Data: responseData}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, responseWriter.Code)
+		assert.Equal(t, []ItemSuggestionResponse{
+			{Text: "아메리카노", Type: domain.ItemSuggestionTypeName},
+			{Text: "음료", Type: domain.ItemSuggestionTypeCategory},
+		}, responseData.Suggestions)
+	})
+
+	t.Run("기본 결과 수", func(t *testing.T) {
+		itemUsecase.EXPECT().Suggest(gomock.Any(), &item.SuggestInput{
+			User:  userDomain,
+			Query: "a",
+			Limit: item.DefaultSuggestLimit,
+		}).Return(&item.SuggestOutput{Suggestions: []domain.ItemSuggestion{}}, nil)
+
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodGet, "/items/suggest?q=a", nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+		assert.Equal(t, http.StatusOK, responseWriter.Code)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		for _, query := range []string{"limit=abc", "limit=0", fmt.Sprintf("limit=%d", item.MaxSuggestLimit+1)} {
+			responseWriter := httptest.NewRecorder()
+			httpRequest, err := http.NewRequest(http.MethodGet, "/items/suggest?q=a&"+query, nil)
+			require.NoError(t, err)
+			r.ServeHTTP(responseWriter, httpRequest)
+
+			resp := ginhelper.Response{}
+			err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, responseWriter.Code, query)
+			assert.Equal(t, i18n.T(language.English, i18n.InvalidRequest, nil), resp.Meta.Message)
+		}
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodGet, "/unauthorized", nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		resp := ginhelper.Response{}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.InternalError, nil), resp.Meta.Message)
+	})
+
+	t.Run("unexpected error", func(t *testing.T) {
+		itemUsecase.EXPECT().Suggest(gomock.Any(), gomock.Any()).Return(nil, gofakeit.Error())
+
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodGet, "/items/suggest?q=a", nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		resp := ginhelper.Response{}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.InternalError, nil), resp.Meta.Message)
+	})
+}
+
+func TestItemHandler_FindDuplicates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemUsecase := ucmocks.NewMockItemTokenUsecase(ctrl)
+	r := gin.New()
+	handler, err := NewItemHandler(itemUsecase, testItemHandlerConfig)
+	assert.NoError(t, err)
+	assert.NotNil(t, handler)
+
+	userDomain := newTestUser(t, gofakeit.Password(true, true, true, true, true, 10))
+	r.GET("/items/duplicates", ginhelper.ContextMiddleware(), func(ginCtx *gin.Context) {
+		ctx := ginhelper.GetContext(ginCtx)
+		ctx = context.WithValue(ctx, domain.CtxKeyUser, userDomain)
+		ginhelper.SetContext(ginCtx, ctx)
+		ginCtx.Next()
+	}, handler.FindDuplicates)
+	r.GET("/unauthorized", handler.FindDuplicates)
+
+	t.Run("OK", func(t *testing.T) {
+		itemDomain := newTestItem(t, userDomain.ID)
+		itemDomain.CreatedAt = time.Unix(itemDomain.CreatedAt.Unix(), 0).UTC()
+		itemDomain.ExpiryAt = time.Unix(itemDomain.ExpiryAt.Unix(), 0).UTC()
+		itemUsecase.EXPECT().FindDuplicates(gomock.Any(), &item.FindDuplicatesInput{User: userDomain}).Return(&item.FindDuplicatesOutput{
+			Clusters: []domain.ItemDuplicateCluster{
+				{Items: []domain.Item{*itemDomain, *itemDomain}, Reason: domain.ItemDuplicateReasonChosung},
+			},
+		}, nil)
+
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodGet, "/items/duplicates", nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		responseData := &FindDuplicatesResponse{}
+		resp := ginhelper.Response{Data: responseData}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, responseWriter.Code)
+		require.Len(t, responseData.Clusters, 1)
+		assert.Equal(t, domain.ItemDuplicateReasonChosung, responseData.Clusters[0].Reason)
+		require.Len(t, responseData.Clusters[0].Items, 2)
+		assert.Equal(t, GetItemResponse{
+			ID:          itemDomain.ID,
+			Name:        itemDomain.Name,
+			Description: itemDomain.Description,
+			Price:       itemDomain.Price,
+			Cost:        itemDomain.Cost,
+			Category:    itemDomain.Category,
+			Barcode:     itemDomain.Barcode,
+			Size:        itemDomain.Size,
+			ExpiryAt:    itemDomain.ExpiryAt,
+			CreatedAt:   itemDomain.CreatedAt,
+			Version:     itemDomain.Version,
+		}, responseData.Clusters[0].Items[0])
+	})
+
+	t.Run("중복 아이템 없음", func(t *testing.T) {
+		itemUsecase.EXPECT().FindDuplicates(gomock.Any(), gomock.Any()).Return(&item.FindDuplicatesOutput{Clusters: []domain.ItemDuplicateCluster{}}, nil)
+
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodGet, "/items/duplicates", nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		assert.Equal(t, http.StatusOK, responseWriter.Code)
+		assert.JSONEq(t, `{"meta":{"code":200,"message":"ok"},"data":{"clusters":[]}}`, responseWriter.Body.String())
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodGet, "/unauthorized", nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
+	})
+
+	t.Run("unexpected error", func(t *testing.T) {
+		itemUsecase.EXPECT().FindDuplicates(gomock.Any(), gomock.Any()).Return(nil, gofakeit.Error())
+
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodGet, "/items/duplicates", nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		resp := ginhelper.Response{}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.InternalError, nil), resp.Meta.Message)
+	})
+}
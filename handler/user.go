@@ -94,22 +94,58 @@ func (h *UserHandler) SignIn(ginCtx *gin.Context) {
 		return
 	}
 
-	createTokenOutput, err := h.authTokenUsecase.Create(ctx, &authtoken.CreateInput{Identifier: strconv.Itoa(userDomain.ID)})
+	createTokenOutput, err := h.authTokenUsecase.Create(ctx, &authtoken.CreateInput{
+		Identifier: strconv.Itoa(userDomain.ID),
+		ClientIP:   ginCtx.ClientIP(),
+		UserAgent:  ginCtx.Request.UserAgent(),
+	})
 	if err != nil {
 		ginhelper.Error(ginCtx, errors.WithStack(err))
 		return
 	}
 
-	ginhelper.Success(ginCtx, SignInResponse{
-		Token:     createTokenOutput.Token,
-		ExpiresAt: createTokenOutput.ExpiresAt,
+	ginhelper.Success(ginCtx, newSignInResponse(createTokenOutput))
+}
+
+func (h *UserHandler) RefreshToken(ginCtx *gin.Context) {
+	ctx := ginhelper.GetContext(ginCtx)
+	var req RefreshTokenRequest
+	if err := ginCtx.BindJSON(&req); err != nil {
+		ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusBadRequest, i18n.InvalidRequest, errors.WithStack(err)))
+		return
+	}
+	if err := valid.ValidateStruct(req); err != nil {
+		ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusBadRequest, i18n.InvalidRequest, errors.WithStack(err)))
+		return
+	}
+
+	refreshOutput, err := h.authTokenUsecase.Refresh(ctx, &authtoken.RefreshInput{
+		RefreshToken: req.RefreshToken,
+		ClientIP:     ginCtx.ClientIP(),
+		UserAgent:    ginCtx.Request.UserAgent(),
 	})
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidRefreshToken) {
+			ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusUnauthorized, i18n.InvalidRefreshToken, errors.WithStack(err)))
+			return
+		}
+
+		ginhelper.Error(ginCtx, errors.WithStack(err))
+		return
+	}
+
+	ginhelper.Success(ginCtx, newSignInResponse(refreshOutput))
 }
 
 func (h *UserHandler) SignOut(ginCtx *gin.Context) {
 	ctx := ginhelper.GetContext(ginCtx)
 	token := ginhelper.GetToken(ginCtx)
 
+	// 블랙리스트 등록 후 폐기에 실패하면 다시 요청해도 블랙리스트에 등록된 토큰으로 거부되므로, 다시 요청할 수 있는 리프레시 토큰 폐기를 먼저 처리합니다.
+	if err := h.authTokenUsecase.RevokeRefreshToken(ctx, &authtoken.RevokeRefreshTokenInput{Token: token}); err != nil {
+		ginhelper.Error(ginCtx, errors.WithStack(err))
+		return
+	}
 	if err := h.authTokenUsecase.RegisterBlacklist(ctx, &authtoken.RegisterBlacklistInput{Token: token}); err != nil {
 		if errors.Is(err, domain.ErrTokenBlacklistAlreadyExists) {
 			ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusUnauthorized, i18n.TokenBlacklistAlreadyExists, errors.WithStack(err)))
@@ -124,6 +160,177 @@ func (h *UserHandler) SignOut(ginCtx *gin.Context) {
 	return
 }
 
+func (h *UserHandler) GetPreferences(ginCtx *gin.Context) {
+	ctx := ginhelper.GetContext(ginCtx)
+
+	// 1. 인증된 유저 확인
+	userDomain, ok := ctx.Value(domain.CtxKeyUser).(*domain.User)
+	if !ok {
+		ginhelper.Error(ginCtx, errors.New("unauthenticated request"))
+		return
+	}
+
+	// 2. 결과 반환
+	ginhelper.Success(ginCtx, newPreferencesResponse(userDomain))
+}
+
+func (h *UserHandler) UpdatePreferences(ginCtx *gin.Context) {
+	ctx := ginhelper.GetContext(ginCtx)
+
+	// 1. 인증된 유저 확인
+	userDomain, ok := ctx.Value(domain.CtxKeyUser).(*domain.User)
+	if !ok {
+		ginhelper.Error(ginCtx, errors.New("unauthenticated request"))
+		return
+	}
+
+	// 2. 요청 확인
+	var req UpdatePreferencesRequest
+	if err := ginCtx.BindJSON(&req); err != nil {
+		ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusBadRequest, i18n.InvalidRequest, errors.WithStack(err)))
+		return
+	}
+	if err := req.Validate(); err != nil {
+		ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusBadRequest, i18n.InvalidRequest, errors.WithStack(err)))
+		return
+	}
+
+	// 3. 설정 수정
+	output, err := h.userUsecase.UpdatePreferences(ctx, &user.UpdatePreferencesInput{
+		User:                userDomain,
+		DuplicateItemPolicy: req.DuplicateItemPolicy,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusNotFound, i18n.UserNotFound, errors.WithStack(err)))
+			return
+		}
+
+		ginhelper.Error(ginCtx, errors.WithStack(err))
+		return
+	}
+
+	// 4. 결과 반환
+	ginhelper.Success(ginCtx, newPreferencesResponse(output.User))
+}
+
+// GetSessions 로그인된 기기 목록을 응답하며, 요청에 사용한 세션은 current로 표시합니다.
+func (h *UserHandler) GetSessions(ginCtx *gin.Context) {
+	ctx := ginhelper.GetContext(ginCtx)
+
+	// 1. 인증된 유저 확인
+	userDomain, ok := ctx.Value(domain.CtxKeyUser).(*domain.User)
+	if !ok {
+		ginhelper.Error(ginCtx, errors.New("unauthenticated request"))
+		return
+	}
+	currentSessionID, _ := ctx.Value(domain.CtxKeySessionID).(string)
+
+	// 2. 세션 조회
+	output, err := h.authTokenUsecase.FindSessions(ctx, &authtoken.FindSessionsInput{
+		Identifier: strconv.Itoa(userDomain.ID),
+	})
+	if err != nil {
+		ginhelper.Error(ginCtx, errors.WithStack(err))
+		return
+	}
+
+	// 3. 결과 반환
+	ginhelper.Success(ginCtx, newGetSessionsResponse(output.Sessions, currentSessionID))
+}
+
+// DeleteSession 세션을 폐기하며, 해당 기기는 토큰을 재발급할 수 없으므로 다시 로그인해야 합니다.
+func (h *UserHandler) DeleteSession(ginCtx *gin.Context) {
+	ctx := ginhelper.GetContext(ginCtx)
+
+	// 1. 인증된 유저 확인
+	userDomain, ok := ctx.Value(domain.CtxKeyUser).(*domain.User)
+	if !ok {
+		ginhelper.Error(ginCtx, errors.New("unauthenticated request"))
+		return
+	}
+
+	// 2. 세션 폐기
+	if err := h.authTokenUsecase.RevokeSession(ctx, &authtoken.RevokeSessionInput{
+		Identifier: strconv.Itoa(userDomain.ID),
+		SessionID:  ginCtx.Param("sessionId"),
+	}); err != nil {
+		if errors.Is(err, domain.ErrSessionNotFound) {
+			ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusNotFound, i18n.SessionNotFound, errors.WithStack(err)))
+			return
+		}
+
+		ginhelper.Error(ginCtx, errors.WithStack(err))
+		return
+	}
+
+	ginCtx.Status(http.StatusNoContent)
+}
+
+// RevokeAllSessions 요청에 사용한 세션을 포함해 모든 세션을 폐기합니다.
+func (h *UserHandler) RevokeAllSessions(ginCtx *gin.Context) {
+	ctx := ginhelper.GetContext(ginCtx)
+
+	// 1. 인증된 유저 확인
+	userDomain, ok := ctx.Value(domain.CtxKeyUser).(*domain.User)
+	if !ok {
+		ginhelper.Error(ginCtx, errors.New("unauthenticated request"))
+		return
+	}
+
+	// 2. 지금까지 발급된 토큰 무효화 및 세션 폐기
+	output, err := h.userUsecase.InvalidateTokens(ctx, &user.InvalidateTokensInput{User: userDomain})
+	if err != nil {
+		ginhelper.Error(ginCtx, errors.WithStack(err))
+		return
+	}
+
+	// 3. 결과 반환
+	ginhelper.Success(ginCtx, RevokeAllSessionsResponse{RevokedCount: output.RevokedSessionCount})
+}
+
+// ChangePassword 비밀번호를 변경하고 변경 전에 발급된 토큰과 세션을 모두 폐기합니다.
+func (h *UserHandler) ChangePassword(ginCtx *gin.Context) {
+	ctx := ginhelper.GetContext(ginCtx)
+
+	// 1. 인증된 유저 확인
+	userDomain, ok := ctx.Value(domain.CtxKeyUser).(*domain.User)
+	if !ok {
+		ginhelper.Error(ginCtx, errors.New("unauthenticated request"))
+		return
+	}
+
+	// 2. 요청 확인
+	var req ChangePasswordRequest
+	if err := ginCtx.BindJSON(&req); err != nil {
+		ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusBadRequest, i18n.InvalidRequest, errors.WithStack(err)))
+		return
+	}
+	if err := req.Validate(); err != nil {
+		ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusBadRequest, i18n.InvalidRequest, errors.WithStack(err)))
+		return
+	}
+
+	// 3. 비밀번호 변경 및 세션 폐기
+	if _, err := h.userUsecase.ChangePassword(ctx, &user.ChangePasswordInput{
+		User:            userDomain,
+		CurrentPassword: req.CurrentPassword,
+		NewPassword:     req.NewPassword,
+	}); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPasswordMismatch):
+			ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusBadRequest, i18n.PasswordMismatch, errors.WithStack(err)))
+		case errors.Is(err, domain.ErrUserNotFound):
+			ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusNotFound, i18n.UserNotFound, errors.WithStack(err)))
+		default:
+			ginhelper.Error(ginCtx, errors.WithStack(err))
+		}
+		return
+	}
+
+	ginCtx.Status(http.StatusNoContent)
+}
+
 type SignUpRequest struct {
 	PhoneNumber string `json:"phoneNumber"`
 	Password    string `json:"password"`
@@ -147,6 +354,94 @@ type SignInRequest struct {
 }
 
 type SignInResponse struct {
-	Token     string    `json:"token"`
+	Token                 string    `json:"token"`
+	ExpiresAt             time.Time `json:"expiresAt"`
+	RefreshToken          string    `json:"refreshToken"`
+	RefreshTokenExpiresAt time.Time `json:"refreshTokenExpiresAt"`
+}
+
+func newSignInResponse(output *authtoken.CreateOutput) SignInResponse {
+	return SignInResponse{
+		Token:                 output.Token,
+		ExpiresAt:             output.ExpiresAt,
+		RefreshToken:          output.RefreshToken,
+		RefreshTokenExpiresAt: output.RefreshTokenExpiresAt,
+	}
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refreshToken" validate:"required"`
+}
+
+type UpdatePreferencesRequest struct {
+	DuplicateItemPolicy *domain.DuplicateItemPolicy `json:"duplicateItemPolicy"`
+}
+
+func (r *UpdatePreferencesRequest) Validate() error {
+	if valid.IsNil(r.DuplicateItemPolicy) {
+		return fmt.Errorf("nothing to update")
+	}
+	if err := r.DuplicateItemPolicy.Validate(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"currentPassword"`
+	NewPassword     string `json:"newPassword"`
+}
+
+func (r *ChangePasswordRequest) Validate() error {
+	if len(r.CurrentPassword) == 0 {
+		return fmt.Errorf("empty currentPassword")
+	}
+	if err := valid.ValidatePassword(r.NewPassword); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+type PreferencesResponse struct {
+	DuplicateItemPolicy domain.DuplicateItemPolicy `json:"duplicateItemPolicy"`
+}
+
+func newPreferencesResponse(user *domain.User) PreferencesResponse {
+	return PreferencesResponse{DuplicateItemPolicy: user.DuplicateItemPolicy}
+}
+
+type GetSessionsResponse struct {
+	Sessions []SessionResponse `json:"sessions"`
+}
+
+type SessionResponse struct {
+	ID        string    `json:"id"`
+	IP        string    `json:"ip"`
+	UserAgent string    `json:"userAgent"`
+	CreatedAt time.Time `json:"createdAt"`
 	ExpiresAt time.Time `json:"expiresAt"`
+	// Current 요청에 사용한 세션인지 여부입니다.
+	Current bool `json:"current"`
+}
+
+func newGetSessionsResponse(sessions []domain.Session, currentSessionID string) GetSessionsResponse {
+	response := GetSessionsResponse{Sessions: make([]SessionResponse, 0, len(sessions))}
+	for _, session := range sessions {
+		response.Sessions = append(response.Sessions, SessionResponse{
+			ID:        session.ID,
+			IP:        session.IP,
+			UserAgent: session.UserAgent,
+			CreatedAt: session.CreatedAt,
+			ExpiresAt: session.ExpiresAt,
+			Current:   session.ID == currentSessionID,
+		})
+	}
+
+	return response
+}
+
+type RevokeAllSessionsResponse struct {
+	RevokedCount int `json:"revokedCount"`
 }
@@ -2,11 +2,15 @@ package handler
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
 	"testing"
+	"time"
+
+	"github.com/pkg/errors"
 
 	"github.com/psi59/payhere-assignment/internal/ginhelper"
 
@@ -227,8 +231,11 @@ func TestUserHandler_SignIn(t *testing.T) {
 		userUsecase.EXPECT().GetByPhoneNumber(gomock.Any(), &user.GetByPhoneNumberInput{
 			PhoneNumber: signInRequest.PhoneNumber,
 		}).Return(&user.GetOutput{User: userDomain}, nil)
+		userAgent := gofakeit.UserAgent()
 		authTokenUsecase.EXPECT().Create(gomock.Any(), &authtoken.CreateInput{
 			Identifier: strconv.Itoa(userDomain.ID),
+			ClientIP:   "203.0.113.1",
+			UserAgent:  userAgent,
 		}).Return(&authtoken.CreateOutput{
 			Token:     gofakeit.UUID(),
 			ExpiresAt: gofakeit.FutureDate(),
@@ -240,6 +247,8 @@ func TestUserHandler_SignIn(t *testing.T) {
 		require.NoError(t, err)
 		httpRequest, err := http.NewRequest(http.MethodPost, "/", buf)
 		require.NoError(t, err)
+		httpRequest.RemoteAddr = "203.0.113.1:50000"
+		httpRequest.Header.Set("User-Agent", userAgent)
 		r.ServeHTTP(responseWriter, httpRequest)
 
 		assert.Equal(t, http.StatusOK, responseWriter.Code)
@@ -404,9 +413,15 @@ func TestUserHandler_SignOut(t *testing.T) {
 
 	t.Run("OK", func(t *testing.T) {
 		token := gofakeit.UUID()
-		authTokenUsecase.EXPECT().RegisterBlacklist(gomock.Any(), &authtoken.RegisterBlacklistInput{
-			Token: token,
-		}).Return(nil)
+		// 리프레시 토큰을 먼저 폐기합니다.
+		gomock.InOrder(
+			authTokenUsecase.EXPECT().RevokeRefreshToken(gomock.Any(), &authtoken.RevokeRefreshTokenInput{
+				Token: token,
+			}).Return(nil),
+			authTokenUsecase.EXPECT().RegisterBlacklist(gomock.Any(), &authtoken.RegisterBlacklistInput{
+				Token: token,
+			}).Return(nil),
+		)
 
 		responseWriter := httptest.NewRecorder()
 		httpRequest, err := http.NewRequest(http.MethodPost, "/", nil)
@@ -417,8 +432,27 @@ func TestUserHandler_SignOut(t *testing.T) {
 		assert.Equal(t, http.StatusNoContent, responseWriter.Code)
 	})
 
+	t.Run("리프레시 토큰 폐기 에러", func(t *testing.T) {
+		token := gofakeit.UUID()
+		// 블랙리스트에 등록하지 않으므로 다시 요청할 수 있습니다.
+		authTokenUsecase.EXPECT().RevokeRefreshToken(gomock.Any(), &authtoken.RevokeRefreshTokenInput{
+			Token: token,
+		}).Return(gofakeit.Error())
+
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodPost, "/", nil)
+		require.NoError(t, err)
+		httpRequest.Header.Set("Authorization", "Bearer "+token)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
+	})
+
 	t.Run("이미 등록된 토큰일 경우", func(t *testing.T) {
 		token := gofakeit.UUID()
+		authTokenUsecase.EXPECT().RevokeRefreshToken(gomock.Any(), &authtoken.RevokeRefreshTokenInput{
+			Token: token,
+		}).Return(nil)
 		authTokenUsecase.EXPECT().RegisterBlacklist(gomock.Any(), &authtoken.RegisterBlacklistInput{
 			Token: token,
 		}).Return(domain.ErrTokenBlacklistAlreadyExists)
@@ -439,6 +473,9 @@ func TestUserHandler_SignOut(t *testing.T) {
 
 	t.Run("예상하지 못한 토큰 등록 에러", func(t *testing.T) {
 		token := gofakeit.UUID()
+		authTokenUsecase.EXPECT().RevokeRefreshToken(gomock.Any(), &authtoken.RevokeRefreshTokenInput{
+			Token: token,
+		}).Return(nil)
 		authTokenUsecase.EXPECT().RegisterBlacklist(gomock.Any(), &authtoken.RegisterBlacklistInput{
 			Token: token,
 		}).Return(gofakeit.Error())
@@ -458,6 +495,214 @@ func TestUserHandler_SignOut(t *testing.T) {
 	})
 }
 
+func TestUserHandler_RefreshToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userUsecase := ucmocks.NewMockUserUsecase(ctrl)
+	authTokenUsecase := ucmocks.NewMockAuthTokenUsecase(ctrl)
+
+	r := gin.New()
+	handler, err := NewUserHandler(userUsecase, authTokenUsecase)
+	require.NoError(t, err)
+	r.POST("/", handler.RefreshToken)
+
+	newRequest := func(t *testing.T, body any) *http.Request {
+		buf := bytes.NewBuffer(nil)
+		err := json.NewEncoder(buf).Encode(body)
+		require.NoError(t, err)
+		httpRequest, err := http.NewRequest(http.MethodPost, "/", buf)
+		require.NoError(t, err)
+
+		return httpRequest
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		req := RefreshTokenRequest{RefreshToken: gofakeit.LetterN(43)}
+		createOutput := &authtoken.CreateOutput{
+			Token:                 gofakeit.UUID(),
+			ExpiresAt:             time.Now().Add(time.Hour).UTC().Truncate(time.Second),
+			RefreshToken:          gofakeit.LetterN(43),
+			RefreshTokenExpiresAt: time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second),
+		}
+		authTokenUsecase.EXPECT().Refresh(gomock.Any(), &authtoken.RefreshInput{
+			RefreshToken: req.RefreshToken,
+		}).Return(createOutput, nil)
+
+		responseWriter := httptest.NewRecorder()
+		r.ServeHTTP(responseWriter, newRequest(t, req))
+
+		var resp struct {
+			Data SignInResponse `json:"data"`
+		}
+		err := json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, responseWriter.Code)
+		assert.Equal(t, newSignInResponse(createOutput), resp.Data)
+	})
+
+	t.Run("빈 리프레시 토큰", func(t *testing.T) {
+		responseWriter := httptest.NewRecorder()
+		r.ServeHTTP(responseWriter, newRequest(t, RefreshTokenRequest{}))
+
+		var resp ginhelper.Response
+		err := json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, responseWriter.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.InvalidRequest, nil), resp.Meta.Message)
+	})
+
+	t.Run("유효하지 않은 리프레시 토큰", func(t *testing.T) {
+		req := RefreshTokenRequest{RefreshToken: gofakeit.LetterN(43)}
+		authTokenUsecase.EXPECT().Refresh(gomock.Any(), &authtoken.RefreshInput{
+			RefreshToken: req.RefreshToken,
+		}).Return(nil, errors.Wrap(domain.ErrInvalidRefreshToken, domain.ErrRefreshTokenReused.Error()))
+
+		responseWriter := httptest.NewRecorder()
+		r.ServeHTTP(responseWriter, newRequest(t, req))
+
+		var resp ginhelper.Response
+		err := json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, responseWriter.Code)
+		assert.Equal(t, http.StatusUnauthorized, resp.Meta.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.InvalidRefreshToken, nil), resp.Meta.Message)
+	})
+
+	t.Run("예상하지 못한 에러", func(t *testing.T) {
+		req := RefreshTokenRequest{RefreshToken: gofakeit.LetterN(43)}
+		authTokenUsecase.EXPECT().Refresh(gomock.Any(), gomock.Any()).Return(nil, gofakeit.Error())
+
+		responseWriter := httptest.NewRecorder()
+		r.ServeHTTP(responseWriter, newRequest(t, req))
+
+		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
+	})
+}
+
+func TestUserHandler_GetPreferences(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userUsecase := ucmocks.NewMockUserUsecase(ctrl)
+	authTokenUsecase := ucmocks.NewMockAuthTokenUsecase(ctrl)
+
+	r := gin.New()
+	handler, err := NewUserHandler(userUsecase, authTokenUsecase)
+	require.NoError(t, err)
+	userDomain := newTestUser(t, gofakeit.Password(true, true, true, true, true, 10))
+	r.GET("/", ginhelper.ContextMiddleware(), func(ginCtx *gin.Context) {
+		ctx := ginhelper.GetContext(ginCtx)
+		ctx = context.WithValue(ctx, domain.CtxKeyUser, userDomain)
+		ginhelper.SetContext(ginCtx, ctx)
+		ginCtx.Next()
+	}, handler.GetPreferences)
+	r.GET("/unauthorized", handler.GetPreferences)
+
+	t.Run("OK", func(t *testing.T) {
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodGet, "/", nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		responseData := &PreferencesResponse{}
+		resp := ginhelper.Response{Data: responseData}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, responseWriter.Code)
+		assert.Equal(t, &PreferencesResponse{DuplicateItemPolicy: domain.DuplicateItemPolicyWarn}, responseData)
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodGet, "/unauthorized", nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
+	})
+}
+
+func TestUserHandler_UpdatePreferences(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userUsecase := ucmocks.NewMockUserUsecase(ctrl)
+	authTokenUsecase := ucmocks.NewMockAuthTokenUsecase(ctrl)
+
+	r := gin.New()
+	handler, err := NewUserHandler(userUsecase, authTokenUsecase)
+	require.NoError(t, err)
+	userDomain := newTestUser(t, gofakeit.Password(true, true, true, true, true, 10))
+	r.PUT("/", ginhelper.ContextMiddleware(), func(ginCtx *gin.Context) {
+		ctx := ginhelper.GetContext(ginCtx)
+		ctx = context.WithValue(ctx, domain.CtxKeyUser, userDomain)
+		ginhelper.SetContext(ginCtx, ctx)
+		ginCtx.Next()
+	}, handler.UpdatePreferences)
+
+	newRequest := func(t *testing.T, body string) *http.Request {
+		httpRequest, err := http.NewRequest(http.MethodPut, "/", bytes.NewBufferString(body))
+		require.NoError(t, err)
+		return httpRequest
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		policy := domain.DuplicateItemPolicyBlock
+		updated := *userDomain
+		updated.DuplicateItemPolicy = policy
+		userUsecase.EXPECT().UpdatePreferences(gomock.Any(), &user.UpdatePreferencesInput{
+			User:                userDomain,
+			DuplicateItemPolicy: &policy,
+		}).Return(&user.UpdatePreferencesOutput{User: &updated}, nil)
+
+		responseWriter := httptest.NewRecorder()
+		r.ServeHTTP(responseWriter, newRequest(t, `{"duplicateItemPolicy":"block"}`))
+
+		responseData := &PreferencesResponse{}
+		resp := ginhelper.Response{Data: responseData}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, responseWriter.Code)
+		assert.Equal(t, &PreferencesResponse{DuplicateItemPolicy: domain.DuplicateItemPolicyBlock}, responseData)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		for _, body := range []string{`{`, `{}`, `{"duplicateItemPolicy":"ignore"}`} {
+			responseWriter := httptest.NewRecorder()
+			r.ServeHTTP(responseWriter, newRequest(t, body))
+
+			var resp ginhelper.Response
+			err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, responseWriter.Code, body)
+			assert.Equal(t, i18n.T(language.English, i18n.InvalidRequest, nil), resp.Meta.Message)
+		}
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		userUsecase.EXPECT().UpdatePreferences(gomock.Any(), gomock.Any()).Return(nil, domain.ErrUserNotFound)
+
+		responseWriter := httptest.NewRecorder()
+		r.ServeHTTP(responseWriter, newRequest(t, `{"duplicateItemPolicy":"warn"}`))
+
+		var resp ginhelper.Response
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, responseWriter.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.UserNotFound, nil), resp.Meta.Message)
+	})
+
+	t.Run("예상하지 못한 에러", func(t *testing.T) {
+		userUsecase.EXPECT().UpdatePreferences(gomock.Any(), gomock.Any()).Return(nil, gofakeit.Error())
+
+		responseWriter := httptest.NewRecorder()
+		r.ServeHTTP(responseWriter, newRequest(t, `{"duplicateItemPolicy":"warn"}`))
+
+		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
+	})
+}
+
 func newTestUser(t *testing.T, password string) *domain.User {
 	userDomain, err := domain.NewUser(
 		gofakeit.Regex(`^01\d{8,9}$`),
@@ -469,3 +714,295 @@ func newTestUser(t *testing.T, password string) *domain.User {
 
 	return userDomain
 }
+
+func TestUserHandler_GetSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userUsecase := ucmocks.NewMockUserUsecase(ctrl)
+	authTokenUsecase := ucmocks.NewMockAuthTokenUsecase(ctrl)
+
+	r := gin.New()
+	handler, err := NewUserHandler(userUsecase, authTokenUsecase)
+	require.NoError(t, err)
+	userDomain := newTestUser(t, gofakeit.Password(true, true, true, true, true, 10))
+	currentSessionID := gofakeit.LetterN(20)
+	r.GET("/", ginhelper.ContextMiddleware(), func(ginCtx *gin.Context) {
+		ctx := ginhelper.GetContext(ginCtx)
+		ctx = context.WithValue(ctx, domain.CtxKeyUser, userDomain)
+		ctx = context.WithValue(ctx, domain.CtxKeySessionID, currentSessionID)
+		ginhelper.SetContext(ginCtx, ctx)
+		ginCtx.Next()
+	}, handler.GetSessions)
+	r.GET("/unauthorized", handler.GetSessions)
+
+	t.Run("OK", func(t *testing.T) {
+		now := time.Now().Truncate(time.Second).UTC()
+		sessions := []domain.Session{
+			{ID: currentSessionID, IP: gofakeit.IPv4Address(), UserAgent: gofakeit.UserAgent(), CreatedAt: now, ExpiresAt: now.Add(time.Hour)},
+			{ID: gofakeit.LetterN(20), IP: gofakeit.IPv4Address(), UserAgent: gofakeit.UserAgent(), CreatedAt: now.Add(-time.Hour), ExpiresAt: now},
+		}
+		authTokenUsecase.EXPECT().FindSessions(gomock.Any(), &authtoken.FindSessionsInput{
+			Identifier: strconv.Itoa(userDomain.ID),
+		}).Return(&authtoken.FindSessionsOutput{Sessions: sessions}, nil)
+
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodGet, "/", nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		responseData := &GetSessionsResponse{}
+		resp := ginhelper.Response{Data: responseData}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, responseWriter.Code)
+		require.Len(t, responseData.Sessions, 2)
+		assert.Equal(t, SessionResponse{
+			ID:        sessions[0].ID,
+			IP:        sessions[0].IP,
+			UserAgent: sessions[0].UserAgent,
+			CreatedAt: sessions[0].CreatedAt,
+			ExpiresAt: sessions[0].ExpiresAt,
+			Current:   true,
+		}, responseData.Sessions[0])
+		assert.False(t, responseData.Sessions[1].Current)
+	})
+
+	t.Run("예상하지 못한 에러", func(t *testing.T) {
+		authTokenUsecase.EXPECT().FindSessions(gomock.Any(), gomock.Any()).Return(nil, gofakeit.Error())
+
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodGet, "/", nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodGet, "/unauthorized", nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
+	})
+}
+
+func TestUserHandler_DeleteSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userUsecase := ucmocks.NewMockUserUsecase(ctrl)
+	authTokenUsecase := ucmocks.NewMockAuthTokenUsecase(ctrl)
+
+	r := gin.New()
+	handler, err := NewUserHandler(userUsecase, authTokenUsecase)
+	require.NoError(t, err)
+	userDomain := newTestUser(t, gofakeit.Password(true, true, true, true, true, 10))
+	r.DELETE("/:sessionId", ginhelper.ContextMiddleware(), func(ginCtx *gin.Context) {
+		ctx := ginhelper.GetContext(ginCtx)
+		ctx = context.WithValue(ctx, domain.CtxKeyUser, userDomain)
+		ginhelper.SetContext(ginCtx, ctx)
+		ginCtx.Next()
+	}, handler.DeleteSession)
+	sessionID := gofakeit.LetterN(20)
+
+	t.Run("OK", func(t *testing.T) {
+		authTokenUsecase.EXPECT().RevokeSession(gomock.Any(), &authtoken.RevokeSessionInput{
+			Identifier: strconv.Itoa(userDomain.ID),
+			SessionID:  sessionID,
+		}).Return(nil)
+
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodDelete, "/"+sessionID, nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		assert.Equal(t, http.StatusNoContent, responseWriter.Code)
+	})
+
+	t.Run("세션이 존재하지 않을 때", func(t *testing.T) {
+		authTokenUsecase.EXPECT().RevokeSession(gomock.Any(), gomock.Any()).Return(errors.WithStack(domain.ErrSessionNotFound))
+
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodDelete, "/"+sessionID, nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		var resp ginhelper.Response
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, responseWriter.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.SessionNotFound, nil), resp.Meta.Message)
+	})
+
+	t.Run("예상하지 못한 에러", func(t *testing.T) {
+		authTokenUsecase.EXPECT().RevokeSession(gomock.Any(), gomock.Any()).Return(gofakeit.Error())
+
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodDelete, "/"+sessionID, nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
+	})
+}
+
+func TestUserHandler_RevokeAllSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userUsecase := ucmocks.NewMockUserUsecase(ctrl)
+	authTokenUsecase := ucmocks.NewMockAuthTokenUsecase(ctrl)
+
+	r := gin.New()
+	handler, err := NewUserHandler(userUsecase, authTokenUsecase)
+	require.NoError(t, err)
+	userDomain := newTestUser(t, gofakeit.Password(true, true, true, true, true, 10))
+	r.POST("/", ginhelper.ContextMiddleware(), func(ginCtx *gin.Context) {
+		ctx := ginhelper.GetContext(ginCtx)
+		ctx = context.WithValue(ctx, domain.CtxKeyUser, userDomain)
+		ginhelper.SetContext(ginCtx, ctx)
+		ginCtx.Next()
+	}, handler.RevokeAllSessions)
+	r.POST("/unauthorized", handler.RevokeAllSessions)
+
+	t.Run("OK", func(t *testing.T) {
+		userUsecase.EXPECT().InvalidateTokens(gomock.Any(), &user.InvalidateTokensInput{
+			User: userDomain,
+		}).Return(&user.InvalidateTokensOutput{User: userDomain, RevokedSessionCount: 3}, nil)
+
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodPost, "/", nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		responseData := &RevokeAllSessionsResponse{}
+		resp := ginhelper.Response{Data: responseData}
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, responseWriter.Code)
+		assert.Equal(t, &RevokeAllSessionsResponse{RevokedCount: 3}, responseData)
+	})
+
+	t.Run("토큰 무효화 실패", func(t *testing.T) {
+		userUsecase.EXPECT().InvalidateTokens(gomock.Any(), gomock.Any()).Return(nil, gofakeit.Error())
+
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodPost, "/", nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		responseWriter := httptest.NewRecorder()
+		httpRequest, err := http.NewRequest(http.MethodPost, "/unauthorized", nil)
+		require.NoError(t, err)
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
+	})
+}
+
+func TestUserHandler_ChangePassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userUsecase := ucmocks.NewMockUserUsecase(ctrl)
+	authTokenUsecase := ucmocks.NewMockAuthTokenUsecase(ctrl)
+
+	r := gin.New()
+	handler, err := NewUserHandler(userUsecase, authTokenUsecase)
+	require.NoError(t, err)
+	password := gofakeit.Password(true, true, true, true, true, 10)
+	newPassword := gofakeit.Password(true, true, true, true, true, 12)
+	userDomain := newTestUser(t, password)
+	r.PUT("/", ginhelper.ContextMiddleware(), func(ginCtx *gin.Context) {
+		ctx := ginhelper.GetContext(ginCtx)
+		ctx = context.WithValue(ctx, domain.CtxKeyUser, userDomain)
+		ginhelper.SetContext(ginCtx, ctx)
+		ginCtx.Next()
+	}, handler.ChangePassword)
+	r.PUT("/unauthorized", handler.ChangePassword)
+
+	newRequest := func(t *testing.T, path string, req ChangePasswordRequest) *http.Request {
+		b, err := json.Marshal(req)
+		require.NoError(t, err)
+		httpRequest, err := http.NewRequest(http.MethodPut, path, bytes.NewReader(b))
+		require.NoError(t, err)
+		return httpRequest
+	}
+	req := ChangePasswordRequest{CurrentPassword: password, NewPassword: newPassword}
+	changePasswordInput := &user.ChangePasswordInput{
+		User:            userDomain,
+		CurrentPassword: password,
+		NewPassword:     newPassword,
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		userUsecase.EXPECT().ChangePassword(gomock.Any(), changePasswordInput).Return(&user.ChangePasswordOutput{User: userDomain}, nil)
+
+		responseWriter := httptest.NewRecorder()
+		r.ServeHTTP(responseWriter, newRequest(t, "/", req))
+
+		assert.Equal(t, http.StatusNoContent, responseWriter.Code)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		for _, invalidReq := range []ChangePasswordRequest{
+			{NewPassword: newPassword},
+			{CurrentPassword: password},
+			{CurrentPassword: password, NewPassword: "short"},
+		} {
+			responseWriter := httptest.NewRecorder()
+			r.ServeHTTP(responseWriter, newRequest(t, "/", invalidReq))
+
+			var resp ginhelper.Response
+			err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, responseWriter.Code)
+			assert.Equal(t, i18n.T(language.English, i18n.InvalidRequest, nil), resp.Meta.Message)
+		}
+	})
+
+	t.Run("비밀번호가 틀렸을 때", func(t *testing.T) {
+		userUsecase.EXPECT().ChangePassword(gomock.Any(), changePasswordInput).Return(nil, domain.ErrPasswordMismatch)
+
+		responseWriter := httptest.NewRecorder()
+		r.ServeHTTP(responseWriter, newRequest(t, "/", req))
+
+		var resp ginhelper.Response
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, responseWriter.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.PasswordMismatch, nil), resp.Meta.Message)
+	})
+
+	t.Run("UserNotFound", func(t *testing.T) {
+		userUsecase.EXPECT().ChangePassword(gomock.Any(), changePasswordInput).Return(nil, domain.ErrUserNotFound)
+
+		responseWriter := httptest.NewRecorder()
+		r.ServeHTTP(responseWriter, newRequest(t, "/", req))
+
+		assert.Equal(t, http.StatusNotFound, responseWriter.Code)
+	})
+
+	t.Run("예상하지 못한 에러", func(t *testing.T) {
+		userUsecase.EXPECT().ChangePassword(gomock.Any(), changePasswordInput).Return(nil, gofakeit.Error())
+
+		responseWriter := httptest.NewRecorder()
+		r.ServeHTTP(responseWriter, newRequest(t, "/", req))
+
+		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		responseWriter := httptest.NewRecorder()
+		r.ServeHTTP(responseWriter, newRequest(t, "/unauthorized", req))
+
+		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
+	})
+}
@@ -0,0 +1,73 @@
+package bloom
+
+import (
+	"hash/fnv"
+	"math"
+)
+
+// Filter 문자열 키의 블룸 필터입니다.
+// Test가 false이면 키가 없는 것이 확실하며, true이면 falsePositiveRate의 확률로 없는 키일 수 있습니다.
+// 동시에 Add하지 않는다면 여러 고루틴에서 Test할 수 있습니다.
+type Filter struct {
+	bits      []uint64
+	size      uint64
+	hashCount uint64
+}
+
+// New expectedItems개의 키를 넣었을 때 오탐률이 falsePositiveRate가 되도록 필터를 생성합니다.
+func New(expectedItems int, falsePositiveRate float64) *Filter {
+	if expectedItems < 1 {
+		expectedItems = 1
+	}
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		falsePositiveRate = 0.01
+	}
+
+	// m = -n*ln(p)/(ln2)^2, k = m/n*ln2
+	size := uint64(math.Ceil(-float64(expectedItems) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	size = max(size, 64)
+	hashCount := uint64(math.Round(float64(size) / float64(expectedItems) * math.Ln2))
+	hashCount = max(hashCount, 1)
+
+	return &Filter{
+		bits:      make([]uint64, (size+63)/64),
+		size:      size,
+		hashCount: hashCount,
+	}
+}
+
+func (f *Filter) Add(key string) {
+	h1, h2 := hash(key)
+	for i := uint64(0); i < f.hashCount; i++ {
+		pos := (h1 + i*h2) % f.size
+		f.bits[pos/64] |= 1 << (pos % 64)
+	}
+}
+
+func (f *Filter) Test(key string) bool {
+	h1, h2 := hash(key)
+	for i := uint64(0); i < f.hashCount; i++ {
+		pos := (h1 + i*h2) % f.size
+		if f.bits[pos/64]&(1<<(pos%64)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// hash 128비트 FNV-1a 해시를 두 개의 64비트 해시로 나누어 반환합니다. (double hashing)
+func hash(key string) (uint64, uint64) {
+	h := fnv.New128a()
+	_, _ = h.Write([]byte(key))
+	sum := h.Sum(nil)
+
+	var h1, h2 uint64
+	for i := 0; i < 8; i++ {
+		h1 = h1<<8 | uint64(sum[i])
+		h2 = h2<<8 | uint64(sum[8+i])
+	}
+
+	// h2가 0이면 모든 해시가 같은 위치를 가리키므로 홀수로 만듭니다.
+	return h1, h2 | 1
+}
@@ -0,0 +1,40 @@
+package bloom
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilter(t *testing.T) {
+	const n = 10000
+	filter := New(n, 0.01)
+	for i := 0; i < n; i++ {
+		filter.Add("added-" + strconv.Itoa(i))
+	}
+
+	t.Run("추가한 키", func(t *testing.T) {
+		for i := 0; i < n; i++ {
+			assert.True(t, filter.Test("added-"+strconv.Itoa(i)))
+		}
+	})
+
+	t.Run("오탐률", func(t *testing.T) {
+		var falsePositives int
+		for i := 0; i < n; i++ {
+			if filter.Test("missing-" + strconv.Itoa(i)) {
+				falsePositives++
+			}
+		}
+		assert.Less(t, float64(falsePositives)/n, 0.02)
+	})
+}
+
+func TestNew(t *testing.T) {
+	for _, filter := range []*Filter{New(0, 0.01), New(-1, 0), New(1, 1)} {
+		assert.False(t, filter.Test("key"))
+		filter.Add("key")
+		assert.True(t, filter.Test("key"))
+	}
+}
@@ -1,28 +1,112 @@
 package db
 
 import (
+	"fmt"
 	"net"
+	"net/url"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/glebarez/sqlite"
 	"github.com/go-sql-driver/mysql"
 	gorm_mysql "gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
+const (
+	DriverMySQL    = "mysql"
+	DriverSQLite   = "sqlite"
+	DriverPostgres = "postgres"
+)
+
 type Config struct {
-	Host            string `json:"host" yaml:"host"`
-	Port            int    `json:"port" yaml:"port"`
-	Database        string `json:"database" yaml:"database"`
-	Username        string `json:"username" yaml:"username"`
-	Password        string `json:"password" yaml:"password"`
+	// Driver 사용할 DB 드라이버이며, 설정되지 않은 경우 MySQL을 사용합니다.
+	Driver string `json:"driver" yaml:"driver"`
+	Host   string `json:"host" yaml:"host"`
+	Port   int    `json:"port" yaml:"port"`
+	// Database SQLite의 경우 DB 파일 경로를 의미합니다.
+	Database string `json:"database" yaml:"database"`
+	Username string `json:"username" yaml:"username"`
+	Password string `json:"password" yaml:"password"`
+	// SSLMode PostgreSQL의 sslmode이며, 설정되지 않은 경우 disable을 사용합니다.
+	SSLMode         string `json:"ssl_mode" yaml:"ssl_mode"`
 	Verbose         bool   `json:"verbose" yaml:"verbose"`
 	MaxOpenConns    int    `json:"max_open_conns" yaml:"max_open_conns"`
 	MaxIdleConns    int    `json:"max_idle_conns" yaml:"max_idle_conns"`
 	ConnMaxLifetime int    `json:"conn_max_lifetime" yaml:"conn_max_lifetime"`
 	ConnMaxIdleTime int    `json:"conn_max_idle_time" yaml:"conn_max_idle_time"`
+	// Replicas 읽기 전용 복제본 목록이며, 트랜잭션 밖의 조회 쿼리는 복제본으로 전달됩니다.
+	// 복제본에 설정되지 않은 접속 정보와 커넥션 풀 설정은 primary의 설정을 사용합니다.
+	Replicas []Config `json:"replicas" yaml:"replicas"`
+	// ReplicaHealthCheckInterval 복제본 상태 점검 주기(초)이며, 설정되지 않은 경우 10초마다 점검합니다.
+	ReplicaHealthCheckInterval int `json:"replica_health_check_interval" yaml:"replica_health_check_interval"`
+}
+
+// HealthCheckInterval 복제본 상태 점검 주기를 반환합니다.
+func (c *Config) HealthCheckInterval() time.Duration {
+	if c.ReplicaHealthCheckInterval > 0 {
+		return time.Duration(c.ReplicaHealthCheckInterval) * time.Second
+	}
+
+	return defaultReplicaHealthCheckInterval
+}
+
+// replicaConfig i번째 복제본의 설정에 primary의 설정을 채워 반환합니다.
+func (c *Config) replicaConfig(i int) Config {
+	replica := c.Replicas[i]
+	replica.Driver = c.Driver
+	replica.Replicas = nil
+	if len(replica.Host) == 0 {
+		replica.Host = c.Host
+	}
+	if replica.Port == 0 {
+		replica.Port = c.Port
+	}
+	if len(replica.Database) == 0 {
+		replica.Database = c.Database
+	}
+	if len(replica.Username) == 0 {
+		replica.Username = c.Username
+		replica.Password = c.Password
+	}
+	if len(replica.SSLMode) == 0 {
+		replica.SSLMode = c.SSLMode
+	}
+	if replica.MaxOpenConns == 0 {
+		replica.MaxOpenConns = c.MaxOpenConns
+	}
+	if replica.MaxIdleConns == 0 {
+		replica.MaxIdleConns = c.MaxIdleConns
+	}
+	if replica.ConnMaxLifetime == 0 {
+		replica.ConnMaxLifetime = c.ConnMaxLifetime
+	}
+	if replica.ConnMaxIdleTime == 0 {
+		replica.ConnMaxIdleTime = c.ConnMaxIdleTime
+	}
+
+	return replica
+}
+
+// replicaName 로그에 사용할 복제본 이름을 반환합니다.
+func (c *Config) replicaName(i int) string {
+	if c.Driver == DriverSQLite {
+		return fmt.Sprintf("replica-%d(%s)", i, c.Database)
+	}
+
+	return fmt.Sprintf("replica-%d(%s)", i, net.JoinHostPort(c.Host, strconv.Itoa(c.Port)))
 }
 
 func (c *Config) DSN() string {
+	switch c.Driver {
+	case DriverSQLite:
+		return c.sqliteDSN()
+	case DriverPostgres:
+		return c.postgresDSN()
+	}
+
 	mysqlConfig := mysql.NewConfig()
 	mysqlConfig.User = c.Username
 	mysqlConfig.Passwd = c.Password
@@ -39,6 +123,57 @@ func (c *Config) DSN() string {
 	return mysqlConfig.FormatDSN()
 }
 
+func (c *Config) sqliteDSN() string {
+	params := url.Values{}
+	params.Add("_pragma", "foreign_keys(1)")
+	params.Add("_pragma", "busy_timeout(5000)")
+	params.Add("_pragma", "journal_mode(WAL)")
+
+	return "file:" + c.Database + "?" + params.Encode()
+}
+
+func (c *Config) postgresDSN() string {
+	sslMode := c.SSLMode
+	if len(sslMode) == 0 {
+		sslMode = "disable"
+	}
+	params := []string{
+		fmt.Sprintf("host=%s", quoteDSNValue(c.Host)),
+		fmt.Sprintf("port=%d", c.Port),
+		fmt.Sprintf("user=%s", quoteDSNValue(c.Username)),
+		fmt.Sprintf("password=%s", quoteDSNValue(c.Password)),
+		fmt.Sprintf("dbname=%s", quoteDSNValue(c.Database)),
+		fmt.Sprintf("sslmode=%s", quoteDSNValue(sslMode)),
+		"TimeZone=UTC",
+	}
+
+	return strings.Join(params, " ")
+}
+
+// quoteDSNValue PostgreSQL 연결 문자열의 값을 작은 따옴표로 감쌉니다.
+func quoteDSNValue(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
+
+// driverName database/sql에 등록된 드라이버 이름을 반환합니다.
+func (c *Config) driverName() string {
+	switch c.Driver {
+	case DriverSQLite:
+		return sqlite.DriverName
+	case DriverPostgres:
+		return "pgx"
+	default:
+		return DriverMySQL
+	}
+}
+
 func (c *Config) Dialector() gorm.Dialector {
-	return gorm_mysql.Open(c.DSN())
+	switch c.Driver {
+	case DriverSQLite:
+		return sqlite.Open(c.DSN())
+	case DriverPostgres:
+		return postgres.Open(c.DSN())
+	default:
+		return gorm_mysql.Open(c.DSN())
+	}
 }
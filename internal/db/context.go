@@ -13,6 +13,8 @@ var (
 	ctxKey = contextKey{}
 )
 
+// ConnFromContext 컨텍스트의 커넥션을 반환합니다.
+// 복제본이 설정된 경우 트랜잭션 밖의 조회 쿼리는 복제본으로, 쓰기 쿼리와 트랜잭션은 primary로 전달됩니다.
 func ConnFromContext(c context.Context) (*gorm.DB, error) {
 	if c == nil {
 		return nil, fmt.Errorf("nil Context")
@@ -29,3 +31,17 @@ func ConnFromContext(c context.Context) (*gorm.DB, error) {
 func ContextWithConn(c context.Context, db *gorm.DB) context.Context {
 	return context.WithValue(c, ctxKey, db)
 }
+
+// Transactor DB 커넥션을 사용하지 않는 저장소에서 트랜잭션 대신 fn을 실행합니다.
+type Transactor interface {
+	Transaction(c context.Context, fn func(c context.Context) error) error
+}
+
+type transactorContextKey struct{}
+
+var transactorCtxKey = transactorContextKey{}
+
+// ContextWithTransactor 커넥션이 없는 컨텍스트에서 Transaction이 사용할 Transactor를 지정합니다.
+func ContextWithTransactor(c context.Context, transactor Transactor) context.Context {
+	return context.WithValue(c, transactorCtxKey, transactor)
+}
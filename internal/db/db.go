@@ -27,11 +27,28 @@ func Connect(c Config) (*gorm.DB, error) {
 		return nil, fmt.Errorf("failed to get standard db object: %w", err)
 	}
 
+	configurePool(stdDB, c)
+
+	if len(c.Replicas) > 0 {
+		if err := connectReplicas(db, c); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+
+	return db, nil
+}
+
+// configurePool 설정에 따라 커넥션 풀을 설정합니다.
+func configurePool(stdDB *sql.DB, c Config) {
 	maxOpenConn := 10
+	if c.Driver == DriverSQLite {
+		// SQLite는 동시에 하나의 쓰기만 허용하므로 기본적으로 커넥션 하나를 사용합니다.
+		maxOpenConn = 1
+	}
 	if c.MaxOpenConns > 0 {
 		maxOpenConn = c.MaxOpenConns
 	}
-	maxIdleConn := maxOpenConn / 2
+	maxIdleConn := max(maxOpenConn/2, 1)
 	if c.MaxIdleConns > 0 {
 		maxIdleConn = c.MaxIdleConns
 	}
@@ -48,12 +65,17 @@ func Connect(c Config) (*gorm.DB, error) {
 	stdDB.SetConnMaxLifetime(connMaxLifetime)
 	stdDB.SetMaxIdleConns(maxIdleConn)
 	stdDB.SetConnMaxIdleTime(connMaxIdletime)
-
-	return db, nil
 }
 
+// Transaction 컨텍스트의 커넥션으로 트랜잭션을 시작하고 fn을 실행합니다.
+// 커넥션이 없는 경우 ContextWithTransactor로 지정한 Transactor를 사용하며, 둘 다 없으면 ErrNilDB를 반환합니다.
 func Transaction(c context.Context, fn func(c context.Context) error, opts ...*sql.TxOptions) error {
 	conn, err := ConnFromContext(c)
+	if errors.Is(err, ErrNilDB) {
+		if transactor, ok := c.Value(transactorCtxKey).(Transactor); ok {
+			return transactor.Transaction(c, fn)
+		}
+	}
 	if err != nil {
 		return errors.Wrap(err, "failed to get connection")
 	}
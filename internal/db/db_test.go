@@ -0,0 +1,65 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testTransactor struct {
+	called int
+}
+
+func (t *testTransactor) Transaction(c context.Context, fn func(c context.Context) error) error {
+	t.called++
+	return fn(c)
+}
+
+func TestTransaction(t *testing.T) {
+	t.Run("커밋과 롤백", func(t *testing.T) {
+		database := filepath.Join(t.TempDir(), "payhere.db")
+		createTestDatabase(t, database, "before")
+		conn, err := Connect(Config{Driver: DriverSQLite, Database: database})
+		require.NoError(t, err)
+		ctx := ContextWithConn(context.TODO(), conn)
+		update := func(name string, fnErr error) error {
+			return Transaction(ctx, func(c context.Context) error {
+				tx, err := ConnFromContext(c)
+				require.NoError(t, err)
+				require.NoError(t, tx.Model(&testRecord{}).Where("id = ?", 1).Update("name", name).Error)
+				return fnErr
+			})
+		}
+
+		assert.Error(t, update("rollback", fmt.Errorf("failed")))
+		assert.Equal(t, "before", nameOf(t, conn, 1))
+		assert.NoError(t, update("commit", nil))
+		assert.Equal(t, "commit", nameOf(t, conn, 1))
+	})
+
+	t.Run("커넥션 없이 Transactor 사용", func(t *testing.T) {
+		transactor := &testTransactor{}
+		ctx := ContextWithTransactor(context.TODO(), transactor)
+
+		var called bool
+		err := Transaction(ctx, func(c context.Context) error {
+			called = true
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.True(t, called)
+		assert.Equal(t, 1, transactor.called)
+	})
+
+	t.Run("커넥션과 Transactor가 없음", func(t *testing.T) {
+		err := Transaction(context.TODO(), func(c context.Context) error {
+			t.Fatal("fn must not be called without a transaction")
+			return nil
+		})
+		assert.ErrorIs(t, err, ErrNilDB)
+	})
+}
@@ -0,0 +1,185 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+const (
+	replicaPluginName = "payhere:replica"
+
+	defaultReplicaHealthCheckInterval = 10 * time.Second
+	replicaPingTimeout                = 3 * time.Second
+)
+
+type primaryContextKey struct{}
+
+var primaryCtxKey = primaryContextKey{}
+
+// ContextWithPrimary 복제본 설정과 관계없이 조회 쿼리도 primary로 전달되도록 합니다.
+// 쓰기 직후 변경된 데이터를 다시 조회하는 경우처럼 복제 지연을 허용할 수 없을 때 사용합니다.
+func ContextWithPrimary(c context.Context) context.Context {
+	return context.WithValue(c, primaryCtxKey, true)
+}
+
+// UsePrimary 컨텍스트가 primary 사용을 강제하는지 반환합니다.
+func UsePrimary(c context.Context) bool {
+	if c == nil {
+		return false
+	}
+	v, _ := c.Value(primaryCtxKey).(bool)
+
+	return v
+}
+
+type replica struct {
+	name    string
+	db      *sql.DB
+	healthy atomic.Bool
+}
+
+// replicaResolver 트랜잭션 밖의 조회 쿼리를 정상 상태인 복제본으로 분배하고, 나머지 쿼리는 primary로 전달합니다.
+type replicaResolver struct {
+	replicas []*replica
+	next     atomic.Uint64
+}
+
+func (r *replicaResolver) Name() string {
+	return replicaPluginName
+}
+
+func (r *replicaResolver) Initialize(db *gorm.DB) error {
+	primary := db.ConnPool
+	toPrimary := func(db *gorm.DB) {
+		r.route(db, primary, false)
+	}
+	toReplica := func(db *gorm.DB) {
+		r.route(db, primary, true)
+	}
+	toReplicaIfSelect := func(db *gorm.DB) {
+		// Raw로 작성된 쿼리는 SELECT 문인 경우에만 복제본으로 전달합니다.
+		query := strings.TrimSpace(db.Statement.SQL.String())
+		r.route(db, primary, len(query) == 0 || strings.HasPrefix(strings.ToLower(query), "select"))
+	}
+
+	for _, err := range []error{
+		db.Callback().Create().Before("gorm:create").Register(replicaPluginName, toPrimary),
+		db.Callback().Update().Before("gorm:update").Register(replicaPluginName, toPrimary),
+		db.Callback().Delete().Before("gorm:delete").Register(replicaPluginName, toPrimary),
+		db.Callback().Raw().Before("gorm:raw").Register(replicaPluginName, toPrimary),
+		db.Callback().Query().Before("gorm:query").Register(replicaPluginName, toReplica),
+		db.Callback().Row().Before("gorm:row").Register(replicaPluginName, toReplicaIfSelect),
+	} {
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return nil
+}
+
+// route 쿼리를 전달할 커넥션을 지정합니다. 트랜잭션 중인 쿼리는 트랜잭션의 커넥션을 그대로 사용합니다.
+func (r *replicaResolver) route(db *gorm.DB, primary gorm.ConnPool, read bool) {
+	if _, ok := db.Statement.ConnPool.(gorm.TxCommitter); ok {
+		return
+	}
+
+	// 같은 Statement로 조회 후 쓰기를 하는 경우가 있으므로 쓰기 쿼리는 항상 primary를 다시 지정합니다.
+	db.Statement.ConnPool = primary
+	if !read || UsePrimary(db.Statement.Context) {
+		return
+	}
+	if rep := r.pick(); rep != nil {
+		db.Statement.ConnPool = rep.db
+	}
+}
+
+// pick 정상 상태인 복제본을 순서대로 반환하며, 정상 상태인 복제본이 없으면 nil을 반환합니다.
+func (r *replicaResolver) pick() *replica {
+	n := len(r.replicas)
+	if n == 0 {
+		return nil
+	}
+
+	start := r.next.Add(1)
+	for i := 0; i < n; i++ {
+		rep := r.replicas[(start+uint64(i))%uint64(n)]
+		if rep.healthy.Load() {
+			return rep
+		}
+	}
+
+	return nil
+}
+
+func (r *replicaResolver) check(c context.Context) {
+	for _, rep := range r.replicas {
+		pingCtx, cancel := context.WithTimeout(c, replicaPingTimeout)
+		err := rep.db.PingContext(pingCtx)
+		cancel()
+
+		healthy := err == nil
+		if rep.healthy.Swap(healthy) == healthy {
+			continue
+		}
+		if healthy {
+			log.Info().Str("replica", rep.name).Msg("replica is in rotation")
+		} else {
+			log.Warn().Err(err).Str("replica", rep.name).Msg("replica is out of rotation")
+		}
+	}
+}
+
+// connectReplicas 복제본에 연결하고 primary 커넥션에 복제본 라우팅을 등록합니다.
+// 연결할 수 없는 복제본은 상태 점검에서 정상으로 확인될 때까지 사용하지 않습니다.
+func connectReplicas(primary *gorm.DB, c Config) error {
+	resolver := &replicaResolver{}
+	for i := range c.Replicas {
+		replicaConfig := c.replicaConfig(i)
+		// 복제본 장애로 서버가 시작되지 않는 일이 없도록 연결을 시도하지 않는 sql.Open을 사용합니다.
+		stdDB, err := sql.Open(replicaConfig.driverName(), replicaConfig.DSN())
+		if err != nil {
+			return fmt.Errorf("failed to open replica: %w", err)
+		}
+		configurePool(stdDB, replicaConfig)
+
+		resolver.replicas = append(resolver.replicas, &replica{
+			name: replicaConfig.replicaName(i),
+			db:   stdDB,
+		})
+	}
+	resolver.check(context.Background())
+
+	if err := primary.Use(resolver); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// CheckReplicas 복제본의 상태를 점검합니다.
+// 응답하지 않는 복제본은 조회 대상에서 제외하고, 다시 응답하는 복제본은 조회 대상에 포함합니다.
+func CheckReplicas(c context.Context, conn *gorm.DB) error {
+	switch {
+	case c == nil:
+		return fmt.Errorf("nil Context")
+	case conn == nil:
+		return ErrNilDB
+	}
+
+	resolver, ok := conn.Config.Plugins[replicaPluginName].(*replicaResolver)
+	if !ok {
+		return nil
+	}
+	resolver.check(c)
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package db
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type testRecord struct {
+	ID   int
+	Name string
+}
+
+func (r *testRecord) TableName() string {
+	return "records"
+}
+
+// createTestDatabase SQLite DB 파일을 생성하고 name을 이름으로 하는 레코드를 추가합니다.
+func createTestDatabase(t *testing.T, database, name string) {
+	conn, err := Connect(Config{Driver: DriverSQLite, Database: database})
+	require.NoError(t, err)
+	require.NoError(t, conn.Exec("CREATE TABLE records (id INTEGER PRIMARY KEY, name TEXT NOT NULL)").Error)
+	require.NoError(t, conn.Create(&testRecord{ID: 1, Name: name}).Error)
+
+	stdDB, err := conn.DB()
+	require.NoError(t, err)
+	require.NoError(t, stdDB.Close())
+}
+
+func nameOf(t *testing.T, conn *gorm.DB, id int) string {
+	var record testRecord
+	require.NoError(t, conn.Where("id = ?", id).Take(&record).Error)
+
+	return record.Name
+}
+
+func newTestReplicaContext(t *testing.T) (ctx context.Context, primary, replica string) {
+	dir := t.TempDir()
+	primary = filepath.Join(dir, "primary.db")
+	replica = filepath.Join(dir, "replica.db")
+	createTestDatabase(t, primary, "primary")
+	createTestDatabase(t, replica, "replica")
+
+	conn, err := Connect(Config{
+		Driver:   DriverSQLite,
+		Database: primary,
+		Replicas: []Config{{Database: replica}},
+	})
+	require.NoError(t, err)
+
+	return ContextWithConn(context.TODO(), conn), primary, replica
+}
+
+func TestConnFromContext_Replica(t *testing.T) {
+	ctx, primary, replica := newTestReplicaContext(t)
+	conn, err := ConnFromContext(ctx)
+	require.NoError(t, err)
+
+	t.Run("조회는 복제본", func(t *testing.T) {
+		assert.Equal(t, "replica", nameOf(t, conn, 1))
+
+		var name string
+		require.NoError(t, conn.Raw("SELECT name FROM records WHERE id = ?", 1).Scan(&name).Error)
+		assert.Equal(t, "replica", name)
+
+		var count int64
+		require.NoError(t, conn.Model(&testRecord{}).Count(&count).Error)
+		assert.EqualValues(t, 1, count)
+	})
+
+	t.Run("쓰기는 primary", func(t *testing.T) {
+		require.NoError(t, conn.Create(&testRecord{ID: 2, Name: "created"}).Error)
+
+		primaryConn, err := Connect(Config{Driver: DriverSQLite, Database: primary})
+		require.NoError(t, err)
+		assert.Equal(t, "created", nameOf(t, primaryConn, 2))
+		replicaConn, err := Connect(Config{Driver: DriverSQLite, Database: replica})
+		require.NoError(t, err)
+		assert.ErrorIs(t, replicaConn.Where("id = ?", 2).Take(&testRecord{}).Error, gorm.ErrRecordNotFound)
+	})
+
+	t.Run("primary 강제", func(t *testing.T) {
+		primaryConn, err := ConnFromContext(ContextWithPrimary(ctx))
+		require.NoError(t, err)
+		assert.Equal(t, "created", nameOf(t, primaryConn, 2))
+	})
+
+	t.Run("트랜잭션은 primary", func(t *testing.T) {
+		err := Transaction(ctx, func(c context.Context) error {
+			tx, err := ConnFromContext(c)
+			require.NoError(t, err)
+			assert.Equal(t, "created", nameOf(t, tx, 2))
+			return nil
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("조회 후 같은 Statement로 쓰기", func(t *testing.T) {
+		query := conn.Where("id = ?", 1)
+		var record testRecord
+		require.NoError(t, query.Take(&record).Error)
+		assert.Equal(t, "replica", record.Name)
+		require.NoError(t, query.Exec("UPDATE records SET name = ? WHERE id = ?", "updated", 1).Error)
+
+		primaryConn, err := ConnFromContext(ContextWithPrimary(ctx))
+		require.NoError(t, err)
+		assert.Equal(t, "updated", nameOf(t, primaryConn, 1))
+		assert.Equal(t, "replica", nameOf(t, conn, 1))
+	})
+}
+
+func TestCheckReplicas(t *testing.T) {
+	dir := t.TempDir()
+	primary := filepath.Join(dir, "primary.db")
+	createTestDatabase(t, primary, "primary")
+	// 복제본 디렉터리가 없으므로 복제본에 연결할 수 없습니다.
+	replica := filepath.Join(dir, "replica", "replica.db")
+
+	conn, err := Connect(Config{
+		Driver:   DriverSQLite,
+		Database: primary,
+		Replicas: []Config{{Database: replica}},
+	})
+	require.NoError(t, err)
+	ctx := ContextWithConn(context.TODO(), conn)
+
+	t.Run("장애 복제본은 제외", func(t *testing.T) {
+		require.NoError(t, CheckReplicas(ctx, conn))
+		assert.Equal(t, "primary", nameOf(t, conn, 1))
+	})
+
+	t.Run("복구된 복제본은 포함", func(t *testing.T) {
+		require.NoError(t, os.Mkdir(filepath.Dir(replica), 0o755))
+		createTestDatabase(t, replica, "replica")
+
+		require.NoError(t, CheckReplicas(ctx, conn))
+		assert.Equal(t, "replica", nameOf(t, conn, 1))
+	})
+
+	t.Run("복제본이 없는 커넥션", func(t *testing.T) {
+		primaryConn, err := Connect(Config{Driver: DriverSQLite, Database: primary})
+		require.NoError(t, err)
+		assert.NoError(t, CheckReplicas(ctx, primaryConn))
+	})
+
+	t.Run("nil Context", func(t *testing.T) {
+		assert.Error(t, CheckReplicas(nil, conn))
+	})
+
+	t.Run("nil conn", func(t *testing.T) {
+		assert.ErrorIs(t, CheckReplicas(ctx, nil), ErrNilDB)
+	})
+}
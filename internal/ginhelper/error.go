@@ -29,6 +29,7 @@ func Error(ginCtx *gin.Context, err error) {
 			Meta: ResponseMeta{
 				Code:    httpError.StatusCode,
 				Message: httpError.Message(),
+				Details: httpError.Details,
 			},
 		},
 	)
@@ -48,6 +49,8 @@ type HTTPError struct {
 	StatusCode int
 	ErrorCode  string
 	Internal   error
+	// Details 클라이언트가 에러를 처리하는 데 필요한 추가 정보이며, 응답의 meta.details로 전달됩니다.
+	Details any
 }
 
 func NewHTTPError(statusCode int, msgID string, err error) *HTTPError {
@@ -58,6 +61,12 @@ func NewHTTPError(statusCode int, msgID string, err error) *HTTPError {
 	}
 }
 
+// WithDetails 응답에 details를 포함합니다.
+func (e *HTTPError) WithDetails(details any) *HTTPError {
+	e.Details = details
+	return e
+}
+
 func (e *HTTPError) Error() string {
 	return fmt.Sprintf("[%s:%d] %s: %v", e.ErrorCode, e.StatusCode, e.Message(), e.Internal)
 }
@@ -1,6 +1,7 @@
 package ginhelper
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -8,13 +9,15 @@ import (
 	"github.com/gin-contrib/requestid"
 	"github.com/gin-gonic/gin"
 	"github.com/psi59/gopkg/ctxlog"
+	"github.com/psi59/payhere-assignment/domain"
 	"github.com/psi59/payhere-assignment/internal/valid"
 	"github.com/rs/zerolog"
 )
 
 func ContextMiddleware() gin.HandlerFunc {
 	return func(ginCtx *gin.Context) {
-		SetContext(ginCtx, ginCtx)
+		ctx := context.WithValue(ginCtx, domain.CtxKeyRequestID, requestid.Get(ginCtx))
+		SetContext(ginCtx, ctx)
 		ginCtx.Next()
 	}
 }
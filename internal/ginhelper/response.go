@@ -8,4 +8,5 @@ type Response struct {
 type ResponseMeta struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
+	Details any    `json:"details,omitempty"`
 }
@@ -0,0 +1,26 @@
+package hangul
+
+import (
+	"github.com/daangn/gorean"
+	"github.com/pkg/errors"
+)
+
+// GetChosung 문자열의 초성을 반환합니다.
+// 한글이 아닌 문자는 그대로 유지됩니다.
+func GetChosung(s string) (string, error) {
+	cc, err := gorean.Chosung(s)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	var result string
+	for _, c := range cc {
+		if c == "" {
+			result += " "
+		} else {
+			result += c
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,150 @@
+package hangul
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetChosung(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{
+			name: "한글",
+			s:    "아메리카노",
+			want: "ㅇㅁㄹㅋㄴ",
+		},
+		{
+			name: "공백 포함",
+			s:    "아이스 라떼",
+			want: "ㅇㅇㅅ ㄹㄸ",
+		},
+		{
+			name: "영문 포함",
+			s:    "ICE 라떼",
+			want: "ICE ㄹㄸ",
+		},
+		{
+			name: "빈 문자열",
+			s:    "",
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetChosung(tt.s)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDisassemble(t *testing.T) {
+	tests := []struct {
+		name        string
+		s           string
+		wantRunes   string
+		wantOffsets []int
+	}{
+		{
+			name:        "한글",
+			s:           "아멜",
+			wantRunes:   "ㅇㅏㅁㅔㄹ",
+			wantOffsets: []int{0, 0, 1, 1, 1},
+		},
+		{
+			name:        "겹모음과 겹받침",
+			s:           "괜찮",
+			wantRunes:   "ㄱㅗㅐㄴㅊㅏㄴㅎ",
+			wantOffsets: []int{0, 0, 0, 0, 1, 1, 1, 1},
+		},
+		{
+			name:        "쌍자음",
+			s:           "빵",
+			wantRunes:   "ㅃㅏㅇ",
+			wantOffsets: []int{0, 0, 0},
+		},
+		{
+			name:        "영문과 공백 포함",
+			s:           "ICE 티",
+			wantRunes:   "ICE ㅌㅣ",
+			wantOffsets: []int{0, 1, 2, 3, 4, 4},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Disassemble(tt.s)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantRunes, string(got.Runes))
+			require.Equal(t, tt.wantOffsets, got.Offsets)
+		})
+	}
+}
+
+func TestNameLength(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want int
+	}{
+		{name: "한글", s: "아메리카노", want: 10},
+		{name: "겹모음과 공백", s: "아이스 과자", want: 11},
+		{name: "대소문자와 공백", s: " Cafe Latte ", want: 9},
+		{name: "빈 문자열", s: "", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NameLength(tt.s)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFromQwerty(t *testing.T) {
+	tests := []struct {
+		name   string
+		s      string
+		want   string
+		wantOk bool
+	}{
+		{
+			name:   "영문 자판으로 입력한 한글",
+			s:      "dkapflzksh",
+			want:   "ㅇㅏㅁㅔㄹㅣㅋㅏㄴㅗ",
+			wantOk: true,
+		},
+		{
+			name:   "쌍자음",
+			s:      "Qkd",
+			want:   "ㅃㅏㅇ",
+			wantOk: true,
+		},
+		{
+			name:   "Shift로 입력하지 않는 키",
+			s:      "DK fkEp",
+			want:   "ㅇㅏ ㄹㅏㄸㅔ",
+			wantOk: true,
+		},
+		{
+			name:   "영문이 아닌 문자 포함",
+			s:      "dk1",
+			wantOk: false,
+		},
+		{
+			name:   "한글",
+			s:      "아메",
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := FromQwerty(tt.s)
+			require.Equal(t, tt.wantOk, ok)
+			require.Equal(t, tt.want, string(got))
+		})
+	}
+}
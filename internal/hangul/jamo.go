@@ -0,0 +1,121 @@
+package hangul
+
+import (
+	"strings"
+
+	"github.com/daangn/gorean"
+	"github.com/pkg/errors"
+	"golang.org/x/text/unicode/norm"
+)
+
+// compoundJamo 겹모음과 겹받침을 자판으로 입력하는 순서대로 나눈 값입니다.
+// 쌍자음(ㄲ, ㄸ, ㅃ, ㅆ, ㅉ)과 ㅐ, ㅔ, ㅒ, ㅖ는 키 하나로 입력하므로 나누지 않습니다.
+var compoundJamo = map[rune][]rune{
+	'ㅘ': {'ㅗ', 'ㅏ'},
+	'ㅙ': {'ㅗ', 'ㅐ'},
+	'ㅚ': {'ㅗ', 'ㅣ'},
+	'ㅝ': {'ㅜ', 'ㅓ'},
+	'ㅞ': {'ㅜ', 'ㅔ'},
+	'ㅟ': {'ㅜ', 'ㅣ'},
+	'ㅢ': {'ㅡ', 'ㅣ'},
+	'ㄳ': {'ㄱ', 'ㅅ'},
+	'ㄵ': {'ㄴ', 'ㅈ'},
+	'ㄶ': {'ㄴ', 'ㅎ'},
+	'ㄺ': {'ㄹ', 'ㄱ'},
+	'ㄻ': {'ㄹ', 'ㅁ'},
+	'ㄼ': {'ㄹ', 'ㅂ'},
+	'ㄽ': {'ㄹ', 'ㅅ'},
+	'ㄾ': {'ㄹ', 'ㅌ'},
+	'ㄿ': {'ㄹ', 'ㅍ'},
+	'ㅀ': {'ㄹ', 'ㅎ'},
+	'ㅄ': {'ㅂ', 'ㅅ'},
+}
+
+// qwertyJamo 두벌식 자판에서 영문 키에 해당하는 자모입니다.
+var qwertyJamo = map[rune]rune{
+	'q': 'ㅂ', 'w': 'ㅈ', 'e': 'ㄷ', 'r': 'ㄱ', 't': 'ㅅ', 'y': 'ㅛ', 'u': 'ㅕ', 'i': 'ㅑ', 'o': 'ㅐ', 'p': 'ㅔ',
+	'a': 'ㅁ', 's': 'ㄴ', 'd': 'ㅇ', 'f': 'ㄹ', 'g': 'ㅎ', 'h': 'ㅗ', 'j': 'ㅓ', 'k': 'ㅏ', 'l': 'ㅣ',
+	'z': 'ㅋ', 'x': 'ㅌ', 'c': 'ㅊ', 'v': 'ㅍ', 'b': 'ㅠ', 'n': 'ㅜ', 'm': 'ㅡ',
+	'Q': 'ㅃ', 'W': 'ㅉ', 'E': 'ㄸ', 'R': 'ㄲ', 'T': 'ㅆ', 'O': 'ㅒ', 'P': 'ㅖ',
+}
+
+// Jamo 문자열을 자모 단위로 분해한 결과입니다.
+type Jamo struct {
+	Runes []rune
+	// Offsets Runes의 각 자모가 속한 원래 문자열의 문자(rune) 위치입니다.
+	Offsets []int
+}
+
+// Disassemble 문자열을 자판 입력 순서대로 자모 단위로 분해합니다.
+// 겹모음과 겹받침도 나누므로 "과"는 ㄱ, ㅗ, ㅏ로 분해되며, 한글이 아닌 문자는 그대로 유지됩니다.
+func Disassemble(s string) (Jamo, error) {
+	words, err := gorean.Split(s, gorean.SplitOptBasic)
+	if err != nil {
+		return Jamo{}, errors.WithStack(err)
+	}
+
+	var jamo Jamo
+	for offset, tokens := range words {
+		for _, token := range tokens {
+			for _, r := range token {
+				runes, ok := compoundJamo[r]
+				if !ok {
+					runes = []rune{r}
+				}
+				for _, r := range runes {
+					jamo.Runes = append(jamo.Runes, r)
+					jamo.Offsets = append(jamo.Offsets, offset)
+				}
+			}
+		}
+	}
+
+	return jamo, nil
+}
+
+// NormalizeName 이름을 비교하기 위해 NFC로 정규화하고 소문자로 변환한 뒤 공백을 제거합니다.
+func NormalizeName(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(norm.NFC.String(s))), "")
+}
+
+// NameLength 정규화한 이름을 자모 단위로 분해한 자모 수를 반환합니다.
+func NameLength(s string) (int, error) {
+	jamo, err := Disassemble(NormalizeName(s))
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return len(jamo.Runes), nil
+}
+
+// FromQwerty 한/영 전환 없이 영문 자판으로 입력한 문자열을 두벌식 자판의 자모로 변환합니다.
+// 영문자와 공백이 아닌 문자가 있거나 한글 자판에 없는 키가 있으면 false를 반환합니다.
+func FromQwerty(s string) ([]rune, bool) {
+	var runes []rune
+	for _, r := range s {
+		if r == ' ' {
+			runes = append(runes, r)
+			continue
+		}
+		j, ok := qwertyJamo[r]
+		if !ok && 'A' <= r && r <= 'Z' {
+			j, ok = qwertyJamo[r+'a'-'A']
+		}
+		if !ok {
+			return nil, false
+		}
+		runes = append(runes, j)
+	}
+
+	return runes, len(runes) > 0
+}
+
+// IsConsonant 한글 자음인지 확인합니다.
+func IsConsonant(r rune) bool {
+	return 'ㄱ' <= r && r <= 'ㅎ'
+}
+
+// IsVowel 한글 모음인지 확인합니다.
+func IsVowel(r rune) bool {
+	return 'ㅏ' <= r && r <= 'ㅣ'
+}
@@ -5,10 +5,14 @@ package i18n
 const (
 	ExpiredToken                = "ExpiredToken"
 	InternalError               = "InternalError"
+	InvalidRefreshToken         = "InvalidRefreshToken"
 	InvalidRequest              = "InvalidRequest"
 	ItemAlreadyExists           = "ItemAlreadyExists"
 	ItemNotFound                = "ItemNotFound"
+	ItemVersionMismatch         = "ItemVersionMismatch"
 	PasswordMismatch            = "PasswordMismatch"
+	SessionNotFound             = "SessionNotFound"
+	SimilarItemExists           = "SimilarItemExists"
 	TokenBlacklistAlreadyExists = "TokenBlacklistAlreadyExists"
 	Unauthorized                = "Unauthorized"
 	UserAlreadyExists           = "UserAlreadyExists"
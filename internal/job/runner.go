@@ -0,0 +1,118 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/psi59/payhere-assignment/internal/valid"
+	"github.com/rs/zerolog/log"
+)
+
+var ErrRunnerStarted = fmt.Errorf("runner already started")
+
+// Job 일정한 주기로 실행되는 백그라운드 작업입니다.
+type Job struct {
+	Name     string        `validate:"required"`
+	Interval time.Duration `validate:"gt=0"`
+	Run      func(c context.Context) error
+}
+
+func (j Job) Validate() error {
+	if j.Run == nil {
+		return fmt.Errorf("nil Run: %q", j.Name)
+	}
+	if err := valid.ValidateStruct(j); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// Runner 등록된 작업을 각각의 주기에 따라 실행합니다.
+// 작업은 시작 직후 한 번 실행된 뒤 주기마다 반복되며, 같은 작업이 동시에 실행되지 않습니다.
+type Runner struct {
+	mu      sync.Mutex
+	jobs    []Job
+	started bool
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+func NewRunner() *Runner {
+	return &Runner{}
+}
+
+// Add 작업을 등록합니다. 실행 중인 Runner에는 작업을 등록할 수 없습니다.
+func (r *Runner) Add(job Job) error {
+	if err := job.Validate(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.started {
+		return errors.WithStack(ErrRunnerStarted)
+	}
+	r.jobs = append(r.jobs, job)
+
+	return nil
+}
+
+// Start 등록된 작업을 백그라운드에서 실행합니다. 작업은 c가 취소되거나 Stop이 호출될 때까지 실행됩니다.
+func (r *Runner) Start(c context.Context) error {
+	if valid.IsNil(c) {
+		return fmt.Errorf("nil context")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.started {
+		return errors.WithStack(ErrRunnerStarted)
+	}
+	r.started = true
+
+	c, r.cancel = context.WithCancel(c)
+	for _, job := range r.jobs {
+		r.wg.Add(1)
+		go func(job Job) {
+			defer r.wg.Done()
+			r.run(c, job)
+		}(job)
+	}
+
+	return nil
+}
+
+// Stop 작업을 중단하고 실행 중인 작업이 끝날 때까지 기다립니다.
+func (r *Runner) Stop() {
+	r.mu.Lock()
+	cancel := r.cancel
+	r.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+	r.wg.Wait()
+}
+
+func (r *Runner) run(c context.Context, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := job.Run(c); err != nil && c.Err() == nil {
+			log.Error().Err(err).Str("job", job.Name).Msg("failed to run job")
+		}
+
+		select {
+		case <-c.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
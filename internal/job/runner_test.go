@@ -0,0 +1,126 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunner_Add(t *testing.T) {
+	run := func(c context.Context) error { return nil }
+
+	t.Run("OK", func(t *testing.T) {
+		runner := NewRunner()
+		err := runner.Add(Job{Name: "test", Interval: time.Second, Run: run})
+		assert.NoError(t, err)
+	})
+
+	t.Run("빈 이름", func(t *testing.T) {
+		runner := NewRunner()
+		err := runner.Add(Job{Interval: time.Second, Run: run})
+		assert.Error(t, err)
+	})
+
+	t.Run("잘못된 주기", func(t *testing.T) {
+		runner := NewRunner()
+		err := runner.Add(Job{Name: "test", Run: run})
+		assert.Error(t, err)
+	})
+
+	t.Run("nil Run", func(t *testing.T) {
+		runner := NewRunner()
+		err := runner.Add(Job{Name: "test", Interval: time.Second})
+		assert.Error(t, err)
+	})
+
+	t.Run("실행 중인 Runner", func(t *testing.T) {
+		runner := NewRunner()
+		require.NoError(t, runner.Start(context.TODO()))
+		defer runner.Stop()
+
+		err := runner.Add(Job{Name: "test", Interval: time.Second, Run: run})
+		assert.ErrorIs(t, err, ErrRunnerStarted)
+	})
+}
+
+func TestRunner_Start(t *testing.T) {
+	t.Run("주기마다 실행", func(t *testing.T) {
+		var count, failedCount atomic.Int32
+		runner := NewRunner()
+		require.NoError(t, runner.Add(Job{
+			Name:     "count",
+			Interval: 10 * time.Millisecond,
+			Run: func(c context.Context) error {
+				count.Add(1)
+				return nil
+			},
+		}))
+		require.NoError(t, runner.Add(Job{
+			Name:     "failed",
+			Interval: 10 * time.Millisecond,
+			Run: func(c context.Context) error {
+				failedCount.Add(1)
+				return fmt.Errorf("failed")
+			},
+		}))
+
+		require.NoError(t, runner.Start(context.TODO()))
+		assert.Eventually(t, func() bool {
+			return count.Load() >= 3 && failedCount.Load() >= 3
+		}, time.Second, 5*time.Millisecond)
+		runner.Stop()
+
+		// Stop 이후에는 더 이상 실행되지 않습니다.
+		stoppedCount := count.Load()
+		time.Sleep(30 * time.Millisecond)
+		assert.Equal(t, stoppedCount, count.Load())
+	})
+
+	t.Run("Stop은 실행 중인 작업을 기다림", func(t *testing.T) {
+		var finished atomic.Bool
+		started := make(chan struct{})
+		runner := NewRunner()
+		require.NoError(t, runner.Add(Job{
+			Name:     "slow",
+			Interval: time.Hour,
+			Run: func(c context.Context) error {
+				close(started)
+				<-c.Done()
+				finished.Store(true)
+				return c.Err()
+			},
+		}))
+
+		require.NoError(t, runner.Start(context.TODO()))
+		<-started
+		runner.Stop()
+		assert.True(t, finished.Load())
+	})
+
+	t.Run("중복 실행", func(t *testing.T) {
+		runner := NewRunner()
+		require.NoError(t, runner.Start(context.TODO()))
+		defer runner.Stop()
+
+		err := runner.Start(context.TODO())
+		assert.ErrorIs(t, err, ErrRunnerStarted)
+	})
+
+	t.Run("nil context", func(t *testing.T) {
+		runner := NewRunner()
+		err := runner.Start(nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestRunner_Stop(t *testing.T) {
+	t.Run("시작 전 Stop", func(t *testing.T) {
+		runner := NewRunner()
+		runner.Stop()
+	})
+}
@@ -0,0 +1,288 @@
+package keyring
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrNilKeyring          = fmt.Errorf("nil keyring")
+	ErrNoSigningKey        = fmt.Errorf("no signing key")
+	ErrKeyNotFound         = fmt.Errorf("key not found")
+	ErrKeyExpired          = fmt.Errorf("key expired")
+	ErrUnsupportedKey      = fmt.Errorf("unsupported key")
+	ErrDuplicateKeyID      = fmt.Errorf("duplicate key id")
+	ErrInvalidRetirePeriod = fmt.Errorf("retiredAt must be after activatedAt")
+)
+
+// Key JWT 서명, 검증 키입니다.
+type Key struct {
+	// ID JWT 헤더의 kid로 사용되는 키 아이디입니다.
+	ID     string
+	Method jwt.SigningMethod
+	// ActivatedAt 서명에 사용하기 시작하는 시각이며, 그 전에는 검증과 JWKS 공개에만 사용합니다.
+	ActivatedAt time.Time
+	// RetiredAt 서명을 중단하는 시각이며, 이후 rotation window 동안 검증에만 사용합니다.
+	RetiredAt time.Time
+
+	signKey   any
+	verifyKey any
+}
+
+// CanSign 개인키를 가지고 있어 서명할 수 있는지 여부를 반환합니다.
+func (k *Key) CanSign() bool {
+	return k.signKey != nil
+}
+
+// SignKey jwt.Token.SignedString에 전달할 키를 반환합니다.
+func (k *Key) SignKey() any {
+	return k.signKey
+}
+
+// VerifyKey jwt.Keyfunc에서 반환할 키를 반환합니다.
+func (k *Key) VerifyKey() any {
+	return k.verifyKey
+}
+
+// ParseKey PEM으로 인코딩된 P-256 또는 Ed25519 키를 읽습니다.
+// 공개키만 있는 경우 서명할 수 없으며 검증에만 사용합니다.
+func ParseKey(id string, pemBytes []byte) (*Key, error) {
+	if len(id) == 0 {
+		return nil, fmt.Errorf("empty key id")
+	}
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block: kid(%s)", id)
+	}
+
+	var parsed any
+	var err error
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		parsed, err = x509.ParseECPrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("%w: PEM type(%s), kid(%s)", ErrUnsupportedKey, block.Type, id)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse key: kid(%s)", id)
+	}
+
+	key := &Key{ID: id}
+	switch k := parsed.(type) {
+	case *ecdsa.PrivateKey:
+		key.Method, key.signKey, key.verifyKey = jwt.SigningMethodES256, k, &k.PublicKey
+	case *ecdsa.PublicKey:
+		key.Method, key.verifyKey = jwt.SigningMethodES256, k
+	case ed25519.PrivateKey:
+		key.Method, key.signKey, key.verifyKey = jwt.SigningMethodEdDSA, k, k.Public()
+	case ed25519.PublicKey:
+		key.Method, key.verifyKey = jwt.SigningMethodEdDSA, k
+	default:
+		return nil, fmt.Errorf("%w: %T, kid(%s)", ErrUnsupportedKey, parsed, id)
+	}
+	if pub, ok := key.verifyKey.(*ecdsa.PublicKey); ok && pub.Curve != elliptic.P256() {
+		return nil, fmt.Errorf("%w: curve(%s), kid(%s)", ErrUnsupportedKey, pub.Curve.Params().Name, id)
+	}
+
+	return key, nil
+}
+
+// LoadKey PEM 파일에서 키를 읽습니다.
+func LoadKey(id, path string) (*Key, error) {
+	pemBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	key, err := ParseKey(id, pemBytes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "path(%s)", path)
+	}
+
+	return key, nil
+}
+
+// NewHMACKey HS256 키를 생성합니다. 서명 키를 설정하지 않은 경우를 위한 키이며 JWKS로 공개하지 않습니다.
+func NewHMACKey(id string, secret []byte) (*Key, error) {
+	if len(id) == 0 {
+		return nil, fmt.Errorf("empty key id")
+	}
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("empty secret")
+	}
+
+	return &Key{
+		ID:        id,
+		Method:    jwt.SigningMethodHS256,
+		signKey:   secret,
+		verifyKey: secret,
+	}, nil
+}
+
+// Keyring 키 교체 중에도 토큰을 검증할 수 있도록 여러 개의 키를 관리합니다.
+type Keyring struct {
+	keys []*Key
+	// rotationWindow 폐기된 키로 서명된 토큰을 계속 검증하는 기간입니다.
+	rotationWindow time.Duration
+}
+
+func New(keys []*Key, rotationWindow time.Duration) (*Keyring, error) {
+	if len(keys) == 0 {
+		return nil, errors.WithStack(ErrNoSigningKey)
+	}
+	if rotationWindow < 0 {
+		return nil, fmt.Errorf("negative rotation window: %s", rotationWindow)
+	}
+
+	ids := make(map[string]struct{}, len(keys))
+	hasSigningKey := false
+	for _, key := range keys {
+		if _, ok := ids[key.ID]; ok {
+			return nil, fmt.Errorf("%w: kid(%s)", ErrDuplicateKeyID, key.ID)
+		}
+		ids[key.ID] = struct{}{}
+		if !key.RetiredAt.IsZero() && !key.RetiredAt.After(key.ActivatedAt) {
+			return nil, fmt.Errorf("%w: kid(%s)", ErrInvalidRetirePeriod, key.ID)
+		}
+		if key.CanSign() && key.RetiredAt.IsZero() {
+			hasSigningKey = true
+		}
+	}
+	// 모든 키가 폐기 예정이면 언젠가 서명할 수 없게 되므로 설정 오류로 판단합니다.
+	if !hasSigningKey {
+		return nil, errors.WithStack(ErrNoSigningKey)
+	}
+
+	return &Keyring{
+		keys:           keys,
+		rotationWindow: rotationWindow,
+	}, nil
+}
+
+// SigningKey now 시점에 서명에 사용할 키를 반환합니다.
+// 사용 가능한 키가 여러 개인 경우 가장 최근에 활성화된 키를 사용합니다.
+func (r *Keyring) SigningKey(now time.Time) (*Key, error) {
+	var signingKey *Key
+	for _, key := range r.keys {
+		if !key.CanSign() || now.Before(key.ActivatedAt) || (!key.RetiredAt.IsZero() && !now.Before(key.RetiredAt)) {
+			continue
+		}
+		if signingKey == nil || key.ActivatedAt.After(signingKey.ActivatedAt) {
+			signingKey = key
+		}
+	}
+	if signingKey == nil {
+		return nil, fmt.Errorf("%w: now(%s)", ErrNoSigningKey, now.UTC())
+	}
+
+	return signingKey, nil
+}
+
+// VerificationKey kid에 해당하는 검증 키를 반환합니다.
+// 폐기된 키는 rotation window가 지나면 사용할 수 없습니다.
+func (r *Keyring) VerificationKey(kid string, now time.Time) (*Key, error) {
+	for _, key := range r.keys {
+		if key.ID != kid {
+			continue
+		}
+		if r.expired(key, now) {
+			return nil, fmt.Errorf("%w: kid(%s), retiredAt(%s)", ErrKeyExpired, kid, key.RetiredAt.UTC())
+		}
+
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("%w: kid(%s)", ErrKeyNotFound, kid)
+}
+
+// Algorithms 키가 사용하는 서명 알고리즘 목록을 중복 없이 반환합니다.
+func (r *Keyring) Algorithms() []string {
+	algorithms := make([]string, 0, len(r.keys))
+	for _, key := range r.keys {
+		if !slices.Contains(algorithms, key.Method.Alg()) {
+			algorithms = append(algorithms, key.Method.Alg())
+		}
+	}
+
+	return algorithms
+}
+
+// JWKS now 시점에 검증에 사용할 수 있는 공개키 목록을 반환합니다.
+// 활성화 전인 키도 미리 공개해 다른 서비스가 키 교체 전에 캐시할 수 있도록 합니다.
+func (r *Keyring) JWKS(now time.Time) JWKSet {
+	keySet := JWKSet{Keys: make([]JWK, 0, len(r.keys))}
+	for _, key := range r.keys {
+		if r.expired(key, now) {
+			continue
+		}
+		jwk, ok := key.jwk()
+		if !ok {
+			continue
+		}
+		keySet.Keys = append(keySet.Keys, jwk)
+	}
+
+	return keySet
+}
+
+func (r *Keyring) expired(key *Key, now time.Time) bool {
+	return !key.RetiredAt.IsZero() && !now.Before(key.RetiredAt.Add(r.rotationWindow))
+}
+
+// JWKSet RFC 7517 JSON Web Key Set입니다.
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWK RFC 7517 JSON Web Key입니다.
+type JWK struct {
+	KeyType   string `json:"kty"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+	Y         string `json:"y,omitempty"`
+}
+
+// jwk 공개키를 JWK로 변환하며, 공개할 수 없는 키(HMAC)는 false를 반환합니다.
+func (k *Key) jwk() (JWK, bool) {
+	switch pub := k.verifyKey.(type) {
+	case *ecdsa.PublicKey:
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		return JWK{
+			KeyType:   "EC",
+			Use:       "sig",
+			Algorithm: k.Method.Alg(),
+			KeyID:     k.ID,
+			Curve:     pub.Curve.Params().Name,
+			X:         base64.RawURLEncoding.EncodeToString(pub.X.FillBytes(make([]byte, size))),
+			Y:         base64.RawURLEncoding.EncodeToString(pub.Y.FillBytes(make([]byte, size))),
+		}, true
+	case ed25519.PublicKey:
+		return JWK{
+			KeyType:   "OKP",
+			Use:       "sig",
+			Algorithm: k.Method.Alg(),
+			KeyID:     k.ID,
+			Curve:     "Ed25519",
+			X:         base64.RawURLEncoding.EncodeToString(pub),
+		}, true
+	}
+
+	return JWK{}, false
+}
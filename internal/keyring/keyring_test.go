@@ -0,0 +1,293 @@
+package keyring
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodePKCS8(t *testing.T, privateKey any) []byte {
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func encodePublicKey(t *testing.T, publicKey any) []byte {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func newECKey(t *testing.T, id string) *Key {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	key, err := ParseKey(id, encodePKCS8(t, privateKey))
+	require.NoError(t, err)
+
+	return key
+}
+
+func newEd25519Key(t *testing.T, id string) *Key {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	key, err := ParseKey(id, encodePKCS8(t, privateKey))
+	require.NoError(t, err)
+
+	return key
+}
+
+func TestParseKey(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	t.Run("ES256", func(t *testing.T) {
+		got, err := ParseKey("es", encodePKCS8(t, ecKey))
+		require.NoError(t, err)
+		assert.Equal(t, jwt.SigningMethodES256, got.Method)
+		assert.True(t, got.CanSign())
+
+		sec1, err := x509.MarshalECPrivateKey(ecKey)
+		require.NoError(t, err)
+		got, err = ParseKey("es", pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: sec1}))
+		require.NoError(t, err)
+		assert.Equal(t, jwt.SigningMethodES256, got.Method)
+		assert.True(t, got.CanSign())
+	})
+
+	t.Run("EdDSA", func(t *testing.T) {
+		got, err := ParseKey("ed", encodePKCS8(t, edKey))
+		require.NoError(t, err)
+		assert.Equal(t, jwt.SigningMethodEdDSA, got.Method)
+		assert.True(t, got.CanSign())
+	})
+
+	t.Run("공개키는 검증에만 사용", func(t *testing.T) {
+		got, err := ParseKey("es", encodePublicKey(t, &ecKey.PublicKey))
+		require.NoError(t, err)
+		assert.Equal(t, jwt.SigningMethodES256, got.Method)
+		assert.False(t, got.CanSign())
+
+		got, err = ParseKey("ed", encodePublicKey(t, edKey.Public()))
+		require.NoError(t, err)
+		assert.Equal(t, jwt.SigningMethodEdDSA, got.Method)
+		assert.False(t, got.CanSign())
+	})
+
+	t.Run("지원하지 않는 키", func(t *testing.T) {
+		p384Key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+		require.NoError(t, err)
+		_, err = ParseKey("es", encodePKCS8(t, p384Key))
+		assert.ErrorIs(t, err, ErrUnsupportedKey)
+
+		rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		_, err = ParseKey("rsa", encodePKCS8(t, rsaKey))
+		assert.ErrorIs(t, err, ErrUnsupportedKey)
+
+		_, err = ParseKey("cert", pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("cert")}))
+		assert.ErrorIs(t, err, ErrUnsupportedKey)
+	})
+
+	t.Run("잘못된 PEM", func(t *testing.T) {
+		_, err := ParseKey("es", []byte("not a pem"))
+		assert.Error(t, err)
+
+		_, err = ParseKey("es", pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("broken")}))
+		assert.Error(t, err)
+	})
+
+	t.Run("empty key id", func(t *testing.T) {
+		_, err := ParseKey("", encodePKCS8(t, ecKey))
+		assert.Error(t, err)
+	})
+}
+
+func TestLoadKey(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "key.pem")
+	require.NoError(t, os.WriteFile(path, encodePKCS8(t, edKey), 0o600))
+
+	got, err := LoadKey("ed", path)
+	require.NoError(t, err)
+	assert.Equal(t, "ed", got.ID)
+
+	_, err = LoadKey("ed", filepath.Join(t.TempDir(), "missing.pem"))
+	assert.Error(t, err)
+}
+
+func TestNew(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		got, err := New([]*Key{newECKey(t, "a"), newEd25519Key(t, "b")}, time.Hour)
+		require.NoError(t, err)
+		require.NotNil(t, got)
+	})
+
+	t.Run("empty keys", func(t *testing.T) {
+		_, err := New(nil, time.Hour)
+		assert.ErrorIs(t, err, ErrNoSigningKey)
+	})
+
+	t.Run("duplicate key id", func(t *testing.T) {
+		_, err := New([]*Key{newECKey(t, "a"), newEd25519Key(t, "a")}, time.Hour)
+		assert.ErrorIs(t, err, ErrDuplicateKeyID)
+	})
+
+	t.Run("서명할 수 있는 키가 없음", func(t *testing.T) {
+		ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		publicKey, err := ParseKey("public", encodePublicKey(t, &ecKey.PublicKey))
+		require.NoError(t, err)
+		retiredKey := newECKey(t, "retired")
+		retiredKey.RetiredAt = time.Now()
+
+		_, err = New([]*Key{publicKey, retiredKey}, time.Hour)
+		assert.ErrorIs(t, err, ErrNoSigningKey)
+	})
+
+	t.Run("활성화 전에 폐기", func(t *testing.T) {
+		key := newECKey(t, "a")
+		key.ActivatedAt = time.Now()
+		key.RetiredAt = key.ActivatedAt.Add(-time.Second)
+
+		_, err := New([]*Key{key, newECKey(t, "b")}, time.Hour)
+		assert.ErrorIs(t, err, ErrInvalidRetirePeriod)
+	})
+
+	t.Run("negative rotation window", func(t *testing.T) {
+		_, err := New([]*Key{newECKey(t, "a")}, -time.Second)
+		assert.Error(t, err)
+	})
+}
+
+func TestKeyring_SigningKey(t *testing.T) {
+	now := time.Now()
+	oldKey := newECKey(t, "old")
+	oldKey.ActivatedAt = now.Add(-48 * time.Hour)
+	oldKey.RetiredAt = now.Add(time.Hour)
+	currentKey := newEd25519Key(t, "current")
+	currentKey.ActivatedAt = now.Add(-time.Hour)
+	nextKey := newECKey(t, "next")
+	nextKey.ActivatedAt = now.Add(24 * time.Hour)
+	keyring, err := New([]*Key{oldKey, currentKey, nextKey}, time.Hour)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want *Key
+	}{
+		{name: "가장 최근에 활성화된 키", now: now, want: currentKey},
+		{name: "다른 키가 활성화되기 전", now: now.Add(-2 * time.Hour), want: oldKey},
+		{name: "새 키 활성화", now: now.Add(25 * time.Hour), want: nextKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := keyring.SigningKey(tt.now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.ID, got.ID)
+		})
+	}
+
+	t.Run("사용할 수 있는 키가 없음", func(t *testing.T) {
+		_, err := keyring.SigningKey(now.Add(-72 * time.Hour))
+		assert.ErrorIs(t, err, ErrNoSigningKey)
+	})
+}
+
+func TestKeyring_VerificationKey(t *testing.T) {
+	now := time.Now()
+	retiredKey := newECKey(t, "retired")
+	retiredKey.RetiredAt = now.Add(-30 * time.Minute)
+	currentKey := newEd25519Key(t, "current")
+	keyring, err := New([]*Key{retiredKey, currentKey}, time.Hour)
+	require.NoError(t, err)
+
+	got, err := keyring.VerificationKey("current", now)
+	require.NoError(t, err)
+	assert.Equal(t, currentKey, got)
+
+	// rotation window 동안은 폐기된 키로도 검증합니다.
+	got, err = keyring.VerificationKey("retired", now)
+	require.NoError(t, err)
+	assert.Equal(t, retiredKey, got)
+
+	_, err = keyring.VerificationKey("retired", now.Add(30*time.Minute))
+	assert.ErrorIs(t, err, ErrKeyExpired)
+
+	_, err = keyring.VerificationKey("unknown", now)
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+
+	_, err = keyring.VerificationKey("", now)
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+}
+
+func TestKeyring_JWKS(t *testing.T) {
+	now := time.Now()
+	ecKey := newECKey(t, "es")
+	edKey := newEd25519Key(t, "ed")
+	hmacKey, err := NewHMACKey("hs", []byte("secret"))
+	require.NoError(t, err)
+	expiredKey := newECKey(t, "expired")
+	expiredKey.RetiredAt = now.Add(-2 * time.Hour)
+	keyring, err := New([]*Key{ecKey, edKey, hmacKey, expiredKey}, time.Hour)
+	require.NoError(t, err)
+
+	got := keyring.JWKS(now)
+	require.Len(t, got.Keys, 2)
+
+	t.Run("ES256", func(t *testing.T) {
+		jwk := got.Keys[0]
+		assert.Equal(t, JWK{KeyType: "EC", Use: "sig", Algorithm: "ES256", KeyID: "es", Curve: "P-256", X: jwk.X, Y: jwk.Y}, jwk)
+
+		// JWK의 공개키로 서명을 검증할 수 있어야 합니다.
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		require.NoError(t, err)
+		y, err := base64.RawURLEncoding.DecodeString(jwk.Y)
+		require.NoError(t, err)
+		assert.Len(t, x, 32)
+		assert.Len(t, y, 32)
+		publicKey := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+
+		token, err := jwt.NewWithClaims(ecKey.Method, jwt.RegisteredClaims{Subject: "1"}).SignedString(ecKey.SignKey())
+		require.NoError(t, err)
+		_, err = jwt.Parse(token, func(*jwt.Token) (any, error) { return publicKey, nil })
+		assert.NoError(t, err)
+	})
+
+	t.Run("EdDSA", func(t *testing.T) {
+		jwk := got.Keys[1]
+		assert.Equal(t, JWK{KeyType: "OKP", Use: "sig", Algorithm: "EdDSA", KeyID: "ed", Curve: "Ed25519", X: jwk.X}, jwk)
+
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		require.NoError(t, err)
+		assert.Equal(t, []byte(edKey.VerifyKey().(ed25519.PublicKey)), x)
+	})
+}
+
+func TestKeyring_Algorithms(t *testing.T) {
+	hmacKey, err := NewHMACKey("hs", []byte("secret"))
+	require.NoError(t, err)
+	keyring, err := New([]*Key{newECKey(t, "es-1"), newEd25519Key(t, "ed"), newECKey(t, "es-2"), hmacKey}, time.Hour)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"ES256", "EdDSA", "HS256"}, keyring.Algorithms())
+}
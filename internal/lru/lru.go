@@ -0,0 +1,97 @@
+package lru
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Cache 항목마다 만료 시각이 있는 LRU 캐시입니다.
+// 크기를 넘으면 가장 오래 사용하지 않은 항목부터 제거하며, 만료된 항목은 조회할 때 제거합니다.
+type Cache[K comparable, V any] struct {
+	mu      sync.Mutex
+	size    int
+	entries map[K]*list.Element
+	order   *list.List
+}
+
+type entry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time
+}
+
+// New 최대 size개의 항목을 저장하는 캐시를 생성합니다.
+func New[K comparable, V any](size int) *Cache[K, V] {
+	return &Cache[K, V]{
+		size:    max(size, 1),
+		entries: make(map[K]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// Get now 기준으로 만료되지 않은 항목을 반환합니다.
+func (c *Cache[K, V]) Get(key K, now time.Time) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var zero V
+	element, exists := c.entries[key]
+	if !exists {
+		return zero, false
+	}
+	e := element.Value.(*entry[K, V])
+	if !now.Before(e.expiresAt) {
+		c.remove(element)
+		return zero, false
+	}
+	c.order.MoveToFront(element)
+
+	return e.value, true
+}
+
+// Add expiresAt까지 유효한 항목을 저장합니다. 이미 있는 키라면 값과 만료 시각을 바꿉니다.
+func (c *Cache[K, V]) Add(key K, value V, expiresAt time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, exists := c.entries[key]; exists {
+		e := element.Value.(*entry[K, V])
+		e.value = value
+		e.expiresAt = expiresAt
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&entry[K, V]{key: key, value: value, expiresAt: expiresAt})
+	if c.order.Len() > c.size {
+		c.remove(c.order.Back())
+	}
+}
+
+// RemoveFunc remove가 true를 반환하는 항목을 모두 제거합니다.
+func (c *Cache[K, V]) RemoveFunc(remove func(key K, value V) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for element := c.order.Front(); element != nil; {
+		next := element.Next()
+		e := element.Value.(*entry[K, V])
+		if remove(e.key, e.value) {
+			c.remove(element)
+		}
+		element = next
+	}
+}
+
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+func (c *Cache[K, V]) remove(element *list.Element) {
+	c.order.Remove(element)
+	delete(c.entries, element.Value.(*entry[K, V]).key)
+}
@@ -0,0 +1,75 @@
+package lru
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCache_Get(t *testing.T) {
+	now := time.Now()
+	cache := New[string, int](10)
+	cache.Add("a", 1, now.Add(time.Minute))
+
+	got, ok := cache.Get("a", now)
+	assert.True(t, ok)
+	assert.Equal(t, 1, got)
+
+	_, ok = cache.Get("b", now)
+	assert.False(t, ok)
+
+	t.Run("만료된 항목", func(t *testing.T) {
+		_, ok := cache.Get("a", now.Add(time.Minute))
+		assert.False(t, ok)
+		assert.Zero(t, cache.Len())
+	})
+}
+
+func TestCache_Add(t *testing.T) {
+	now := time.Now()
+	expiresAt := now.Add(time.Minute)
+
+	t.Run("가장 오래 사용하지 않은 항목부터 제거", func(t *testing.T) {
+		cache := New[string, int](2)
+		cache.Add("a", 1, expiresAt)
+		cache.Add("b", 2, expiresAt)
+		_, _ = cache.Get("a", now)
+		cache.Add("c", 3, expiresAt)
+
+		assert.Equal(t, 2, cache.Len())
+		_, ok := cache.Get("b", now)
+		assert.False(t, ok)
+		_, ok = cache.Get("a", now)
+		assert.True(t, ok)
+		_, ok = cache.Get("c", now)
+		assert.True(t, ok)
+	})
+
+	t.Run("이미 있는 키", func(t *testing.T) {
+		cache := New[string, int](2)
+		cache.Add("a", 1, now.Add(time.Second))
+		cache.Add("a", 2, expiresAt)
+
+		got, ok := cache.Get("a", now.Add(time.Second))
+		assert.True(t, ok)
+		assert.Equal(t, 2, got)
+		assert.Equal(t, 1, cache.Len())
+	})
+}
+
+func TestCache_RemoveFunc(t *testing.T) {
+	now := time.Now()
+	cache := New[string, int](10)
+	for i, key := range []string{"a", "b", "c", "d"} {
+		cache.Add(key, i, now.Add(time.Minute))
+	}
+
+	cache.RemoveFunc(func(_ string, value int) bool { return value%2 == 0 })
+
+	assert.Equal(t, 2, cache.Len())
+	for _, key := range []string{"b", "d"} {
+		_, ok := cache.Get(key, now)
+		assert.True(t, ok)
+	}
+}
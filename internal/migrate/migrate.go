@@ -0,0 +1,300 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/psi59/payhere-assignment/internal/db"
+	"github.com/psi59/payhere-assignment/internal/valid"
+)
+
+const (
+	directionUp   = "up"
+	directionDown = "down"
+)
+
+var (
+	regexpFilename = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+	regexpName     = regexp.MustCompile(`^\w+$`)
+)
+
+// Migration 버전별 스키마 변경 사항입니다.
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status 마이그레이션의 적용 여부입니다.
+type Status struct {
+	Migration
+	Applied   bool
+	AppliedAt time.Time
+}
+
+type Migrator struct {
+	migrations []Migration
+}
+
+// New fsys 최상위 경로의 마이그레이션 파일을 읽어 Migrator를 생성합니다.
+func New(fsys fs.FS) (*Migrator, error) {
+	if valid.IsNil(fsys) {
+		return nil, fmt.Errorf("nil fs.FS")
+	}
+
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &Migrator{migrations: migrations}, nil
+}
+
+// Load fsys 최상위 경로의 마이그레이션 파일을 버전 순으로 읽습니다.
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read migrations")
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		matches := regexpFilename.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			continue
+		}
+		version, err := strconv.Atoi(matches[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid migration version: %q", entry.Name())
+		}
+		b, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read migration: %q", entry.Name())
+		}
+
+		m, exists := byVersion[version]
+		if !exists {
+			m = &Migration{Version: version, Name: matches[2]}
+			byVersion[version] = m
+		}
+		if m.Name != matches[2] {
+			return nil, fmt.Errorf("duplicate migration version %d: %q, %q", version, m.Name, matches[2])
+		}
+		switch matches[3] {
+		case directionUp:
+			m.Up = string(b)
+		case directionDown:
+			m.Down = string(b)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if len(m.Up) == 0 {
+			return nil, fmt.Errorf("empty up migration: %06d_%s", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Status 모든 마이그레이션의 적용 여부를 반환합니다.
+func (m *Migrator) Status(c context.Context) ([]Status, error) {
+	applied, err := m.applied(c)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	statuses := make([]Status, len(m.migrations))
+	for i, migration := range m.migrations {
+		statuses[i].Migration = migration
+		if record, exists := applied[migration.Version]; exists {
+			statuses[i].Applied = true
+			statuses[i].AppliedAt = record.AppliedAt
+		}
+	}
+
+	return statuses, nil
+}
+
+// Pending 적용되지 않은 마이그레이션을 버전 순으로 반환합니다.
+func (m *Migrator) Pending(c context.Context) ([]Migration, error) {
+	applied, err := m.applied(c)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	pending := make([]Migration, 0)
+	for _, migration := range m.migrations {
+		if _, exists := applied[migration.Version]; !exists {
+			pending = append(pending, migration)
+		}
+	}
+
+	return pending, nil
+}
+
+// Up 적용되지 않은 마이그레이션을 모두 적용하고, 적용된 마이그레이션을 반환합니다.
+func (m *Migrator) Up(c context.Context) ([]Migration, error) {
+	pending, err := m.Pending(c)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	for i, migration := range pending {
+		if err := m.apply(c, migration, directionUp); err != nil {
+			return pending[:i], errors.WithStack(err)
+		}
+	}
+
+	return pending, nil
+}
+
+// Down 가장 최근에 적용된 마이그레이션부터 n개를 되돌리고, 되돌린 마이그레이션을 반환합니다.
+func (m *Migrator) Down(c context.Context, n int) ([]Migration, error) {
+	if n < 1 {
+		return nil, fmt.Errorf("invalid n: %d", n)
+	}
+	applied, err := m.applied(c)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	targets := make([]Migration, 0, n)
+	for i := len(m.migrations) - 1; i >= 0 && len(targets) < n; i-- {
+		if _, exists := applied[m.migrations[i].Version]; exists {
+			targets = append(targets, m.migrations[i])
+		}
+	}
+
+	for i, migration := range targets {
+		if len(migration.Down) == 0 {
+			return targets[:i], fmt.Errorf("irreversible migration: %06d_%s", migration.Version, migration.Name)
+		}
+		if err := m.apply(c, migration, directionDown); err != nil {
+			return targets[:i], errors.WithStack(err)
+		}
+	}
+
+	return targets, nil
+}
+
+// apply 마이그레이션과 schema_migrations 기록을 하나의 트랜잭션으로 실행합니다.
+// MySQL의 DDL은 암묵적으로 커밋되므로 실패한 마이그레이션은 직접 복구해야 합니다.
+func (m *Migrator) apply(c context.Context, migration Migration, direction string) error {
+	query := migration.Up
+	if direction == directionDown {
+		query = migration.Down
+	}
+
+	if err := db.Transaction(c, func(c context.Context) error {
+		conn, err := db.ConnFromContext(c)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		for _, stmt := range Split(query) {
+			if err := conn.Exec(stmt).Error; err != nil {
+				return errors.Wrapf(err, "failed to execute statement: %s", stmt)
+			}
+		}
+
+		if direction == directionDown {
+			return errors.WithStack(conn.Delete(&SchemaMigration{}, migration.Version).Error)
+		}
+
+		return errors.WithStack(conn.Create(&SchemaMigration{
+			Version:   migration.Version,
+			Name:      migration.Name,
+			AppliedAt: time.Now().UTC(),
+		}).Error)
+	}); err != nil {
+		return errors.Wrapf(err, "failed to migrate %s %06d_%s", direction, migration.Version, migration.Name)
+	}
+
+	return nil
+}
+
+func (m *Migrator) applied(c context.Context) (map[int]SchemaMigration, error) {
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err := conn.AutoMigrate(&SchemaMigration{}); err != nil {
+		return nil, errors.Wrap(err, "failed to create schema_migrations")
+	}
+
+	var records []SchemaMigration
+	if err := conn.Find(&records).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	applied := make(map[int]SchemaMigration, len(records))
+	for _, record := range records {
+		applied[record.Version] = record
+	}
+
+	return applied, nil
+}
+
+// Create dir에 다음 버전의 up, down 마이그레이션 파일을 생성하고 경로를 반환합니다.
+func Create(dir, name string) (up string, down string, err error) {
+	if !regexpName.MatchString(name) {
+		return "", "", fmt.Errorf("invalid migration name: %q", name)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", "", errors.WithStack(err)
+	}
+	migrations, err := Load(os.DirFS(dir))
+	if err != nil {
+		return "", "", errors.WithStack(err)
+	}
+
+	version := 1
+	if len(migrations) > 0 {
+		version = migrations[len(migrations)-1].Version + 1
+	}
+	up = filepath.Join(dir, fmt.Sprintf("%06d_%s.%s.sql", version, name, directionUp))
+	down = filepath.Join(dir, fmt.Sprintf("%06d_%s.%s.sql", version, name, directionDown))
+	for path, direction := range map[string]string{up: directionUp, down: directionDown} {
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if err != nil {
+			return "", "", errors.WithStack(err)
+		}
+		_, err = fmt.Fprintf(f, "-- %06d_%s (%s)\n", version, name, direction)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return "", "", errors.WithStack(err)
+		}
+	}
+
+	return up, down, nil
+}
+
+// SchemaMigration 적용된 마이그레이션 기록입니다.
+type SchemaMigration struct {
+	Version   int       `gorm:"primaryKey;autoIncrement:false"`
+	Name      string    `gorm:"size:255;not null"`
+	AppliedAt time.Time `gorm:"not null"`
+}
+
+func (m *SchemaMigration) TableName() string {
+	return "schema_migrations"
+}
@@ -0,0 +1,151 @@
+package migrate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/psi59/payhere-assignment/internal/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testMigrations = fstest.MapFS{
+	"000001_create_a.up.sql":   {Data: []byte("CREATE TABLE a (id INTEGER PRIMARY KEY);")},
+	"000001_create_a.down.sql": {Data: []byte("DROP TABLE a;")},
+	"000002_create_b.up.sql":   {Data: []byte("CREATE TABLE b (id INTEGER PRIMARY KEY);\nCREATE INDEX idx_b ON b (id);")},
+	"000002_create_b.down.sql": {Data: []byte("DROP TABLE b;")},
+	"README.md":                {Data: []byte("ignored")},
+}
+
+func newTestContext(t *testing.T) context.Context {
+	conn, err := db.Connect(db.Config{
+		Driver:   db.DriverSQLite,
+		Database: filepath.Join(t.TempDir(), "migrate.db"),
+	})
+	require.NoError(t, err)
+
+	return db.ContextWithConn(context.TODO(), conn)
+}
+
+func tableExists(t *testing.T, ctx context.Context, table string) bool {
+	conn, err := db.ConnFromContext(ctx)
+	require.NoError(t, err)
+
+	return conn.Migrator().HasTable(table)
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		migrations, err := Load(testMigrations)
+		assert.NoError(t, err)
+		assert.Len(t, migrations, 2)
+		assert.Equal(t, 1, migrations[0].Version)
+		assert.Equal(t, "create_a", migrations[0].Name)
+		assert.Equal(t, "DROP TABLE a;", migrations[0].Down)
+		assert.Equal(t, 2, migrations[1].Version)
+	})
+
+	t.Run("중복 버전", func(t *testing.T) {
+		_, err := Load(fstest.MapFS{
+			"000001_a.up.sql": {Data: []byte("SELECT 1;")},
+			"000001_b.up.sql": {Data: []byte("SELECT 1;")},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("up 파일 누락", func(t *testing.T) {
+		_, err := Load(fstest.MapFS{
+			"000001_a.down.sql": {Data: []byte("SELECT 1;")},
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestMigrator(t *testing.T) {
+	ctx := newTestContext(t)
+	migrator, err := New(testMigrations)
+	require.NoError(t, err)
+
+	pending, err := migrator.Pending(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, pending, 2)
+
+	applied, err := migrator.Up(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, applied, 2)
+	assert.True(t, tableExists(t, ctx, "a"))
+	assert.True(t, tableExists(t, ctx, "b"))
+
+	applied, err = migrator.Up(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, applied)
+
+	statuses, err := migrator.Status(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, statuses, 2)
+	for _, status := range statuses {
+		assert.True(t, status.Applied)
+		assert.False(t, status.AppliedAt.IsZero())
+	}
+
+	reverted, err := migrator.Down(ctx, 1)
+	assert.NoError(t, err)
+	assert.Len(t, reverted, 1)
+	assert.Equal(t, 2, reverted[0].Version)
+	assert.True(t, tableExists(t, ctx, "a"))
+	assert.False(t, tableExists(t, ctx, "b"))
+
+	pending, err = migrator.Pending(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, pending, 1)
+
+	reverted, err = migrator.Down(ctx, 5)
+	assert.NoError(t, err)
+	assert.Len(t, reverted, 1)
+	assert.False(t, tableExists(t, ctx, "a"))
+
+	_, err = migrator.Down(ctx, 0)
+	assert.Error(t, err)
+}
+
+func TestMigrator_Up_Failure(t *testing.T) {
+	ctx := newTestContext(t)
+	migrator, err := New(fstest.MapFS{
+		"000001_create_a.up.sql": {Data: []byte("CREATE TABLE a (id INTEGER PRIMARY KEY);")},
+		"000002_invalid.up.sql":  {Data: []byte("CREATE TABLE b (id INTEGER PRIMARY KEY);\nINVALID SQL;")},
+	})
+	require.NoError(t, err)
+
+	applied, err := migrator.Up(ctx)
+	assert.Error(t, err)
+	assert.Len(t, applied, 1)
+	assert.False(t, tableExists(t, ctx, "b"))
+
+	pending, err := migrator.Pending(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, pending, 1)
+	assert.Equal(t, 2, pending[0].Version)
+}
+
+func TestCreate(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "migrations")
+
+	up, down, err := Create(dir, "create_a")
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "000001_create_a.up.sql"), up)
+	assert.Equal(t, filepath.Join(dir, "000001_create_a.down.sql"), down)
+
+	b, err := os.ReadFile(up)
+	require.NoError(t, err)
+	assert.Equal(t, "-- 000001_create_a (up)\n", string(b))
+
+	up, _, err = Create(dir, "add_b")
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "000002_add_b.up.sql"), up)
+
+	_, _, err = Create(dir, "invalid name")
+	assert.Error(t, err)
+}
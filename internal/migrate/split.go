@@ -0,0 +1,53 @@
+package migrate
+
+import (
+	"strings"
+)
+
+// Split 마이그레이션 파일을 실행 가능한 SQL 문 단위로 나눕니다.
+// 줄 끝의 세미콜론을 구분자로 사용하며, 트리거의 BEGIN ... END; 블록과 $$ 로 감싼 본문은 나누지 않습니다.
+// 드라이버의 multi statements 옵션 없이 여러 문을 실행하기 위해 사용합니다.
+func Split(query string) []string {
+	var (
+		stmts   []string
+		buf     strings.Builder
+		inBlock bool
+		inQuote bool
+	)
+	flush := func() {
+		if stmt := strings.TrimSpace(buf.String()); len(stmt) > 0 {
+			stmts = append(stmts, stmt)
+		}
+		buf.Reset()
+	}
+
+	for _, line := range strings.Split(query, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if !inQuote && (len(trimmed) == 0 || strings.HasPrefix(trimmed, "--")) {
+			continue
+		}
+		buf.WriteString(line)
+		buf.WriteString("\n")
+
+		if strings.Count(line, "$$")%2 == 1 {
+			inQuote = !inQuote
+		}
+		if inQuote {
+			continue
+		}
+
+		upper := strings.ToUpper(trimmed)
+		switch {
+		case upper == "BEGIN" || strings.HasSuffix(upper, " BEGIN"):
+			inBlock = true
+		case inBlock && upper == "END;":
+			inBlock = false
+			flush()
+		case !inBlock && strings.HasSuffix(trimmed, ";"):
+			flush()
+		}
+	}
+	flush()
+
+	return stmts
+}
@@ -0,0 +1,55 @@
+package migrate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{
+			name:  "여러 문",
+			query: "-- comment\nCREATE TABLE a\n(\n    id INT\n);\n\nCREATE TABLE b (id INT);\n",
+			want: []string{
+				"CREATE TABLE a\n(\n    id INT\n);",
+				"CREATE TABLE b (id INT);",
+			},
+		},
+		{
+			name:  "트리거",
+			query: "CREATE TRIGGER t\n    AFTER INSERT\n    ON a\nBEGIN\n    INSERT INTO b VALUES (1);\n    INSERT INTO b VALUES (2);\nEND;\nDROP TABLE c;",
+			want: []string{
+				"CREATE TRIGGER t\n    AFTER INSERT\n    ON a\nBEGIN\n    INSERT INTO b VALUES (1);\n    INSERT INTO b VALUES (2);\nEND;",
+				"DROP TABLE c;",
+			},
+		},
+		{
+			name:  "달러 인용",
+			query: "DO $$\nBEGIN\n    CREATE TYPE s AS ENUM ('a');\nEXCEPTION\n    WHEN duplicate_object THEN NULL;\nEND\n$$;\nCREATE TABLE a (id INT);",
+			want: []string{
+				"DO $$\nBEGIN\n    CREATE TYPE s AS ENUM ('a');\nEXCEPTION\n    WHEN duplicate_object THEN NULL;\nEND\n$$;",
+				"CREATE TABLE a (id INT);",
+			},
+		},
+		{
+			name:  "세미콜론 없는 마지막 문",
+			query: "DROP TABLE a",
+			want:  []string{"DROP TABLE a"},
+		},
+		{
+			name:  "빈 파일",
+			query: "-- nothing\n",
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Split(tt.query))
+		})
+	}
+}
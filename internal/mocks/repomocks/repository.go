@@ -12,6 +12,7 @@ package repomocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/psi59/payhere-assignment/domain"
 	repository "github.com/psi59/payhere-assignment/repository"
@@ -157,102 +158,726 @@ func (c_2 *MockUserRepositoryGetByPhoneNumberCall) DoAndReturn(f func(context.Co
 	return c_2
 }
 
+// Update mocks base method.
+func (m *MockUserRepository) Update(c context.Context, userID int, input *repository.UpdateUserInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", c, userID, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUserRepositoryMockRecorder) Update(c, userID, input any) *MockUserRepositoryUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserRepository)(nil).Update), c, userID, input)
+	return &MockUserRepositoryUpdateCall{Call: call}
+}
+
+// MockUserRepositoryUpdateCall wrap *gomock.Call
+type MockUserRepositoryUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockUserRepositoryUpdateCall) Return(arg0 error) *MockUserRepositoryUpdateCall {
+	c_2.Call = c_2.Call.Return(arg0)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockUserRepositoryUpdateCall) Do(f func(context.Context, int, *repository.UpdateUserInput) error) *MockUserRepositoryUpdateCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockUserRepositoryUpdateCall) DoAndReturn(f func(context.Context, int, *repository.UpdateUserInput) error) *MockUserRepositoryUpdateCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
 // MockTokenBlacklistRepository is a mock of TokenBlacklistRepository interface.
 type MockTokenBlacklistRepository struct {
 	ctrl     *gomock.Controller
 	recorder *MockTokenBlacklistRepositoryMockRecorder
 }
 
-// MockTokenBlacklistRepositoryMockRecorder is the mock recorder for MockTokenBlacklistRepository.
-type MockTokenBlacklistRepositoryMockRecorder struct {
-	mock *MockTokenBlacklistRepository
+// MockTokenBlacklistRepositoryMockRecorder is the mock recorder for MockTokenBlacklistRepository.
+type MockTokenBlacklistRepositoryMockRecorder struct {
+	mock *MockTokenBlacklistRepository
+}
+
+// NewMockTokenBlacklistRepository creates a new mock instance.
+func NewMockTokenBlacklistRepository(ctrl *gomock.Controller) *MockTokenBlacklistRepository {
+	mock := &MockTokenBlacklistRepository{ctrl: ctrl}
+	mock.recorder = &MockTokenBlacklistRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTokenBlacklistRepository) EXPECT() *MockTokenBlacklistRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTokenBlacklistRepository) Create(c context.Context, token *domain.AuthToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTokenBlacklistRepositoryMockRecorder) Create(c, token any) *MockTokenBlacklistRepositoryCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTokenBlacklistRepository)(nil).Create), c, token)
+	return &MockTokenBlacklistRepositoryCreateCall{Call: call}
+}
+
+// MockTokenBlacklistRepositoryCreateCall wrap *gomock.Call
+type MockTokenBlacklistRepositoryCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockTokenBlacklistRepositoryCreateCall) Return(arg0 error) *MockTokenBlacklistRepositoryCreateCall {
+	c_2.Call = c_2.Call.Return(arg0)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockTokenBlacklistRepositoryCreateCall) Do(f func(context.Context, *domain.AuthToken) error) *MockTokenBlacklistRepositoryCreateCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockTokenBlacklistRepositoryCreateCall) DoAndReturn(f func(context.Context, *domain.AuthToken) error) *MockTokenBlacklistRepositoryCreateCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// DeleteExpired mocks base method.
+func (m *MockTokenBlacklistRepository) DeleteExpired(c context.Context, before time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpired", c, before)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockTokenBlacklistRepositoryMockRecorder) DeleteExpired(c, before any) *MockTokenBlacklistRepositoryDeleteExpiredCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockTokenBlacklistRepository)(nil).DeleteExpired), c, before)
+	return &MockTokenBlacklistRepositoryDeleteExpiredCall{Call: call}
+}
+
+// MockTokenBlacklistRepositoryDeleteExpiredCall wrap *gomock.Call
+type MockTokenBlacklistRepositoryDeleteExpiredCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockTokenBlacklistRepositoryDeleteExpiredCall) Return(arg0 int, arg1 error) *MockTokenBlacklistRepositoryDeleteExpiredCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockTokenBlacklistRepositoryDeleteExpiredCall) Do(f func(context.Context, time.Time) (int, error)) *MockTokenBlacklistRepositoryDeleteExpiredCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockTokenBlacklistRepositoryDeleteExpiredCall) DoAndReturn(f func(context.Context, time.Time) (int, error)) *MockTokenBlacklistRepositoryDeleteExpiredCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// FindActive mocks base method.
+func (m *MockTokenBlacklistRepository) FindActive(c context.Context, now time.Time) ([]domain.AuthToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActive", c, now)
+	ret0, _ := ret[0].([]domain.AuthToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActive indicates an expected call of FindActive.
+func (mr *MockTokenBlacklistRepositoryMockRecorder) FindActive(c, now any) *MockTokenBlacklistRepositoryFindActiveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActive", reflect.TypeOf((*MockTokenBlacklistRepository)(nil).FindActive), c, now)
+	return &MockTokenBlacklistRepositoryFindActiveCall{Call: call}
+}
+
+// MockTokenBlacklistRepositoryFindActiveCall wrap *gomock.Call
+type MockTokenBlacklistRepositoryFindActiveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockTokenBlacklistRepositoryFindActiveCall) Return(arg0 []domain.AuthToken, arg1 error) *MockTokenBlacklistRepositoryFindActiveCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockTokenBlacklistRepositoryFindActiveCall) Do(f func(context.Context, time.Time) ([]domain.AuthToken, error)) *MockTokenBlacklistRepositoryFindActiveCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockTokenBlacklistRepositoryFindActiveCall) DoAndReturn(f func(context.Context, time.Time) ([]domain.AuthToken, error)) *MockTokenBlacklistRepositoryFindActiveCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// Get mocks base method.
+func (m *MockTokenBlacklistRepository) Get(c context.Context, tokenID string) (*domain.AuthToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", c, tokenID)
+	ret0, _ := ret[0].(*domain.AuthToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTokenBlacklistRepositoryMockRecorder) Get(c, tokenID any) *MockTokenBlacklistRepositoryGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTokenBlacklistRepository)(nil).Get), c, tokenID)
+	return &MockTokenBlacklistRepositoryGetCall{Call: call}
+}
+
+// MockTokenBlacklistRepositoryGetCall wrap *gomock.Call
+type MockTokenBlacklistRepositoryGetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockTokenBlacklistRepositoryGetCall) Return(arg0 *domain.AuthToken, arg1 error) *MockTokenBlacklistRepositoryGetCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockTokenBlacklistRepositoryGetCall) Do(f func(context.Context, string) (*domain.AuthToken, error)) *MockTokenBlacklistRepositoryGetCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockTokenBlacklistRepositoryGetCall) DoAndReturn(f func(context.Context, string) (*domain.AuthToken, error)) *MockTokenBlacklistRepositoryGetCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// MockRefreshTokenRepository is a mock of RefreshTokenRepository interface.
+type MockRefreshTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefreshTokenRepositoryMockRecorder
+}
+
+// MockRefreshTokenRepositoryMockRecorder is the mock recorder for MockRefreshTokenRepository.
+type MockRefreshTokenRepositoryMockRecorder struct {
+	mock *MockRefreshTokenRepository
+}
+
+// NewMockRefreshTokenRepository creates a new mock instance.
+func NewMockRefreshTokenRepository(ctrl *gomock.Controller) *MockRefreshTokenRepository {
+	mock := &MockRefreshTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockRefreshTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefreshTokenRepository) EXPECT() *MockRefreshTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRefreshTokenRepository) Create(c context.Context, token *domain.RefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRefreshTokenRepositoryMockRecorder) Create(c, token any) *MockRefreshTokenRepositoryCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRefreshTokenRepository)(nil).Create), c, token)
+	return &MockRefreshTokenRepositoryCreateCall{Call: call}
+}
+
+// MockRefreshTokenRepositoryCreateCall wrap *gomock.Call
+type MockRefreshTokenRepositoryCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockRefreshTokenRepositoryCreateCall) Return(arg0 error) *MockRefreshTokenRepositoryCreateCall {
+	c_2.Call = c_2.Call.Return(arg0)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockRefreshTokenRepositoryCreateCall) Do(f func(context.Context, *domain.RefreshToken) error) *MockRefreshTokenRepositoryCreateCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockRefreshTokenRepositoryCreateCall) DoAndReturn(f func(context.Context, *domain.RefreshToken) error) *MockRefreshTokenRepositoryCreateCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// DeleteExpired mocks base method.
+func (m *MockRefreshTokenRepository) DeleteExpired(c context.Context, before time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpired", c, before)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockRefreshTokenRepositoryMockRecorder) DeleteExpired(c, before any) *MockRefreshTokenRepositoryDeleteExpiredCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockRefreshTokenRepository)(nil).DeleteExpired), c, before)
+	return &MockRefreshTokenRepositoryDeleteExpiredCall{Call: call}
+}
+
+// MockRefreshTokenRepositoryDeleteExpiredCall wrap *gomock.Call
+type MockRefreshTokenRepositoryDeleteExpiredCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockRefreshTokenRepositoryDeleteExpiredCall) Return(arg0 int, arg1 error) *MockRefreshTokenRepositoryDeleteExpiredCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockRefreshTokenRepositoryDeleteExpiredCall) Do(f func(context.Context, time.Time) (int, error)) *MockRefreshTokenRepositoryDeleteExpiredCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockRefreshTokenRepositoryDeleteExpiredCall) DoAndReturn(f func(context.Context, time.Time) (int, error)) *MockRefreshTokenRepositoryDeleteExpiredCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// Get mocks base method.
+func (m *MockRefreshTokenRepository) Get(c context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", c, tokenHash)
+	ret0, _ := ret[0].(*domain.RefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockRefreshTokenRepositoryMockRecorder) Get(c, tokenHash any) *MockRefreshTokenRepositoryGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRefreshTokenRepository)(nil).Get), c, tokenHash)
+	return &MockRefreshTokenRepositoryGetCall{Call: call}
+}
+
+// MockRefreshTokenRepositoryGetCall wrap *gomock.Call
+type MockRefreshTokenRepositoryGetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockRefreshTokenRepositoryGetCall) Return(arg0 *domain.RefreshToken, arg1 error) *MockRefreshTokenRepositoryGetCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockRefreshTokenRepositoryGetCall) Do(f func(context.Context, string) (*domain.RefreshToken, error)) *MockRefreshTokenRepositoryGetCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockRefreshTokenRepositoryGetCall) DoAndReturn(f func(context.Context, string) (*domain.RefreshToken, error)) *MockRefreshTokenRepositoryGetCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// RevokeAll mocks base method.
+func (m *MockRefreshTokenRepository) RevokeAll(c context.Context, identifier string, revokedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAll", c, identifier, revokedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAll indicates an expected call of RevokeAll.
+func (mr *MockRefreshTokenRepositoryMockRecorder) RevokeAll(c, identifier, revokedAt any) *MockRefreshTokenRepositoryRevokeAllCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAll", reflect.TypeOf((*MockRefreshTokenRepository)(nil).RevokeAll), c, identifier, revokedAt)
+	return &MockRefreshTokenRepositoryRevokeAllCall{Call: call}
+}
+
+// MockRefreshTokenRepositoryRevokeAllCall wrap *gomock.Call
+type MockRefreshTokenRepositoryRevokeAllCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockRefreshTokenRepositoryRevokeAllCall) Return(arg0 error) *MockRefreshTokenRepositoryRevokeAllCall {
+	c_2.Call = c_2.Call.Return(arg0)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockRefreshTokenRepositoryRevokeAllCall) Do(f func(context.Context, string, time.Time) error) *MockRefreshTokenRepositoryRevokeAllCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockRefreshTokenRepositoryRevokeAllCall) DoAndReturn(f func(context.Context, string, time.Time) error) *MockRefreshTokenRepositoryRevokeAllCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// RevokeFamily mocks base method.
+func (m *MockRefreshTokenRepository) RevokeFamily(c context.Context, familyID string, revokedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeFamily", c, familyID, revokedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeFamily indicates an expected call of RevokeFamily.
+func (mr *MockRefreshTokenRepositoryMockRecorder) RevokeFamily(c, familyID, revokedAt any) *MockRefreshTokenRepositoryRevokeFamilyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeFamily", reflect.TypeOf((*MockRefreshTokenRepository)(nil).RevokeFamily), c, familyID, revokedAt)
+	return &MockRefreshTokenRepositoryRevokeFamilyCall{Call: call}
+}
+
+// MockRefreshTokenRepositoryRevokeFamilyCall wrap *gomock.Call
+type MockRefreshTokenRepositoryRevokeFamilyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockRefreshTokenRepositoryRevokeFamilyCall) Return(arg0 error) *MockRefreshTokenRepositoryRevokeFamilyCall {
+	c_2.Call = c_2.Call.Return(arg0)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockRefreshTokenRepositoryRevokeFamilyCall) Do(f func(context.Context, string, time.Time) error) *MockRefreshTokenRepositoryRevokeFamilyCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockRefreshTokenRepositoryRevokeFamilyCall) DoAndReturn(f func(context.Context, string, time.Time) error) *MockRefreshTokenRepositoryRevokeFamilyCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// Rotate mocks base method.
+func (m *MockRefreshTokenRepository) Rotate(c context.Context, tokenHash string, rotatedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rotate", c, tokenHash, rotatedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rotate indicates an expected call of Rotate.
+func (mr *MockRefreshTokenRepositoryMockRecorder) Rotate(c, tokenHash, rotatedAt any) *MockRefreshTokenRepositoryRotateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rotate", reflect.TypeOf((*MockRefreshTokenRepository)(nil).Rotate), c, tokenHash, rotatedAt)
+	return &MockRefreshTokenRepositoryRotateCall{Call: call}
+}
+
+// MockRefreshTokenRepositoryRotateCall wrap *gomock.Call
+type MockRefreshTokenRepositoryRotateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockRefreshTokenRepositoryRotateCall) Return(arg0 error) *MockRefreshTokenRepositoryRotateCall {
+	c_2.Call = c_2.Call.Return(arg0)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockRefreshTokenRepositoryRotateCall) Do(f func(context.Context, string, time.Time) error) *MockRefreshTokenRepositoryRotateCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockRefreshTokenRepositoryRotateCall) DoAndReturn(f func(context.Context, string, time.Time) error) *MockRefreshTokenRepositoryRotateCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// MockSessionRepository is a mock of SessionRepository interface.
+type MockSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionRepositoryMockRecorder
+}
+
+// MockSessionRepositoryMockRecorder is the mock recorder for MockSessionRepository.
+type MockSessionRepositoryMockRecorder struct {
+	mock *MockSessionRepository
+}
+
+// NewMockSessionRepository creates a new mock instance.
+func NewMockSessionRepository(ctrl *gomock.Controller) *MockSessionRepository {
+	mock := &MockSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionRepository) EXPECT() *MockSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSessionRepository) Create(c context.Context, session *domain.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSessionRepositoryMockRecorder) Create(c, session any) *MockSessionRepositoryCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSessionRepository)(nil).Create), c, session)
+	return &MockSessionRepositoryCreateCall{Call: call}
+}
+
+// MockSessionRepositoryCreateCall wrap *gomock.Call
+type MockSessionRepositoryCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockSessionRepositoryCreateCall) Return(arg0 error) *MockSessionRepositoryCreateCall {
+	c_2.Call = c_2.Call.Return(arg0)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockSessionRepositoryCreateCall) Do(f func(context.Context, *domain.Session) error) *MockSessionRepositoryCreateCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockSessionRepositoryCreateCall) DoAndReturn(f func(context.Context, *domain.Session) error) *MockSessionRepositoryCreateCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// DeleteExpired mocks base method.
+func (m *MockSessionRepository) DeleteExpired(c context.Context, before time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpired", c, before)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockSessionRepositoryMockRecorder) DeleteExpired(c, before any) *MockSessionRepositoryDeleteExpiredCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockSessionRepository)(nil).DeleteExpired), c, before)
+	return &MockSessionRepositoryDeleteExpiredCall{Call: call}
+}
+
+// MockSessionRepositoryDeleteExpiredCall wrap *gomock.Call
+type MockSessionRepositoryDeleteExpiredCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockSessionRepositoryDeleteExpiredCall) Return(arg0 int, arg1 error) *MockSessionRepositoryDeleteExpiredCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockSessionRepositoryDeleteExpiredCall) Do(f func(context.Context, time.Time) (int, error)) *MockSessionRepositoryDeleteExpiredCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockSessionRepositoryDeleteExpiredCall) DoAndReturn(f func(context.Context, time.Time) (int, error)) *MockSessionRepositoryDeleteExpiredCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// FindActive mocks base method.
+func (m *MockSessionRepository) FindActive(c context.Context, identifier string, now time.Time) ([]domain.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActive", c, identifier, now)
+	ret0, _ := ret[0].([]domain.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActive indicates an expected call of FindActive.
+func (mr *MockSessionRepositoryMockRecorder) FindActive(c, identifier, now any) *MockSessionRepositoryFindActiveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActive", reflect.TypeOf((*MockSessionRepository)(nil).FindActive), c, identifier, now)
+	return &MockSessionRepositoryFindActiveCall{Call: call}
+}
+
+// MockSessionRepositoryFindActiveCall wrap *gomock.Call
+type MockSessionRepositoryFindActiveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockSessionRepositoryFindActiveCall) Return(arg0 []domain.Session, arg1 error) *MockSessionRepositoryFindActiveCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockSessionRepositoryFindActiveCall) Do(f func(context.Context, string, time.Time) ([]domain.Session, error)) *MockSessionRepositoryFindActiveCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockSessionRepositoryFindActiveCall) DoAndReturn(f func(context.Context, string, time.Time) ([]domain.Session, error)) *MockSessionRepositoryFindActiveCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// Get mocks base method.
+func (m *MockSessionRepository) Get(c context.Context, sessionID string) (*domain.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", c, sessionID)
+	ret0, _ := ret[0].(*domain.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSessionRepositoryMockRecorder) Get(c, sessionID any) *MockSessionRepositoryGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSessionRepository)(nil).Get), c, sessionID)
+	return &MockSessionRepositoryGetCall{Call: call}
+}
+
+// MockSessionRepositoryGetCall wrap *gomock.Call
+type MockSessionRepositoryGetCall struct {
+	*gomock.Call
 }
 
-// NewMockTokenBlacklistRepository creates a new mock instance.
-func NewMockTokenBlacklistRepository(ctrl *gomock.Controller) *MockTokenBlacklistRepository {
-	mock := &MockTokenBlacklistRepository{ctrl: ctrl}
-	mock.recorder = &MockTokenBlacklistRepositoryMockRecorder{mock}
-	return mock
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockSessionRepositoryGetCall) Return(arg0 *domain.Session, arg1 error) *MockSessionRepositoryGetCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
+	return c_2
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockTokenBlacklistRepository) EXPECT() *MockTokenBlacklistRepositoryMockRecorder {
-	return m.recorder
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockSessionRepositoryGetCall) Do(f func(context.Context, string) (*domain.Session, error)) *MockSessionRepositoryGetCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
 }
 
-// Create mocks base method.
-func (m *MockTokenBlacklistRepository) Create(c context.Context, token *domain.AuthToken) error {
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockSessionRepositoryGetCall) DoAndReturn(f func(context.Context, string) (*domain.Session, error)) *MockSessionRepositoryGetCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// RevokeAll mocks base method.
+func (m *MockSessionRepository) RevokeAll(c context.Context, identifier string, revokedAt time.Time) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", c, token)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "RevokeAll", c, identifier, revokedAt)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Create indicates an expected call of Create.
-func (mr *MockTokenBlacklistRepositoryMockRecorder) Create(c, token any) *MockTokenBlacklistRepositoryCreateCall {
+// RevokeAll indicates an expected call of RevokeAll.
+func (mr *MockSessionRepositoryMockRecorder) RevokeAll(c, identifier, revokedAt any) *MockSessionRepositoryRevokeAllCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTokenBlacklistRepository)(nil).Create), c, token)
-	return &MockTokenBlacklistRepositoryCreateCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAll", reflect.TypeOf((*MockSessionRepository)(nil).RevokeAll), c, identifier, revokedAt)
+	return &MockSessionRepositoryRevokeAllCall{Call: call}
 }
 
-// MockTokenBlacklistRepositoryCreateCall wrap *gomock.Call
-type MockTokenBlacklistRepositoryCreateCall struct {
+// MockSessionRepositoryRevokeAllCall wrap *gomock.Call
+type MockSessionRepositoryRevokeAllCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c_2 *MockTokenBlacklistRepositoryCreateCall) Return(arg0 error) *MockTokenBlacklistRepositoryCreateCall {
-	c_2.Call = c_2.Call.Return(arg0)
+func (c_2 *MockSessionRepositoryRevokeAllCall) Return(arg0 int, arg1 error) *MockSessionRepositoryRevokeAllCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
 	return c_2
 }
 
 // Do rewrite *gomock.Call.Do
-func (c_2 *MockTokenBlacklistRepositoryCreateCall) Do(f func(context.Context, *domain.AuthToken) error) *MockTokenBlacklistRepositoryCreateCall {
+func (c_2 *MockSessionRepositoryRevokeAllCall) Do(f func(context.Context, string, time.Time) (int, error)) *MockSessionRepositoryRevokeAllCall {
 	c_2.Call = c_2.Call.Do(f)
 	return c_2
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c_2 *MockTokenBlacklistRepositoryCreateCall) DoAndReturn(f func(context.Context, *domain.AuthToken) error) *MockTokenBlacklistRepositoryCreateCall {
+func (c_2 *MockSessionRepositoryRevokeAllCall) DoAndReturn(f func(context.Context, string, time.Time) (int, error)) *MockSessionRepositoryRevokeAllCall {
 	c_2.Call = c_2.Call.DoAndReturn(f)
 	return c_2
 }
 
-// Get mocks base method.
-func (m *MockTokenBlacklistRepository) Get(c context.Context, token string) (*domain.AuthToken, error) {
+// RevokeFamily mocks base method.
+func (m *MockSessionRepository) RevokeFamily(c context.Context, familyID string, revokedAt time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", c, token)
-	ret0, _ := ret[0].(*domain.AuthToken)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RevokeFamily", c, familyID, revokedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Get indicates an expected call of Get.
-func (mr *MockTokenBlacklistRepositoryMockRecorder) Get(c, token any) *MockTokenBlacklistRepositoryGetCall {
+// RevokeFamily indicates an expected call of RevokeFamily.
+func (mr *MockSessionRepositoryMockRecorder) RevokeFamily(c, familyID, revokedAt any) *MockSessionRepositoryRevokeFamilyCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTokenBlacklistRepository)(nil).Get), c, token)
-	return &MockTokenBlacklistRepositoryGetCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeFamily", reflect.TypeOf((*MockSessionRepository)(nil).RevokeFamily), c, familyID, revokedAt)
+	return &MockSessionRepositoryRevokeFamilyCall{Call: call}
 }
 
-// MockTokenBlacklistRepositoryGetCall wrap *gomock.Call
-type MockTokenBlacklistRepositoryGetCall struct {
+// MockSessionRepositoryRevokeFamilyCall wrap *gomock.Call
+type MockSessionRepositoryRevokeFamilyCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c_2 *MockTokenBlacklistRepositoryGetCall) Return(arg0 *domain.AuthToken, arg1 error) *MockTokenBlacklistRepositoryGetCall {
-	c_2.Call = c_2.Call.Return(arg0, arg1)
+func (c_2 *MockSessionRepositoryRevokeFamilyCall) Return(arg0 error) *MockSessionRepositoryRevokeFamilyCall {
+	c_2.Call = c_2.Call.Return(arg0)
 	return c_2
 }
 
 // Do rewrite *gomock.Call.Do
-func (c_2 *MockTokenBlacklistRepositoryGetCall) Do(f func(context.Context, string) (*domain.AuthToken, error)) *MockTokenBlacklistRepositoryGetCall {
+func (c_2 *MockSessionRepositoryRevokeFamilyCall) Do(f func(context.Context, string, time.Time) error) *MockSessionRepositoryRevokeFamilyCall {
 	c_2.Call = c_2.Call.Do(f)
 	return c_2
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c_2 *MockTokenBlacklistRepositoryGetCall) DoAndReturn(f func(context.Context, string) (*domain.AuthToken, error)) *MockTokenBlacklistRepositoryGetCall {
+func (c_2 *MockSessionRepositoryRevokeFamilyCall) DoAndReturn(f func(context.Context, string, time.Time) error) *MockSessionRepositoryRevokeFamilyCall {
 	c_2.Call = c_2.Call.DoAndReturn(f)
 	return c_2
 }
@@ -319,17 +944,17 @@ func (c_2 *MockItemRepositoryCreateCall) DoAndReturn(f func(context.Context, *do
 }
 
 // Delete mocks base method.
-func (m *MockItemRepository) Delete(c context.Context, userID, itemID int) error {
+func (m *MockItemRepository) Delete(c context.Context, userID, itemID, version int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", c, userID, itemID)
+	ret := m.ctrl.Call(m, "Delete", c, userID, itemID, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockItemRepositoryMockRecorder) Delete(c, userID, itemID any) *MockItemRepositoryDeleteCall {
+func (mr *MockItemRepositoryMockRecorder) Delete(c, userID, itemID, version any) *MockItemRepositoryDeleteCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockItemRepository)(nil).Delete), c, userID, itemID)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockItemRepository)(nil).Delete), c, userID, itemID, version)
 	return &MockItemRepositoryDeleteCall{Call: call}
 }
 
@@ -345,13 +970,13 @@ func (c_2 *MockItemRepositoryDeleteCall) Return(arg0 error) *MockItemRepositoryD
 }
 
 // Do rewrite *gomock.Call.Do
-func (c_2 *MockItemRepositoryDeleteCall) Do(f func(context.Context, int, int) error) *MockItemRepositoryDeleteCall {
+func (c_2 *MockItemRepositoryDeleteCall) Do(f func(context.Context, int, int, int) error) *MockItemRepositoryDeleteCall {
 	c_2.Call = c_2.Call.Do(f)
 	return c_2
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c_2 *MockItemRepositoryDeleteCall) DoAndReturn(f func(context.Context, int, int) error) *MockItemRepositoryDeleteCall {
+func (c_2 *MockItemRepositoryDeleteCall) DoAndReturn(f func(context.Context, int, int, int) error) *MockItemRepositoryDeleteCall {
 	c_2.Call = c_2.Call.DoAndReturn(f)
 	return c_2
 }
@@ -395,6 +1020,45 @@ func (c_2 *MockItemRepositoryFindCall) DoAndReturn(f func(context.Context, *repo
 	return c_2
 }
 
+// FindDeleted mocks base method.
+func (m *MockItemRepository) FindDeleted(c context.Context, input *repository.FindDeletedItemInput) (*repository.FindItemOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeleted", c, input)
+	ret0, _ := ret[0].(*repository.FindItemOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeleted indicates an expected call of FindDeleted.
+func (mr *MockItemRepositoryMockRecorder) FindDeleted(c, input any) *MockItemRepositoryFindDeletedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeleted", reflect.TypeOf((*MockItemRepository)(nil).FindDeleted), c, input)
+	return &MockItemRepositoryFindDeletedCall{Call: call}
+}
+
+// MockItemRepositoryFindDeletedCall wrap *gomock.Call
+type MockItemRepositoryFindDeletedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockItemRepositoryFindDeletedCall) Return(arg0 *repository.FindItemOutput, arg1 error) *MockItemRepositoryFindDeletedCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockItemRepositoryFindDeletedCall) Do(f func(context.Context, *repository.FindDeletedItemInput) (*repository.FindItemOutput, error)) *MockItemRepositoryFindDeletedCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockItemRepositoryFindDeletedCall) DoAndReturn(f func(context.Context, *repository.FindDeletedItemInput) (*repository.FindItemOutput, error)) *MockItemRepositoryFindDeletedCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
 // Get mocks base method.
 func (m *MockItemRepository) Get(c context.Context, userID, itemID int) (*domain.Item, error) {
 	m.ctrl.T.Helper()
@@ -434,6 +1098,160 @@ func (c_2 *MockItemRepositoryGetCall) DoAndReturn(f func(context.Context, int, i
 	return c_2
 }
 
+// GetStamp mocks base method.
+func (m *MockItemRepository) GetStamp(c context.Context, userID int) (*repository.ItemStamp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStamp", c, userID)
+	ret0, _ := ret[0].(*repository.ItemStamp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStamp indicates an expected call of GetStamp.
+func (mr *MockItemRepositoryMockRecorder) GetStamp(c, userID any) *MockItemRepositoryGetStampCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStamp", reflect.TypeOf((*MockItemRepository)(nil).GetStamp), c, userID)
+	return &MockItemRepositoryGetStampCall{Call: call}
+}
+
+// MockItemRepositoryGetStampCall wrap *gomock.Call
+type MockItemRepositoryGetStampCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockItemRepositoryGetStampCall) Return(arg0 *repository.ItemStamp, arg1 error) *MockItemRepositoryGetStampCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockItemRepositoryGetStampCall) Do(f func(context.Context, int) (*repository.ItemStamp, error)) *MockItemRepositoryGetStampCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockItemRepositoryGetStampCall) DoAndReturn(f func(context.Context, int) (*repository.ItemStamp, error)) *MockItemRepositoryGetStampCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// Purge mocks base method.
+func (m *MockItemRepository) Purge(c context.Context, userID, itemID, version int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", c, userID, itemID, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockItemRepositoryMockRecorder) Purge(c, userID, itemID, version any) *MockItemRepositoryPurgeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockItemRepository)(nil).Purge), c, userID, itemID, version)
+	return &MockItemRepositoryPurgeCall{Call: call}
+}
+
+// MockItemRepositoryPurgeCall wrap *gomock.Call
+type MockItemRepositoryPurgeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockItemRepositoryPurgeCall) Return(arg0 error) *MockItemRepositoryPurgeCall {
+	c_2.Call = c_2.Call.Return(arg0)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockItemRepositoryPurgeCall) Do(f func(context.Context, int, int, int) error) *MockItemRepositoryPurgeCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockItemRepositoryPurgeCall) DoAndReturn(f func(context.Context, int, int, int) error) *MockItemRepositoryPurgeCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// PurgeDeleted mocks base method.
+func (m *MockItemRepository) PurgeDeleted(c context.Context, deletedBefore time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeleted", c, deletedBefore)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeleted indicates an expected call of PurgeDeleted.
+func (mr *MockItemRepositoryMockRecorder) PurgeDeleted(c, deletedBefore any) *MockItemRepositoryPurgeDeletedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeleted", reflect.TypeOf((*MockItemRepository)(nil).PurgeDeleted), c, deletedBefore)
+	return &MockItemRepositoryPurgeDeletedCall{Call: call}
+}
+
+// MockItemRepositoryPurgeDeletedCall wrap *gomock.Call
+type MockItemRepositoryPurgeDeletedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockItemRepositoryPurgeDeletedCall) Return(arg0 int, arg1 error) *MockItemRepositoryPurgeDeletedCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockItemRepositoryPurgeDeletedCall) Do(f func(context.Context, time.Time) (int, error)) *MockItemRepositoryPurgeDeletedCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockItemRepositoryPurgeDeletedCall) DoAndReturn(f func(context.Context, time.Time) (int, error)) *MockItemRepositoryPurgeDeletedCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// Restore mocks base method.
+func (m *MockItemRepository) Restore(c context.Context, userID, itemID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", c, userID, itemID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockItemRepositoryMockRecorder) Restore(c, userID, itemID any) *MockItemRepositoryRestoreCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockItemRepository)(nil).Restore), c, userID, itemID)
+	return &MockItemRepositoryRestoreCall{Call: call}
+}
+
+// MockItemRepositoryRestoreCall wrap *gomock.Call
+type MockItemRepositoryRestoreCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockItemRepositoryRestoreCall) Return(arg0 error) *MockItemRepositoryRestoreCall {
+	c_2.Call = c_2.Call.Return(arg0)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockItemRepositoryRestoreCall) Do(f func(context.Context, int, int) error) *MockItemRepositoryRestoreCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockItemRepositoryRestoreCall) DoAndReturn(f func(context.Context, int, int) error) *MockItemRepositoryRestoreCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
 // Update mocks base method.
 func (m *MockItemRepository) Update(c context.Context, userID, itemID int, input *repository.UpdateItemInput) error {
 	m.ctrl.T.Helper()
@@ -471,3 +1289,103 @@ func (c_2 *MockItemRepositoryUpdateCall) DoAndReturn(f func(context.Context, int
 	c_2.Call = c_2.Call.DoAndReturn(f)
 	return c_2
 }
+
+// MockItemHistoryRepository is a mock of ItemHistoryRepository interface.
+type MockItemHistoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockItemHistoryRepositoryMockRecorder
+}
+
+// MockItemHistoryRepositoryMockRecorder is the mock recorder for MockItemHistoryRepository.
+type MockItemHistoryRepositoryMockRecorder struct {
+	mock *MockItemHistoryRepository
+}
+
+// NewMockItemHistoryRepository creates a new mock instance.
+func NewMockItemHistoryRepository(ctrl *gomock.Controller) *MockItemHistoryRepository {
+	mock := &MockItemHistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockItemHistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockItemHistoryRepository) EXPECT() *MockItemHistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockItemHistoryRepository) Create(c context.Context, history *domain.ItemHistory) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c, history)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockItemHistoryRepositoryMockRecorder) Create(c, history any) *MockItemHistoryRepositoryCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockItemHistoryRepository)(nil).Create), c, history)
+	return &MockItemHistoryRepositoryCreateCall{Call: call}
+}
+
+// MockItemHistoryRepositoryCreateCall wrap *gomock.Call
+type MockItemHistoryRepositoryCreateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockItemHistoryRepositoryCreateCall) Return(arg0 error) *MockItemHistoryRepositoryCreateCall {
+	c_2.Call = c_2.Call.Return(arg0)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockItemHistoryRepositoryCreateCall) Do(f func(context.Context, *domain.ItemHistory) error) *MockItemHistoryRepositoryCreateCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockItemHistoryRepositoryCreateCall) DoAndReturn(f func(context.Context, *domain.ItemHistory) error) *MockItemHistoryRepositoryCreateCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// Find mocks base method.
+func (m *MockItemHistoryRepository) Find(c context.Context, input *repository.FindItemHistoryInput) (*repository.FindItemHistoryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", c, input)
+	ret0, _ := ret[0].(*repository.FindItemHistoryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockItemHistoryRepositoryMockRecorder) Find(c, input any) *MockItemHistoryRepositoryFindCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockItemHistoryRepository)(nil).Find), c, input)
+	return &MockItemHistoryRepositoryFindCall{Call: call}
+}
+
+// MockItemHistoryRepositoryFindCall wrap *gomock.Call
+type MockItemHistoryRepositoryFindCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockItemHistoryRepositoryFindCall) Return(arg0 *repository.FindItemHistoryOutput, arg1 error) *MockItemHistoryRepositoryFindCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockItemHistoryRepositoryFindCall) Do(f func(context.Context, *repository.FindItemHistoryInput) (*repository.FindItemHistoryOutput, error)) *MockItemHistoryRepositoryFindCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockItemHistoryRepositoryFindCall) DoAndReturn(f func(context.Context, *repository.FindItemHistoryInput) (*repository.FindItemHistoryOutput, error)) *MockItemHistoryRepositoryFindCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
@@ -79,6 +79,45 @@ func (c_2 *MockAuthTokenUsecaseCreateCall) DoAndReturn(f func(context.Context, *
 	return c_2
 }
 
+// FindSessions mocks base method.
+func (m *MockAuthTokenUsecase) FindSessions(c context.Context, input *authtoken.FindSessionsInput) (*authtoken.FindSessionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSessions", c, input)
+	ret0, _ := ret[0].(*authtoken.FindSessionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSessions indicates an expected call of FindSessions.
+func (mr *MockAuthTokenUsecaseMockRecorder) FindSessions(c, input any) *MockAuthTokenUsecaseFindSessionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSessions", reflect.TypeOf((*MockAuthTokenUsecase)(nil).FindSessions), c, input)
+	return &MockAuthTokenUsecaseFindSessionsCall{Call: call}
+}
+
+// MockAuthTokenUsecaseFindSessionsCall wrap *gomock.Call
+type MockAuthTokenUsecaseFindSessionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockAuthTokenUsecaseFindSessionsCall) Return(arg0 *authtoken.FindSessionsOutput, arg1 error) *MockAuthTokenUsecaseFindSessionsCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockAuthTokenUsecaseFindSessionsCall) Do(f func(context.Context, *authtoken.FindSessionsInput) (*authtoken.FindSessionsOutput, error)) *MockAuthTokenUsecaseFindSessionsCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockAuthTokenUsecaseFindSessionsCall) DoAndReturn(f func(context.Context, *authtoken.FindSessionsInput) (*authtoken.FindSessionsOutput, error)) *MockAuthTokenUsecaseFindSessionsCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
 // GetBlacklist mocks base method.
 func (m *MockAuthTokenUsecase) GetBlacklist(c context.Context, input *authtoken.GetBlacklistInput) (*authtoken.GetBlacklistOutput, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +157,279 @@ func (c_2 *MockAuthTokenUsecaseGetBlacklistCall) DoAndReturn(f func(context.Cont
 	return c_2
 }
 
+// GetKeySet mocks base method.
+func (m *MockAuthTokenUsecase) GetKeySet(c context.Context) (*authtoken.GetKeySetOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKeySet", c)
+	ret0, _ := ret[0].(*authtoken.GetKeySetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKeySet indicates an expected call of GetKeySet.
+func (mr *MockAuthTokenUsecaseMockRecorder) GetKeySet(c any) *MockAuthTokenUsecaseGetKeySetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeySet", reflect.TypeOf((*MockAuthTokenUsecase)(nil).GetKeySet), c)
+	return &MockAuthTokenUsecaseGetKeySetCall{Call: call}
+}
+
+// MockAuthTokenUsecaseGetKeySetCall wrap *gomock.Call
+type MockAuthTokenUsecaseGetKeySetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockAuthTokenUsecaseGetKeySetCall) Return(arg0 *authtoken.GetKeySetOutput, arg1 error) *MockAuthTokenUsecaseGetKeySetCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockAuthTokenUsecaseGetKeySetCall) Do(f func(context.Context) (*authtoken.GetKeySetOutput, error)) *MockAuthTokenUsecaseGetKeySetCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockAuthTokenUsecaseGetKeySetCall) DoAndReturn(f func(context.Context) (*authtoken.GetKeySetOutput, error)) *MockAuthTokenUsecaseGetKeySetCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// GetSession mocks base method.
+func (m *MockAuthTokenUsecase) GetSession(c context.Context, input *authtoken.GetSessionInput) (*authtoken.GetSessionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", c, input)
+	ret0, _ := ret[0].(*authtoken.GetSessionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockAuthTokenUsecaseMockRecorder) GetSession(c, input any) *MockAuthTokenUsecaseGetSessionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockAuthTokenUsecase)(nil).GetSession), c, input)
+	return &MockAuthTokenUsecaseGetSessionCall{Call: call}
+}
+
+// MockAuthTokenUsecaseGetSessionCall wrap *gomock.Call
+type MockAuthTokenUsecaseGetSessionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockAuthTokenUsecaseGetSessionCall) Return(arg0 *authtoken.GetSessionOutput, arg1 error) *MockAuthTokenUsecaseGetSessionCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockAuthTokenUsecaseGetSessionCall) Do(f func(context.Context, *authtoken.GetSessionInput) (*authtoken.GetSessionOutput, error)) *MockAuthTokenUsecaseGetSessionCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockAuthTokenUsecaseGetSessionCall) DoAndReturn(f func(context.Context, *authtoken.GetSessionInput) (*authtoken.GetSessionOutput, error)) *MockAuthTokenUsecaseGetSessionCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// PurgeBlacklist mocks base method.
+func (m *MockAuthTokenUsecase) PurgeBlacklist(c context.Context, input *authtoken.PurgeBlacklistInput) (*authtoken.PurgeBlacklistOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeBlacklist", c, input)
+	ret0, _ := ret[0].(*authtoken.PurgeBlacklistOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeBlacklist indicates an expected call of PurgeBlacklist.
+func (mr *MockAuthTokenUsecaseMockRecorder) PurgeBlacklist(c, input any) *MockAuthTokenUsecasePurgeBlacklistCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeBlacklist", reflect.TypeOf((*MockAuthTokenUsecase)(nil).PurgeBlacklist), c, input)
+	return &MockAuthTokenUsecasePurgeBlacklistCall{Call: call}
+}
+
+// MockAuthTokenUsecasePurgeBlacklistCall wrap *gomock.Call
+type MockAuthTokenUsecasePurgeBlacklistCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockAuthTokenUsecasePurgeBlacklistCall) Return(arg0 *authtoken.PurgeBlacklistOutput, arg1 error) *MockAuthTokenUsecasePurgeBlacklistCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockAuthTokenUsecasePurgeBlacklistCall) Do(f func(context.Context, *authtoken.PurgeBlacklistInput) (*authtoken.PurgeBlacklistOutput, error)) *MockAuthTokenUsecasePurgeBlacklistCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockAuthTokenUsecasePurgeBlacklistCall) DoAndReturn(f func(context.Context, *authtoken.PurgeBlacklistInput) (*authtoken.PurgeBlacklistOutput, error)) *MockAuthTokenUsecasePurgeBlacklistCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// PurgeRefreshTokens mocks base method.
+func (m *MockAuthTokenUsecase) PurgeRefreshTokens(c context.Context, input *authtoken.PurgeRefreshTokensInput) (*authtoken.PurgeRefreshTokensOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeRefreshTokens", c, input)
+	ret0, _ := ret[0].(*authtoken.PurgeRefreshTokensOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeRefreshTokens indicates an expected call of PurgeRefreshTokens.
+func (mr *MockAuthTokenUsecaseMockRecorder) PurgeRefreshTokens(c, input any) *MockAuthTokenUsecasePurgeRefreshTokensCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeRefreshTokens", reflect.TypeOf((*MockAuthTokenUsecase)(nil).PurgeRefreshTokens), c, input)
+	return &MockAuthTokenUsecasePurgeRefreshTokensCall{Call: call}
+}
+
+// MockAuthTokenUsecasePurgeRefreshTokensCall wrap *gomock.Call
+type MockAuthTokenUsecasePurgeRefreshTokensCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockAuthTokenUsecasePurgeRefreshTokensCall) Return(arg0 *authtoken.PurgeRefreshTokensOutput, arg1 error) *MockAuthTokenUsecasePurgeRefreshTokensCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockAuthTokenUsecasePurgeRefreshTokensCall) Do(f func(context.Context, *authtoken.PurgeRefreshTokensInput) (*authtoken.PurgeRefreshTokensOutput, error)) *MockAuthTokenUsecasePurgeRefreshTokensCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockAuthTokenUsecasePurgeRefreshTokensCall) DoAndReturn(f func(context.Context, *authtoken.PurgeRefreshTokensInput) (*authtoken.PurgeRefreshTokensOutput, error)) *MockAuthTokenUsecasePurgeRefreshTokensCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// PurgeSessions mocks base method.
+func (m *MockAuthTokenUsecase) PurgeSessions(c context.Context, input *authtoken.PurgeSessionsInput) (*authtoken.PurgeSessionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeSessions", c, input)
+	ret0, _ := ret[0].(*authtoken.PurgeSessionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeSessions indicates an expected call of PurgeSessions.
+func (mr *MockAuthTokenUsecaseMockRecorder) PurgeSessions(c, input any) *MockAuthTokenUsecasePurgeSessionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeSessions", reflect.TypeOf((*MockAuthTokenUsecase)(nil).PurgeSessions), c, input)
+	return &MockAuthTokenUsecasePurgeSessionsCall{Call: call}
+}
+
+// MockAuthTokenUsecasePurgeSessionsCall wrap *gomock.Call
+type MockAuthTokenUsecasePurgeSessionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockAuthTokenUsecasePurgeSessionsCall) Return(arg0 *authtoken.PurgeSessionsOutput, arg1 error) *MockAuthTokenUsecasePurgeSessionsCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockAuthTokenUsecasePurgeSessionsCall) Do(f func(context.Context, *authtoken.PurgeSessionsInput) (*authtoken.PurgeSessionsOutput, error)) *MockAuthTokenUsecasePurgeSessionsCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockAuthTokenUsecasePurgeSessionsCall) DoAndReturn(f func(context.Context, *authtoken.PurgeSessionsInput) (*authtoken.PurgeSessionsOutput, error)) *MockAuthTokenUsecasePurgeSessionsCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// Refresh mocks base method.
+func (m *MockAuthTokenUsecase) Refresh(c context.Context, input *authtoken.RefreshInput) (*authtoken.CreateOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", c, input)
+	ret0, _ := ret[0].(*authtoken.CreateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockAuthTokenUsecaseMockRecorder) Refresh(c, input any) *MockAuthTokenUsecaseRefreshCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockAuthTokenUsecase)(nil).Refresh), c, input)
+	return &MockAuthTokenUsecaseRefreshCall{Call: call}
+}
+
+// MockAuthTokenUsecaseRefreshCall wrap *gomock.Call
+type MockAuthTokenUsecaseRefreshCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockAuthTokenUsecaseRefreshCall) Return(arg0 *authtoken.CreateOutput, arg1 error) *MockAuthTokenUsecaseRefreshCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockAuthTokenUsecaseRefreshCall) Do(f func(context.Context, *authtoken.RefreshInput) (*authtoken.CreateOutput, error)) *MockAuthTokenUsecaseRefreshCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockAuthTokenUsecaseRefreshCall) DoAndReturn(f func(context.Context, *authtoken.RefreshInput) (*authtoken.CreateOutput, error)) *MockAuthTokenUsecaseRefreshCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// RefreshBlacklist mocks base method.
+func (m *MockAuthTokenUsecase) RefreshBlacklist(c context.Context) (*authtoken.RefreshBlacklistOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshBlacklist", c)
+	ret0, _ := ret[0].(*authtoken.RefreshBlacklistOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshBlacklist indicates an expected call of RefreshBlacklist.
+func (mr *MockAuthTokenUsecaseMockRecorder) RefreshBlacklist(c any) *MockAuthTokenUsecaseRefreshBlacklistCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshBlacklist", reflect.TypeOf((*MockAuthTokenUsecase)(nil).RefreshBlacklist), c)
+	return &MockAuthTokenUsecaseRefreshBlacklistCall{Call: call}
+}
+
+// MockAuthTokenUsecaseRefreshBlacklistCall wrap *gomock.Call
+type MockAuthTokenUsecaseRefreshBlacklistCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockAuthTokenUsecaseRefreshBlacklistCall) Return(arg0 *authtoken.RefreshBlacklistOutput, arg1 error) *MockAuthTokenUsecaseRefreshBlacklistCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockAuthTokenUsecaseRefreshBlacklistCall) Do(f func(context.Context) (*authtoken.RefreshBlacklistOutput, error)) *MockAuthTokenUsecaseRefreshBlacklistCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockAuthTokenUsecaseRefreshBlacklistCall) DoAndReturn(f func(context.Context) (*authtoken.RefreshBlacklistOutput, error)) *MockAuthTokenUsecaseRefreshBlacklistCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
 // RegisterBlacklist mocks base method.
 func (m *MockAuthTokenUsecase) RegisterBlacklist(c context.Context, input *authtoken.RegisterBlacklistInput) error {
 	m.ctrl.T.Helper()
@@ -156,6 +468,82 @@ func (c_2 *MockAuthTokenUsecaseRegisterBlacklistCall) DoAndReturn(f func(context
 	return c_2
 }
 
+// RevokeRefreshToken mocks base method.
+func (m *MockAuthTokenUsecase) RevokeRefreshToken(c context.Context, input *authtoken.RevokeRefreshTokenInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeRefreshToken", c, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeRefreshToken indicates an expected call of RevokeRefreshToken.
+func (mr *MockAuthTokenUsecaseMockRecorder) RevokeRefreshToken(c, input any) *MockAuthTokenUsecaseRevokeRefreshTokenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeRefreshToken", reflect.TypeOf((*MockAuthTokenUsecase)(nil).RevokeRefreshToken), c, input)
+	return &MockAuthTokenUsecaseRevokeRefreshTokenCall{Call: call}
+}
+
+// MockAuthTokenUsecaseRevokeRefreshTokenCall wrap *gomock.Call
+type MockAuthTokenUsecaseRevokeRefreshTokenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockAuthTokenUsecaseRevokeRefreshTokenCall) Return(arg0 error) *MockAuthTokenUsecaseRevokeRefreshTokenCall {
+	c_2.Call = c_2.Call.Return(arg0)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockAuthTokenUsecaseRevokeRefreshTokenCall) Do(f func(context.Context, *authtoken.RevokeRefreshTokenInput) error) *MockAuthTokenUsecaseRevokeRefreshTokenCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockAuthTokenUsecaseRevokeRefreshTokenCall) DoAndReturn(f func(context.Context, *authtoken.RevokeRefreshTokenInput) error) *MockAuthTokenUsecaseRevokeRefreshTokenCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// RevokeSession mocks base method.
+func (m *MockAuthTokenUsecase) RevokeSession(c context.Context, input *authtoken.RevokeSessionInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", c, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockAuthTokenUsecaseMockRecorder) RevokeSession(c, input any) *MockAuthTokenUsecaseRevokeSessionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockAuthTokenUsecase)(nil).RevokeSession), c, input)
+	return &MockAuthTokenUsecaseRevokeSessionCall{Call: call}
+}
+
+// MockAuthTokenUsecaseRevokeSessionCall wrap *gomock.Call
+type MockAuthTokenUsecaseRevokeSessionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockAuthTokenUsecaseRevokeSessionCall) Return(arg0 error) *MockAuthTokenUsecaseRevokeSessionCall {
+	c_2.Call = c_2.Call.Return(arg0)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockAuthTokenUsecaseRevokeSessionCall) Do(f func(context.Context, *authtoken.RevokeSessionInput) error) *MockAuthTokenUsecaseRevokeSessionCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockAuthTokenUsecaseRevokeSessionCall) DoAndReturn(f func(context.Context, *authtoken.RevokeSessionInput) error) *MockAuthTokenUsecaseRevokeSessionCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
 // Verify mocks base method.
 func (m *MockAuthTokenUsecase) Verify(c context.Context, input *authtoken.VerifyInput) (*authtoken.VerifyOutput, error) {
 	m.ctrl.T.Helper()
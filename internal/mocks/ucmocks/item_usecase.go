@@ -156,6 +156,84 @@ func (c_2 *MockItemTokenUsecaseFindCall) DoAndReturn(f func(context.Context, *it
 	return c_2
 }
 
+// FindDuplicates mocks base method.
+func (m *MockItemTokenUsecase) FindDuplicates(c context.Context, input *item.FindDuplicatesInput) (*item.FindDuplicatesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDuplicates", c, input)
+	ret0, _ := ret[0].(*item.FindDuplicatesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDuplicates indicates an expected call of FindDuplicates.
+func (mr *MockItemTokenUsecaseMockRecorder) FindDuplicates(c, input any) *MockItemTokenUsecaseFindDuplicatesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDuplicates", reflect.TypeOf((*MockItemTokenUsecase)(nil).FindDuplicates), c, input)
+	return &MockItemTokenUsecaseFindDuplicatesCall{Call: call}
+}
+
+// MockItemTokenUsecaseFindDuplicatesCall wrap *gomock.Call
+type MockItemTokenUsecaseFindDuplicatesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockItemTokenUsecaseFindDuplicatesCall) Return(arg0 *item.FindDuplicatesOutput, arg1 error) *MockItemTokenUsecaseFindDuplicatesCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockItemTokenUsecaseFindDuplicatesCall) Do(f func(context.Context, *item.FindDuplicatesInput) (*item.FindDuplicatesOutput, error)) *MockItemTokenUsecaseFindDuplicatesCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockItemTokenUsecaseFindDuplicatesCall) DoAndReturn(f func(context.Context, *item.FindDuplicatesInput) (*item.FindDuplicatesOutput, error)) *MockItemTokenUsecaseFindDuplicatesCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// FindTrash mocks base method.
+func (m *MockItemTokenUsecase) FindTrash(c context.Context, input *item.FindTrashInput) (*item.FindOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTrash", c, input)
+	ret0, _ := ret[0].(*item.FindOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTrash indicates an expected call of FindTrash.
+func (mr *MockItemTokenUsecaseMockRecorder) FindTrash(c, input any) *MockItemTokenUsecaseFindTrashCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTrash", reflect.TypeOf((*MockItemTokenUsecase)(nil).FindTrash), c, input)
+	return &MockItemTokenUsecaseFindTrashCall{Call: call}
+}
+
+// MockItemTokenUsecaseFindTrashCall wrap *gomock.Call
+type MockItemTokenUsecaseFindTrashCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockItemTokenUsecaseFindTrashCall) Return(arg0 *item.FindOutput, arg1 error) *MockItemTokenUsecaseFindTrashCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockItemTokenUsecaseFindTrashCall) Do(f func(context.Context, *item.FindTrashInput) (*item.FindOutput, error)) *MockItemTokenUsecaseFindTrashCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockItemTokenUsecaseFindTrashCall) DoAndReturn(f func(context.Context, *item.FindTrashInput) (*item.FindOutput, error)) *MockItemTokenUsecaseFindTrashCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
 // Get mocks base method.
 func (m *MockItemTokenUsecase) Get(c context.Context, input *item.GetInput) (*item.GetOutput, error) {
 	m.ctrl.T.Helper()
@@ -195,14 +273,170 @@ func (c_2 *MockItemTokenUsecaseGetCall) DoAndReturn(f func(context.Context, *ite
 	return c_2
 }
 
-// Update mocks base method.
-func (m *MockItemTokenUsecase) Update(c context.Context, input *item.UpdateInput) error {
+// History mocks base method.
+func (m *MockItemTokenUsecase) History(c context.Context, input *item.HistoryInput) (*item.HistoryOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", c, input)
+	ret := m.ctrl.Call(m, "History", c, input)
+	ret0, _ := ret[0].(*item.HistoryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// History indicates an expected call of History.
+func (mr *MockItemTokenUsecaseMockRecorder) History(c, input any) *MockItemTokenUsecaseHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockItemTokenUsecase)(nil).History), c, input)
+	return &MockItemTokenUsecaseHistoryCall{Call: call}
+}
+
+// MockItemTokenUsecaseHistoryCall wrap *gomock.Call
+type MockItemTokenUsecaseHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockItemTokenUsecaseHistoryCall) Return(arg0 *item.HistoryOutput, arg1 error) *MockItemTokenUsecaseHistoryCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockItemTokenUsecaseHistoryCall) Do(f func(context.Context, *item.HistoryInput) (*item.HistoryOutput, error)) *MockItemTokenUsecaseHistoryCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockItemTokenUsecaseHistoryCall) DoAndReturn(f func(context.Context, *item.HistoryInput) (*item.HistoryOutput, error)) *MockItemTokenUsecaseHistoryCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// PurgeTrash mocks base method.
+func (m *MockItemTokenUsecase) PurgeTrash(c context.Context, input *item.PurgeTrashInput) (*item.PurgeTrashOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeTrash", c, input)
+	ret0, _ := ret[0].(*item.PurgeTrashOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeTrash indicates an expected call of PurgeTrash.
+func (mr *MockItemTokenUsecaseMockRecorder) PurgeTrash(c, input any) *MockItemTokenUsecasePurgeTrashCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTrash", reflect.TypeOf((*MockItemTokenUsecase)(nil).PurgeTrash), c, input)
+	return &MockItemTokenUsecasePurgeTrashCall{Call: call}
+}
+
+// MockItemTokenUsecasePurgeTrashCall wrap *gomock.Call
+type MockItemTokenUsecasePurgeTrashCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockItemTokenUsecasePurgeTrashCall) Return(arg0 *item.PurgeTrashOutput, arg1 error) *MockItemTokenUsecasePurgeTrashCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockItemTokenUsecasePurgeTrashCall) Do(f func(context.Context, *item.PurgeTrashInput) (*item.PurgeTrashOutput, error)) *MockItemTokenUsecasePurgeTrashCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockItemTokenUsecasePurgeTrashCall) DoAndReturn(f func(context.Context, *item.PurgeTrashInput) (*item.PurgeTrashOutput, error)) *MockItemTokenUsecasePurgeTrashCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// Restore mocks base method.
+func (m *MockItemTokenUsecase) Restore(c context.Context, input *item.RestoreInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", c, input)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// Restore indicates an expected call of Restore.
+func (mr *MockItemTokenUsecaseMockRecorder) Restore(c, input any) *MockItemTokenUsecaseRestoreCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockItemTokenUsecase)(nil).Restore), c, input)
+	return &MockItemTokenUsecaseRestoreCall{Call: call}
+}
+
+// MockItemTokenUsecaseRestoreCall wrap *gomock.Call
+type MockItemTokenUsecaseRestoreCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockItemTokenUsecaseRestoreCall) Return(arg0 error) *MockItemTokenUsecaseRestoreCall {
+	c_2.Call = c_2.Call.Return(arg0)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockItemTokenUsecaseRestoreCall) Do(f func(context.Context, *item.RestoreInput) error) *MockItemTokenUsecaseRestoreCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockItemTokenUsecaseRestoreCall) DoAndReturn(f func(context.Context, *item.RestoreInput) error) *MockItemTokenUsecaseRestoreCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// Suggest mocks base method.
+func (m *MockItemTokenUsecase) Suggest(c context.Context, input *item.SuggestInput) (*item.SuggestOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", c, input)
+	ret0, _ := ret[0].(*item.SuggestOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MockItemTokenUsecaseMockRecorder) Suggest(c, input any) *MockItemTokenUsecaseSuggestCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockItemTokenUsecase)(nil).Suggest), c, input)
+	return &MockItemTokenUsecaseSuggestCall{Call: call}
+}
+
+// MockItemTokenUsecaseSuggestCall wrap *gomock.Call
+type MockItemTokenUsecaseSuggestCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockItemTokenUsecaseSuggestCall) Return(arg0 *item.SuggestOutput, arg1 error) *MockItemTokenUsecaseSuggestCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockItemTokenUsecaseSuggestCall) Do(f func(context.Context, *item.SuggestInput) (*item.SuggestOutput, error)) *MockItemTokenUsecaseSuggestCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockItemTokenUsecaseSuggestCall) DoAndReturn(f func(context.Context, *item.SuggestInput) (*item.SuggestOutput, error)) *MockItemTokenUsecaseSuggestCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// Update mocks base method.
+func (m *MockItemTokenUsecase) Update(c context.Context, input *item.UpdateInput) (*item.UpdateOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", c, input)
+	ret0, _ := ret[0].(*item.UpdateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // Update indicates an expected call of Update.
 func (mr *MockItemTokenUsecaseMockRecorder) Update(c, input any) *MockItemTokenUsecaseUpdateCall {
 	mr.mock.ctrl.T.Helper()
@@ -216,19 +450,19 @@ type MockItemTokenUsecaseUpdateCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c_2 *MockItemTokenUsecaseUpdateCall) Return(arg0 error) *MockItemTokenUsecaseUpdateCall {
-	c_2.Call = c_2.Call.Return(arg0)
+func (c_2 *MockItemTokenUsecaseUpdateCall) Return(arg0 *item.UpdateOutput, arg1 error) *MockItemTokenUsecaseUpdateCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
 	return c_2
 }
 
 // Do rewrite *gomock.Call.Do
-func (c_2 *MockItemTokenUsecaseUpdateCall) Do(f func(context.Context, *item.UpdateInput) error) *MockItemTokenUsecaseUpdateCall {
+func (c_2 *MockItemTokenUsecaseUpdateCall) Do(f func(context.Context, *item.UpdateInput) (*item.UpdateOutput, error)) *MockItemTokenUsecaseUpdateCall {
 	c_2.Call = c_2.Call.Do(f)
 	return c_2
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c_2 *MockItemTokenUsecaseUpdateCall) DoAndReturn(f func(context.Context, *item.UpdateInput) error) *MockItemTokenUsecaseUpdateCall {
+func (c_2 *MockItemTokenUsecaseUpdateCall) DoAndReturn(f func(context.Context, *item.UpdateInput) (*item.UpdateOutput, error)) *MockItemTokenUsecaseUpdateCall {
 	c_2.Call = c_2.Call.DoAndReturn(f)
 	return c_2
 }
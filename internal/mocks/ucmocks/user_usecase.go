@@ -40,6 +40,45 @@ func (m *MockUserUsecase) EXPECT() *MockUserUsecaseMockRecorder {
 	return m.recorder
 }
 
+// ChangePassword mocks base method.
+func (m *MockUserUsecase) ChangePassword(c context.Context, input *user.ChangePasswordInput) (*user.ChangePasswordOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", c, input)
+	ret0, _ := ret[0].(*user.ChangePasswordOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockUserUsecaseMockRecorder) ChangePassword(c, input any) *MockUserUsecaseChangePasswordCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockUserUsecase)(nil).ChangePassword), c, input)
+	return &MockUserUsecaseChangePasswordCall{Call: call}
+}
+
+// MockUserUsecaseChangePasswordCall wrap *gomock.Call
+type MockUserUsecaseChangePasswordCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockUserUsecaseChangePasswordCall) Return(arg0 *user.ChangePasswordOutput, arg1 error) *MockUserUsecaseChangePasswordCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockUserUsecaseChangePasswordCall) Do(f func(context.Context, *user.ChangePasswordInput) (*user.ChangePasswordOutput, error)) *MockUserUsecaseChangePasswordCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockUserUsecaseChangePasswordCall) DoAndReturn(f func(context.Context, *user.ChangePasswordInput) (*user.ChangePasswordOutput, error)) *MockUserUsecaseChangePasswordCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
 // Create mocks base method.
 func (m *MockUserUsecase) Create(c context.Context, input *user.CreateInput) (*user.CreateOutput, error) {
 	m.ctrl.T.Helper()
@@ -156,3 +195,81 @@ func (c_2 *MockUserUsecaseGetByPhoneNumberCall) DoAndReturn(f func(context.Conte
 	c_2.Call = c_2.Call.DoAndReturn(f)
 	return c_2
 }
+
+// InvalidateTokens mocks base method.
+func (m *MockUserUsecase) InvalidateTokens(c context.Context, input *user.InvalidateTokensInput) (*user.InvalidateTokensOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidateTokens", c, input)
+	ret0, _ := ret[0].(*user.InvalidateTokensOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InvalidateTokens indicates an expected call of InvalidateTokens.
+func (mr *MockUserUsecaseMockRecorder) InvalidateTokens(c, input any) *MockUserUsecaseInvalidateTokensCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateTokens", reflect.TypeOf((*MockUserUsecase)(nil).InvalidateTokens), c, input)
+	return &MockUserUsecaseInvalidateTokensCall{Call: call}
+}
+
+// MockUserUsecaseInvalidateTokensCall wrap *gomock.Call
+type MockUserUsecaseInvalidateTokensCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockUserUsecaseInvalidateTokensCall) Return(arg0 *user.InvalidateTokensOutput, arg1 error) *MockUserUsecaseInvalidateTokensCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockUserUsecaseInvalidateTokensCall) Do(f func(context.Context, *user.InvalidateTokensInput) (*user.InvalidateTokensOutput, error)) *MockUserUsecaseInvalidateTokensCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockUserUsecaseInvalidateTokensCall) DoAndReturn(f func(context.Context, *user.InvalidateTokensInput) (*user.InvalidateTokensOutput, error)) *MockUserUsecaseInvalidateTokensCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
+
+// UpdatePreferences mocks base method.
+func (m *MockUserUsecase) UpdatePreferences(c context.Context, input *user.UpdatePreferencesInput) (*user.UpdatePreferencesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePreferences", c, input)
+	ret0, _ := ret[0].(*user.UpdatePreferencesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePreferences indicates an expected call of UpdatePreferences.
+func (mr *MockUserUsecaseMockRecorder) UpdatePreferences(c, input any) *MockUserUsecaseUpdatePreferencesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePreferences", reflect.TypeOf((*MockUserUsecase)(nil).UpdatePreferences), c, input)
+	return &MockUserUsecaseUpdatePreferencesCall{Call: call}
+}
+
+// MockUserUsecaseUpdatePreferencesCall wrap *gomock.Call
+type MockUserUsecaseUpdatePreferencesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c_2 *MockUserUsecaseUpdatePreferencesCall) Return(arg0 *user.UpdatePreferencesOutput, arg1 error) *MockUserUsecaseUpdatePreferencesCall {
+	c_2.Call = c_2.Call.Return(arg0, arg1)
+	return c_2
+}
+
+// Do rewrite *gomock.Call.Do
+func (c_2 *MockUserUsecaseUpdatePreferencesCall) Do(f func(context.Context, *user.UpdatePreferencesInput) (*user.UpdatePreferencesOutput, error)) *MockUserUsecaseUpdatePreferencesCall {
+	c_2.Call = c_2.Call.Do(f)
+	return c_2
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c_2 *MockUserUsecaseUpdatePreferencesCall) DoAndReturn(f func(context.Context, *user.UpdatePreferencesInput) (*user.UpdatePreferencesOutput, error)) *MockUserUsecaseUpdatePreferencesCall {
+	c_2.Call = c_2.Call.DoAndReturn(f)
+	return c_2
+}
@@ -0,0 +1,268 @@
+package search
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/psi59/payhere-assignment/internal/hangul"
+)
+
+// MatchKind 검색어가 일치한 방식이며, 값이 클수록 검색어와 가깝게 일치한 것입니다.
+type MatchKind int
+
+const (
+	MatchNone MatchKind = iota
+	// MatchFuzzy 검색어의 자모가 순서대로 포함된 경우입니다. ("아멬" → 아메리카노)
+	MatchFuzzy
+	// MatchChosung 검색어가 초성에 포함된 경우입니다. ("ㅇㅁㄹ" → 아메리카노)
+	MatchChosung
+	// MatchPartial 입력 중인 마지막 글자까지 자모 단위로 포함된 경우입니다. ("아멜" → 아메리카노)
+	MatchPartial
+	// MatchContains 검색어가 포함된 경우입니다. ("리카" → 아메리카노)
+	MatchContains
+	// MatchPrefix 검색어로 시작하는 경우입니다. ("아메" → 아메리카노)
+	MatchPrefix
+	// MatchExact 검색어와 같은 경우입니다.
+	MatchExact
+)
+
+// kindScore 일치 방식별 기본 점수이며, 같은 방식 안에서의 감점은 maxPenalty를 넘지 않습니다.
+const (
+	kindScore  = 1000
+	maxPenalty = kindScore - 1
+)
+
+// Match 검색 결과의 일치 정도입니다.
+type Match struct {
+	Kind MatchKind
+	// Score 일치 정도이며, 클수록 검색어와 가깝게 일치한 것입니다.
+	Score int
+	// Layout 영문 자판으로 입력한 검색어를 한글로 변환해 일치한 경우 true입니다.
+	Layout bool
+	// Spans 문서에서 검색어와 일치한 범위입니다.
+	Spans []Span
+}
+
+// Span 문서에서 Start 이상 End 미만의 문자(rune) 위치입니다.
+type Span struct {
+	Start int
+	End   int
+}
+
+// Document 검색 대상 문자열을 자모 단위로 분해한 값입니다.
+type Document struct {
+	jamo hangul.Jamo
+	// chosung 문자별 초성이며, 한글이 아닌 문자는 그대로 유지됩니다.
+	chosung []rune
+}
+
+// NewDocument 대소문자를 구분하지 않도록 소문자로 변환해 분해합니다.
+func NewDocument(s string) (*Document, error) {
+	jamo, err := hangul.Disassemble(strings.ToLower(s))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	chosung := make([]rune, 0, len(jamo.Offsets))
+	for i, offset := range jamo.Offsets {
+		if i == 0 || jamo.Offsets[i-1] != offset {
+			chosung = append(chosung, jamo.Runes[i])
+		}
+	}
+
+	return &Document{jamo: jamo, chosung: chosung}, nil
+}
+
+// isBoundary i번째 자모가 글자의 시작이거나 자모 목록의 끝인지 확인합니다.
+func (d *Document) isBoundary(i int) bool {
+	offsets := d.jamo.Offsets
+	return i == 0 || i == len(offsets) || offsets[i-1] != offsets[i]
+}
+
+// Query 검색어를 자모 단위로 분해한 값입니다.
+type Query struct {
+	jamo []rune
+	// layout 검색어를 영문 자판으로 입력한 한글로 간주해 변환한 자모이며, 변환할 수 없는 검색어는 nil입니다.
+	layout []rune
+	// chosung 검색어가 공백과 한글 자음으로만 구성된 경우 true입니다.
+	chosung bool
+	// initials, keywords Initials, Keywords의 반환 값입니다.
+	initials string
+	keywords []string
+}
+
+func NewQuery(s string) (*Query, error) {
+	s = strings.TrimSpace(s)
+	jamo, err := hangul.Disassemble(strings.ToLower(s))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	layout, _ := hangul.FromQwerty(s)
+
+	chosung := len(jamo.Runes) > 0
+	for _, r := range jamo.Runes {
+		if r != ' ' && !hangul.IsConsonant(r) {
+			chosung = false
+			break
+		}
+	}
+	// 자음만 입력한 검색어는 분해한 자모가 원래 문자와 같습니다.
+	chosung = chosung && len(jamo.Runes) == len([]rune(s))
+
+	// 문자별 첫 자모가 초성이며, 한글이 아닌 문자는 그대로 유지됩니다.
+	initials := make([]rune, 0, len(jamo.Runes))
+	for i, offset := range jamo.Offsets {
+		if i == 0 || jamo.Offsets[i-1] != offset {
+			initials = append(initials, jamo.Runes[i])
+		}
+	}
+	var keywords []string
+	if len(jamo.Runes) > 0 {
+		keywords = appendKeyword(keywords, jamo.Runes[:1])
+	}
+	if len(layout) > 0 {
+		keywords = appendKeyword(keywords, layout[:1])
+	}
+
+	return &Query{jamo: jamo.Runes, layout: layout, chosung: chosung, initials: strings.TrimSpace(string(initials)), keywords: keywords}, nil
+}
+
+// Keywords 저장소에서 검색어와 일치할 수 있는 문서를 미리 고르기 위한 키워드 목록입니다.
+// 검색어의 첫 자모이며, 영문 자판으로 입력한 검색어는 한글로 변환한 값의 첫 자모도 포함합니다.
+// 모든 일치 방식(MatchFuzzy 포함)은 검색어의 첫 자모가 문서의 글자의 시작과 일치해야 하므로, 검색어와 일치한 문서의 초성에는 키워드 중 하나 이상이 포함됩니다.
+func (q *Query) Keywords() []string {
+	return q.keywords
+}
+
+// Initials 검색어 글자의 초성을 이어 붙인 값입니다.
+// 자모가 순서대로 포함된 경우(MatchFuzzy)와 영문 자판으로 입력한 경우를 제외하면, 검색어와 일치한 문서의 초성에 포함됩니다.
+func (q *Query) Initials() string {
+	return q.initials
+}
+
+// Match 문서가 검색어와 일치하는 정도를 반환하며, 일치하지 않으면 Kind가 MatchNone입니다.
+// 영문 자판으로 입력한 검색어는 한글로 변환한 결과도 비교하며, 같은 방식으로 일치한 경우 변환하지 않은 결과를 우선합니다.
+func (q *Query) Match(d *Document) Match {
+	if len(q.jamo) == 0 {
+		return Match{}
+	}
+
+	best := match(q.jamo, q.chosung, d)
+	if len(q.layout) > 0 {
+		if m := match(q.layout, false, d); m.Kind != MatchNone && m.Score-1 > best.Score {
+			m.Score--
+			m.Layout = true
+			best = m
+		}
+	}
+
+	return best
+}
+
+func match(query []rune, chosung bool, d *Document) Match {
+	runes, offsets := d.jamo.Runes, d.jamo.Offsets
+	best := Match{}
+	// update 일치한 자모의 위치 positions로 일치 범위를 계산하며, chosung이 true이면 positions는 문자 위치입니다.
+	update := func(kind MatchKind, penalty int, positions ...int) {
+		score := int(kind)*kindScore - min(penalty, maxPenalty)
+		if score <= best.Score {
+			return
+		}
+		best = Match{Kind: kind, Score: score}
+		for _, position := range positions {
+			if kind != MatchChosung {
+				position = offsets[position]
+			}
+			if n := len(best.Spans); n > 0 && best.Spans[n-1].End >= position {
+				best.Spans[n-1].End = position + 1
+				continue
+			}
+			best.Spans = append(best.Spans, Span{Start: position, End: position + 1})
+		}
+	}
+
+	// 글자의 시작부터 자모가 연속으로 일치하는 경우
+	for start := 0; start+len(query) <= len(runes); start++ {
+		if !d.isBoundary(start) || !slices.Equal(runes[start:start+len(query)], query) {
+			continue
+		}
+		end := start + len(query)
+		positions := sequence(start, end)
+		switch {
+		case !d.isBoundary(end):
+			update(MatchPartial, offsets[start], positions...)
+		case start == 0 && end == len(runes):
+			update(MatchExact, 0, positions...)
+		case start == 0:
+			// 같은 검색어로 시작하면 짧은 이름을 우선합니다.
+			update(MatchPrefix, len(runes)-end, positions...)
+		default:
+			update(MatchContains, offsets[start], positions...)
+		}
+	}
+	if best.Kind != MatchNone {
+		return best
+	}
+
+	if chosung {
+		if i := indexRunes(d.chosung, query); i >= 0 {
+			update(MatchChosung, i, sequence(i, i+len(query))...)
+			return best
+		}
+	}
+
+	// 자모가 순서대로 포함된 경우, 건너뛴 자모가 검색어의 자모 수보다 많으면 일치하지 않는 것으로 봅니다.
+	if len(query) < 2 {
+		return best
+	}
+	for start := range runes {
+		if !d.isBoundary(start) || runes[start] != query[0] {
+			continue
+		}
+		positions := []int{start}
+		for end := start + 1; end < len(runes) && len(positions) < len(query); end++ {
+			if runes[end] == query[len(positions)] {
+				positions = append(positions, end)
+			}
+		}
+		if len(positions) < len(query) {
+			break
+		}
+		if gaps := positions[len(positions)-1] + 1 - start - len(query); gaps <= len(query) {
+			update(MatchFuzzy, gaps*10+offsets[start], positions...)
+		}
+	}
+
+	return best
+}
+
+// appendKeyword 비어 있지 않고 keywords에 없는 키워드만 추가합니다.
+func appendKeyword(keywords []string, keyword []rune) []string {
+	if k := strings.TrimSpace(string(keyword)); len(k) > 0 && !slices.Contains(keywords, k) {
+		keywords = append(keywords, k)
+	}
+
+	return keywords
+}
+
+// sequence start 이상 end 미만의 정수 목록을 반환합니다.
+func sequence(start, end int) []int {
+	s := make([]int, 0, end-start)
+	for i := start; i < end; i++ {
+		s = append(s, i)
+	}
+
+	return s
+}
+
+func indexRunes(s, sub []rune) int {
+	for i := 0; i+len(sub) <= len(s); i++ {
+		if slices.Equal(s[i:i+len(sub)], sub) {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,110 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuery_Match(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		document   string
+		wantKind   MatchKind
+		wantLayout bool
+		wantSpans  []Span
+	}{
+		{name: "같은 이름", query: "아메리카노", document: "아메리카노", wantKind: MatchExact, wantSpans: []Span{{0, 5}}},
+		{name: "대소문자 구분 없음", query: "ice", document: "ICE", wantKind: MatchExact},
+		{name: "검색어로 시작", query: "아메", document: "아메리카노", wantKind: MatchPrefix, wantSpans: []Span{{0, 2}}},
+		{name: "검색어 포함", query: "리카", document: "아메리카노", wantKind: MatchContains, wantSpans: []Span{{2, 4}}},
+		{name: "입력 중인 받침", query: "아멜", document: "아메리카노", wantKind: MatchPartial, wantSpans: []Span{{0, 3}}},
+		{name: "입력 중인 겹모음", query: "고", document: "과자", wantKind: MatchPartial},
+		{name: "초성", query: "ㅇㅁㄹ", document: "아메리카노", wantKind: MatchChosung, wantSpans: []Span{{0, 3}}},
+		{name: "공백 포함 초성", query: "ㅋㅍ ㄹㄸ", document: "커피 라떼", wantKind: MatchChosung, wantSpans: []Span{{0, 5}}},
+		{name: "자모 순서대로 포함", query: "아멬", document: "아메리카노", wantKind: MatchFuzzy, wantSpans: []Span{{0, 2}, {3, 4}}},
+		{name: "영문 자판", query: "dkapflzksh", document: "아메리카노", wantKind: MatchExact, wantLayout: true, wantSpans: []Span{{0, 5}}},
+		{name: "영문 자판으로 입력 중", query: "dkapf", document: "아메리카노", wantKind: MatchPartial, wantLayout: true},
+		{name: "영문 자판 쌍자음", query: "Qkd", document: "빵", wantKind: MatchExact, wantLayout: true},
+		{name: "영문 이름은 변환하지 않음", query: "latte", document: "Caffe Latte", wantKind: MatchContains, wantSpans: []Span{{6, 11}}},
+		{name: "글자 중간에서 시작", query: "ㅏ메", document: "아메리카노", wantKind: MatchNone},
+		{name: "건너뛴 자모가 많음", query: "아노", document: "아메리카노", wantKind: MatchNone},
+		{name: "일치하지 않음", query: "라떼", document: "아메리카노", wantKind: MatchNone},
+		{name: "빈 검색어", query: " ", document: "아메리카노", wantKind: MatchNone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := NewQuery(tt.query)
+			require.NoError(t, err)
+			document, err := NewDocument(tt.document)
+			require.NoError(t, err)
+
+			got := query.Match(document)
+			require.Equal(t, tt.wantKind, got.Kind)
+			require.Equal(t, tt.wantLayout, got.Layout)
+			if tt.wantSpans != nil {
+				require.Equal(t, tt.wantSpans, got.Spans)
+			}
+		})
+	}
+}
+
+func TestQuery_Match_Rank(t *testing.T) {
+	// 검색어와 가깝게 일치한 순서입니다.
+	documents := []string{
+		"아메리카노",
+		"아메리카노 라지",
+		"아이스 아메리카노",
+		"아메리칸 와플",
+		"아이스 아메리칸 쿠키",
+		"아메리 카스텔라",
+	}
+	query, err := NewQuery("아메리카")
+	require.NoError(t, err)
+
+	var prev *Match
+	for _, s := range documents {
+		document, err := NewDocument(s)
+		require.NoError(t, err)
+		got := query.Match(document)
+		require.NotEqual(t, MatchNone, got.Kind, s)
+		if prev != nil {
+			require.Greater(t, prev.Score, got.Score, s)
+		}
+		prev = &got
+	}
+}
+
+func TestQuery_Keywords(t *testing.T) {
+	tests := []struct {
+		query    string
+		want     []string
+		initials string
+	}{
+		{query: "아메리카노", want: []string{"ㅇ"}, initials: "ㅇㅁㄹㅋㄴ"},
+		{query: "아멬", want: []string{"ㅇ"}, initials: "ㅇㅁ"},
+		{query: "아리", want: []string{"ㅇ"}, initials: "ㅇㄹ"},
+		{query: "ㅋㅍ ㄹㄸ", want: []string{"ㅋ"}, initials: "ㅋㅍ ㄹㄸ"},
+		{query: "Latte", want: []string{"l", "ㅣ"}, initials: "latte"},
+		{query: "dkapflzksh", want: []string{"d", "ㅇ"}, initials: "dkapflzksh"},
+		{query: "Qkd", want: []string{"q", "ㅃ"}, initials: "qkd"},
+		{query: " ", want: nil, initials: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			query, err := NewQuery(tt.query)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, query.Keywords())
+			require.Equal(t, tt.initials, query.Initials())
+		})
+	}
+
+	// 자모가 순서대로 포함된 경우에도 문서의 초성에 키워드가 포함됩니다.
+	query, err := NewQuery("아리")
+	require.NoError(t, err)
+	document, err := NewDocument("아메리카노")
+	require.NoError(t, err)
+	require.Equal(t, MatchFuzzy, query.Match(document).Kind)
+	require.Contains(t, string(document.chosung), query.Keywords()[0])
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/psi59/payhere-assignment/internal/i18n"
 
 	"github.com/gin-gonic/gin"
+	"github.com/psi59/payhere-assignment/internal/db"
 	"github.com/psi59/payhere-assignment/internal/ginhelper"
 	"github.com/psi59/payhere-assignment/internal/valid"
 	"github.com/psi59/payhere-assignment/usecase/authtoken"
@@ -52,6 +53,8 @@ func (a *AuthMiddleware) Auth() gin.HandlerFunc {
 			Token: token,
 		})
 		if err != nil {
+			// 클라이언트에는 거부 사유를 구분하지 않고 응답하며, 사유는 로그로만 남깁니다.
+			ctxlog.WithStr(ctx, "tokenRejectReason", tokenRejectReason(err))
 			msgID := i18n.Unauthorized
 			if errors.Is(err, domain.ErrExpiredToken) {
 				msgID = i18n.ExpiredToken
@@ -63,7 +66,8 @@ func (a *AuthMiddleware) Auth() gin.HandlerFunc {
 		}
 
 		getTokenBlacklistOutput, err := a.authTokenUsecase.GetBlacklist(ctx, &authtoken.GetBlacklistInput{
-			Token: token,
+			TokenID:   verifyTokenOutput.SessionID,
+			ExpiresAt: verifyTokenOutput.ExpiresAt,
 		})
 		if err != nil {
 			if !errors.Is(err, domain.ErrTokenBlacklistNotFound) {
@@ -80,6 +84,29 @@ func (a *AuthMiddleware) Auth() gin.HandlerFunc {
 			return
 		}
 
+		// 폐기된 세션의 토큰이라면 인증 에러
+		getSessionOutput, err := a.authTokenUsecase.GetSession(ctx, &authtoken.GetSessionInput{
+			SessionID: verifyTokenOutput.SessionID,
+		})
+		if err != nil {
+			if errors.Is(err, domain.ErrSessionNotFound) {
+				ctxlog.WithStr(ctx, "tokenRejectReason", string(domain.ErrSessionNotFound))
+				ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusUnauthorized, i18n.Unauthorized, errors.WithStack(err)))
+				ginCtx.Abort()
+				return
+			}
+
+			ginhelper.Error(ginCtx, errors.Wrap(err, "failed to get session"))
+			ginCtx.Abort()
+			return
+		}
+		if revokedAt := getSessionOutput.Session.RevokedAt; revokedAt != nil {
+			ctxlog.WithStr(ctx, "tokenRejectReason", string(domain.ErrSessionRevoked))
+			ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusUnauthorized, i18n.Unauthorized, errors.Wrapf(domain.ErrSessionRevoked, "revoked at %s", revokedAt.UTC())))
+			ginCtx.Abort()
+			return
+		}
+
 		userID, err := strconv.Atoi(verifyTokenOutput.Identifier)
 		if err != nil {
 			ginhelper.Error(ginCtx, errors.Wrap(err, "failed to parse userID"))
@@ -87,7 +114,8 @@ func (a *AuthMiddleware) Auth() gin.HandlerFunc {
 			return
 		}
 
-		userGetOutput, err := a.userUsecase.Get(ctx, &user.GetInput{
+		// 비밀번호 변경 등으로 토큰을 무효화한 직후의 요청도 거부할 수 있도록 복제본이 아닌 primary에서 조회합니다.
+		userGetOutput, err := a.userUsecase.Get(db.ContextWithPrimary(ctx), &user.GetInput{
 			UserID: userID,
 		})
 		if err != nil {
@@ -101,10 +129,43 @@ func (a *AuthMiddleware) Auth() gin.HandlerFunc {
 			ginCtx.Abort()
 			return
 		}
+		// 비밀번호 변경 등으로 유저의 토큰이 모두 무효화된 이후 발급된 토큰인지 확인
+		if userGetOutput.User.IsTokenInvalidated(verifyTokenOutput.IssuedAt) {
+			ctxlog.WithStr(ctx, "tokenRejectReason", string(domain.ErrTokenInvalidated))
+			ginhelper.Error(ginCtx, ginhelper.NewHTTPError(http.StatusUnauthorized, i18n.Unauthorized, errors.Wrapf(domain.ErrTokenInvalidated, "issuedAt(%s) < tokensValidAfter(%s)", verifyTokenOutput.IssuedAt.UTC(), userGetOutput.User.TokensValidAfter.UTC())))
+			ginCtx.Abort()
+			return
+		}
 		ctx = context.WithValue(ctx, domain.CtxKeyUser, userGetOutput.User)
+		ctx = context.WithValue(ctx, domain.CtxKeySessionID, verifyTokenOutput.SessionID)
 		ctxlog.WithInt(ctx, "userID", userGetOutput.User.ID)
 		ginhelper.SetContext(ginCtx, ctx)
 
 		ginCtx.Next()
 	}
 }
+
+// tokenRejectReasons 토큰 검증 실패 사유입니다.
+var tokenRejectReasons = []domain.ConstantError{
+	domain.ErrMalformedToken,
+	domain.ErrTokenAlgorithmNotAllowed,
+	domain.ErrUnknownTokenKey,
+	domain.ErrInvalidTokenSignature,
+	domain.ErrInvalidTokenIssuer,
+	domain.ErrInvalidTokenAudience,
+	domain.ErrTokenClaimMissing,
+	domain.ErrTokenIssuedInFuture,
+	domain.ErrTokenNotYetValid,
+	domain.ErrExpiredToken,
+}
+
+// tokenRejectReason 토큰 검증 에러의 거부 사유를 반환합니다.
+func tokenRejectReason(err error) string {
+	for _, reason := range tokenRejectReasons {
+		if errors.Is(err, reason) {
+			return string(reason)
+		}
+	}
+
+	return "Unknown"
+}
@@ -1,22 +1,28 @@
 package middleware
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/brianvoe/gofakeit/v6"
 	"golang.org/x/text/language"
 
 	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
 	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/internal/db"
 	"github.com/psi59/payhere-assignment/internal/ginhelper"
 	"github.com/psi59/payhere-assignment/internal/i18n"
 	"github.com/psi59/payhere-assignment/internal/mocks/ucmocks"
 	"github.com/psi59/payhere-assignment/usecase/authtoken"
 	"github.com/psi59/payhere-assignment/usecase/user"
+	"github.com/rs/xid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -95,6 +101,8 @@ func TestAuthMiddleware_Auth(t *testing.T) {
 	require.NoError(t, err)
 	userDomain.ID = gofakeit.Number(1, 10)
 	token := gofakeit.UUID()
+	session := &domain.Session{ID: xid.New().String(), Identifier: strconv.Itoa(userDomain.ID)}
+	expiresAt := gofakeit.FutureDate()
 	httpRequest, err := http.NewRequest(http.MethodPost, "/", nil)
 	require.NoError(t, err)
 	httpRequest.Header.Set("Authorization", "Bearer "+token)
@@ -104,15 +112,24 @@ func TestAuthMiddleware_Auth(t *testing.T) {
 			Token: token,
 		}).Return(&authtoken.VerifyOutput{
 			Identifier: strconv.Itoa(userDomain.ID),
-			ExpiresAt:  gofakeit.FutureDate(),
+			ExpiresAt:  expiresAt,
+			SessionID:  session.ID,
 		}, nil)
 		authTokenUsecase.EXPECT().GetBlacklist(gomock.Any(), &authtoken.GetBlacklistInput{
-			Token: token,
+			TokenID:   session.ID,
+			ExpiresAt: expiresAt,
 		}).Return(nil, domain.ErrTokenBlacklistNotFound)
+		authTokenUsecase.EXPECT().GetSession(gomock.Any(), &authtoken.GetSessionInput{
+			SessionID: session.ID,
+		}).Return(&authtoken.GetSessionOutput{Session: session}, nil)
 
 		userUsecase.EXPECT().Get(gomock.Any(), &user.GetInput{
 			UserID: userDomain.ID,
-		}).Return(&user.GetOutput{User: userDomain}, nil)
+		}).DoAndReturn(func(c context.Context, _ *user.GetInput) (*user.GetOutput, error) {
+			// 토큰 무효화 시각은 primary에서 조회합니다.
+			assert.True(t, db.UsePrimary(c))
+			return &user.GetOutput{User: userDomain}, nil
+		})
 
 		responseWriter := httptest.NewRecorder()
 		r.ServeHTTP(responseWriter, httpRequest)
@@ -145,6 +162,21 @@ func TestAuthMiddleware_Auth(t *testing.T) {
 		assert.Equal(t, i18n.T(language.English, i18n.ExpiredToken, nil), resp.Meta.Message)
 	})
 
+	t.Run("audience가 다른 토큰", func(t *testing.T) {
+		authTokenUsecase.EXPECT().Verify(gomock.Any(), &authtoken.VerifyInput{
+			Token: token,
+		}).Return(nil, fmt.Errorf("%w: aud(%s)", domain.ErrInvalidTokenAudience, gofakeit.DomainName()))
+
+		responseWriter := httptest.NewRecorder()
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		var resp ginhelper.Response
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, responseWriter.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.Unauthorized, nil), resp.Meta.Message)
+	})
+
 	t.Run("토큰 검증 시 알 수 없는 에러 발생", func(t *testing.T) {
 		authTokenUsecase.EXPECT().Verify(gomock.Any(), &authtoken.VerifyInput{
 			Token: token,
@@ -168,10 +200,15 @@ func TestAuthMiddleware_Auth(t *testing.T) {
 		}).Return(&authtoken.VerifyOutput{
 			Identifier: gofakeit.UUID(),
 			ExpiresAt:  expiresAt,
+			SessionID:  session.ID,
 		}, nil)
 		authTokenUsecase.EXPECT().GetBlacklist(gomock.Any(), &authtoken.GetBlacklistInput{
-			Token: token,
+			TokenID:   session.ID,
+			ExpiresAt: expiresAt,
 		}).Return(nil, domain.ErrTokenBlacklistNotFound)
+		authTokenUsecase.EXPECT().GetSession(gomock.Any(), &authtoken.GetSessionInput{
+			SessionID: session.ID,
+		}).Return(&authtoken.GetSessionOutput{Session: session}, nil)
 
 		responseWriter := httptest.NewRecorder()
 		r.ServeHTTP(responseWriter, httpRequest)
@@ -185,18 +222,19 @@ func TestAuthMiddleware_Auth(t *testing.T) {
 	})
 
 	t.Run("토큰이 블랙리스트에 존재할 경우", func(t *testing.T) {
-		expiresAt := gofakeit.FutureDate()
 		authTokenUsecase.EXPECT().Verify(gomock.Any(), &authtoken.VerifyInput{
 			Token: token,
 		}).Return(&authtoken.VerifyOutput{
 			Identifier: strconv.Itoa(userDomain.ID),
 			ExpiresAt:  expiresAt,
+			SessionID:  session.ID,
 		}, nil)
 		authTokenUsecase.EXPECT().GetBlacklist(gomock.Any(), &authtoken.GetBlacklistInput{
-			Token: token,
+			TokenID:   session.ID,
+			ExpiresAt: expiresAt,
 		}).Return(&authtoken.GetBlacklistOutput{
 			Token: &domain.AuthToken{
-				Token:     token,
+				ID:        session.ID,
 				ExpiresAt: expiresAt,
 			},
 		}, nil)
@@ -217,10 +255,12 @@ func TestAuthMiddleware_Auth(t *testing.T) {
 			Token: token,
 		}).Return(&authtoken.VerifyOutput{
 			Identifier: strconv.Itoa(userDomain.ID),
-			ExpiresAt:  gofakeit.FutureDate(),
+			ExpiresAt:  expiresAt,
+			SessionID:  session.ID,
 		}, nil)
 		authTokenUsecase.EXPECT().GetBlacklist(gomock.Any(), &authtoken.GetBlacklistInput{
-			Token: token,
+			TokenID:   session.ID,
+			ExpiresAt: expiresAt,
 		}).Return(nil, gofakeit.Error())
 
 		responseWriter := httptest.NewRecorder()
@@ -234,16 +274,96 @@ func TestAuthMiddleware_Auth(t *testing.T) {
 		assert.Equal(t, i18n.T(language.English, i18n.InternalError, nil), resp.Meta.Message)
 	})
 
-	t.Run("회원이 존재하지 않을 때", func(t *testing.T) {
+	t.Run("폐기된 세션", func(t *testing.T) {
+		revokedAt := time.Now()
 		authTokenUsecase.EXPECT().Verify(gomock.Any(), &authtoken.VerifyInput{
 			Token: token,
 		}).Return(&authtoken.VerifyOutput{
 			Identifier: strconv.Itoa(userDomain.ID),
-			ExpiresAt:  gofakeit.FutureDate(),
+			ExpiresAt:  expiresAt,
+			SessionID:  session.ID,
+		}, nil)
+		authTokenUsecase.EXPECT().GetBlacklist(gomock.Any(), &authtoken.GetBlacklistInput{
+			TokenID:   session.ID,
+			ExpiresAt: expiresAt,
+		}).Return(nil, domain.ErrTokenBlacklistNotFound)
+		authTokenUsecase.EXPECT().GetSession(gomock.Any(), &authtoken.GetSessionInput{
+			SessionID: session.ID,
+		}).Return(&authtoken.GetSessionOutput{Session: &domain.Session{ID: session.ID, RevokedAt: &revokedAt}}, nil)
+
+		responseWriter := httptest.NewRecorder()
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		var resp ginhelper.Response
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, responseWriter.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.Unauthorized, nil), resp.Meta.Message)
+	})
+
+	t.Run("세션이 존재하지 않을 때", func(t *testing.T) {
+		authTokenUsecase.EXPECT().Verify(gomock.Any(), &authtoken.VerifyInput{
+			Token: token,
+		}).Return(&authtoken.VerifyOutput{
+			Identifier: strconv.Itoa(userDomain.ID),
+			ExpiresAt:  expiresAt,
+			SessionID:  session.ID,
+		}, nil)
+		authTokenUsecase.EXPECT().GetBlacklist(gomock.Any(), &authtoken.GetBlacklistInput{
+			TokenID:   session.ID,
+			ExpiresAt: expiresAt,
+		}).Return(nil, domain.ErrTokenBlacklistNotFound)
+		authTokenUsecase.EXPECT().GetSession(gomock.Any(), &authtoken.GetSessionInput{
+			SessionID: session.ID,
+		}).Return(nil, domain.ErrSessionNotFound)
+
+		responseWriter := httptest.NewRecorder()
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		var resp ginhelper.Response
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, responseWriter.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.Unauthorized, nil), resp.Meta.Message)
+	})
+
+	t.Run("세션 조회 실패", func(t *testing.T) {
+		authTokenUsecase.EXPECT().Verify(gomock.Any(), &authtoken.VerifyInput{
+			Token: token,
+		}).Return(&authtoken.VerifyOutput{
+			Identifier: strconv.Itoa(userDomain.ID),
+			ExpiresAt:  expiresAt,
+			SessionID:  session.ID,
 		}, nil)
 		authTokenUsecase.EXPECT().GetBlacklist(gomock.Any(), &authtoken.GetBlacklistInput{
+			TokenID:   session.ID,
+			ExpiresAt: expiresAt,
+		}).Return(nil, domain.ErrTokenBlacklistNotFound)
+		authTokenUsecase.EXPECT().GetSession(gomock.Any(), &authtoken.GetSessionInput{
+			SessionID: session.ID,
+		}).Return(nil, gofakeit.Error())
+
+		responseWriter := httptest.NewRecorder()
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		assert.Equal(t, http.StatusInternalServerError, responseWriter.Code)
+	})
+
+	t.Run("회원이 존재하지 않을 때", func(t *testing.T) {
+		authTokenUsecase.EXPECT().Verify(gomock.Any(), &authtoken.VerifyInput{
 			Token: token,
+		}).Return(&authtoken.VerifyOutput{
+			Identifier: strconv.Itoa(userDomain.ID),
+			ExpiresAt:  expiresAt,
+			SessionID:  session.ID,
+		}, nil)
+		authTokenUsecase.EXPECT().GetBlacklist(gomock.Any(), &authtoken.GetBlacklistInput{
+			TokenID:   session.ID,
+			ExpiresAt: expiresAt,
 		}).Return(nil, domain.ErrTokenBlacklistNotFound)
+		authTokenUsecase.EXPECT().GetSession(gomock.Any(), &authtoken.GetSessionInput{
+			SessionID: session.ID,
+		}).Return(&authtoken.GetSessionOutput{Session: session}, nil)
 
 		userUsecase.EXPECT().Get(gomock.Any(), &user.GetInput{
 			UserID: userDomain.ID,
@@ -260,16 +380,85 @@ func TestAuthMiddleware_Auth(t *testing.T) {
 		assert.Equal(t, i18n.T(language.English, i18n.UserNotFound, nil), resp.Meta.Message)
 	})
 
-	t.Run("회원 조회 실패", func(t *testing.T) {
+	t.Run("무효화 이전에 발급된 토큰", func(t *testing.T) {
+		tokensValidAfter := time.Unix(time.Now().Unix(), 0)
+		invalidatedUser := *userDomain
+		invalidatedUser.TokensValidAfter = &tokensValidAfter
 		authTokenUsecase.EXPECT().Verify(gomock.Any(), &authtoken.VerifyInput{
 			Token: token,
 		}).Return(&authtoken.VerifyOutput{
 			Identifier: strconv.Itoa(userDomain.ID),
-			ExpiresAt:  gofakeit.FutureDate(),
+			ExpiresAt:  expiresAt,
+			IssuedAt:   tokensValidAfter.Add(-time.Second),
+			SessionID:  session.ID,
 		}, nil)
 		authTokenUsecase.EXPECT().GetBlacklist(gomock.Any(), &authtoken.GetBlacklistInput{
+			TokenID:   session.ID,
+			ExpiresAt: expiresAt,
+		}).Return(nil, domain.ErrTokenBlacklistNotFound)
+		authTokenUsecase.EXPECT().GetSession(gomock.Any(), &authtoken.GetSessionInput{
+			SessionID: session.ID,
+		}).Return(&authtoken.GetSessionOutput{Session: session}, nil)
+
+		userUsecase.EXPECT().Get(gomock.Any(), &user.GetInput{
+			UserID: userDomain.ID,
+		}).Return(&user.GetOutput{User: &invalidatedUser}, nil)
+
+		responseWriter := httptest.NewRecorder()
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		var resp ginhelper.Response
+		err = json.NewDecoder(responseWriter.Body).Decode(&resp)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, responseWriter.Code)
+		assert.Equal(t, i18n.T(language.English, i18n.Unauthorized, nil), resp.Meta.Message)
+	})
+
+	t.Run("무효화 이후에 발급된 토큰", func(t *testing.T) {
+		tokensValidAfter := time.Unix(time.Now().Unix(), 0)
+		invalidatedUser := *userDomain
+		invalidatedUser.TokensValidAfter = &tokensValidAfter
+		authTokenUsecase.EXPECT().Verify(gomock.Any(), &authtoken.VerifyInput{
+			Token: token,
+		}).Return(&authtoken.VerifyOutput{
+			Identifier: strconv.Itoa(userDomain.ID),
+			ExpiresAt:  expiresAt,
+			IssuedAt:   tokensValidAfter,
+			SessionID:  session.ID,
+		}, nil)
+		authTokenUsecase.EXPECT().GetBlacklist(gomock.Any(), &authtoken.GetBlacklistInput{
+			TokenID:   session.ID,
+			ExpiresAt: expiresAt,
+		}).Return(nil, domain.ErrTokenBlacklistNotFound)
+		authTokenUsecase.EXPECT().GetSession(gomock.Any(), &authtoken.GetSessionInput{
+			SessionID: session.ID,
+		}).Return(&authtoken.GetSessionOutput{Session: session}, nil)
+
+		userUsecase.EXPECT().Get(gomock.Any(), &user.GetInput{
+			UserID: userDomain.ID,
+		}).Return(&user.GetOutput{User: &invalidatedUser}, nil)
+
+		responseWriter := httptest.NewRecorder()
+		r.ServeHTTP(responseWriter, httpRequest)
+
+		assert.Equal(t, http.StatusNoContent, responseWriter.Code)
+	})
+
+	t.Run("회원 조회 실패", func(t *testing.T) {
+		authTokenUsecase.EXPECT().Verify(gomock.Any(), &authtoken.VerifyInput{
 			Token: token,
+		}).Return(&authtoken.VerifyOutput{
+			Identifier: strconv.Itoa(userDomain.ID),
+			ExpiresAt:  expiresAt,
+			SessionID:  session.ID,
+		}, nil)
+		authTokenUsecase.EXPECT().GetBlacklist(gomock.Any(), &authtoken.GetBlacklistInput{
+			TokenID:   session.ID,
+			ExpiresAt: expiresAt,
 		}).Return(nil, domain.ErrTokenBlacklistNotFound)
+		authTokenUsecase.EXPECT().GetSession(gomock.Any(), &authtoken.GetSessionInput{
+			SessionID: session.ID,
+		}).Return(&authtoken.GetSessionOutput{Session: session}, nil)
 
 		userUsecase.EXPECT().Get(gomock.Any(), &user.GetInput{
 			UserID: userDomain.ID,
@@ -286,3 +475,11 @@ func TestAuthMiddleware_Auth(t *testing.T) {
 		assert.Equal(t, i18n.T(language.English, i18n.InternalError, nil), resp.Meta.Message)
 	})
 }
+
+func TestTokenRejectReason(t *testing.T) {
+	for _, reason := range tokenRejectReasons {
+		err := errors.Wrap(fmt.Errorf("%w: %s", reason, gofakeit.Sentence(3)), "failed to verify")
+		assert.Equal(t, string(reason), tokenRejectReason(err))
+	}
+	assert.Equal(t, "Unknown", tokenRejectReason(gofakeit.Error()))
+}
@@ -14,25 +14,116 @@ const (
 	ErrNilUserRepository           domain.ConstantError = "nil UserRepository"
 	ErrNilTokenBlacklistRepository domain.ConstantError = "nil TokenBlacklistRepository"
 	ErrNilItemRepository           domain.ConstantError = "nil ItemRepository"
+	ErrNilItemHistoryRepository    domain.ConstantError = "nil ItemHistoryRepository"
+	ErrNilRefreshTokenRepository   domain.ConstantError = "nil RefreshTokenRepository"
+	ErrNilSessionRepository        domain.ConstantError = "nil SessionRepository"
 )
 
 type UserRepository interface {
 	Create(c context.Context, user *domain.User) error
 	Get(c context.Context, userID int) (*domain.User, error)
 	GetByPhoneNumber(c context.Context, phoneNumber string) (*domain.User, error)
+	Update(c context.Context, userID int, input *UpdateUserInput) error
+}
+
+// UpdateUserInput nil이 아닌 값만 수정합니다.
+type UpdateUserInput struct {
+	DuplicateItemPolicy *domain.DuplicateItemPolicy
+	// Password 해시된 비밀번호입니다.
+	Password         *string
+	TokensValidAfter *time.Time
+}
+
+func (i *UpdateUserInput) Validate() error {
+	if valid.IsNil(i.DuplicateItemPolicy) && valid.IsNil(i.Password) && valid.IsNil(i.TokensValidAfter) {
+		return fmt.Errorf("invalid input")
+	}
+	if i.DuplicateItemPolicy != nil {
+		if err := i.DuplicateItemPolicy.Validate(); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	if i.Password != nil && len(*i.Password) == 0 {
+		return fmt.Errorf("empty password")
+	}
+	if i.TokensValidAfter != nil && i.TokensValidAfter.IsZero() {
+		return fmt.Errorf("zero tokensValidAfter")
+	}
+
+	return nil
 }
 
 type TokenBlacklistRepository interface {
 	Create(c context.Context, token *domain.AuthToken) error
-	Get(c context.Context, token string) (*domain.AuthToken, error)
+	// Get 토큰의 jti로 블랙리스트를 조회합니다.
+	Get(c context.Context, tokenID string) (*domain.AuthToken, error)
+	// FindActive now 기준으로 만료되지 않은 토큰을 모두 반환합니다.
+	FindActive(c context.Context, now time.Time) ([]domain.AuthToken, error)
+	// DeleteExpired before 이전에 만료된 토큰을 블랙리스트에서 삭제하고, 삭제된 토큰 수를 반환합니다.
+	DeleteExpired(c context.Context, before time.Time) (int, error)
+}
+
+type RefreshTokenRepository interface {
+	Create(c context.Context, token *domain.RefreshToken) error
+	Get(c context.Context, tokenHash string) (*domain.RefreshToken, error)
+	// Rotate 재발급에 사용된 시각을 기록합니다.
+	// 이미 재발급에 사용됐거나 폐기된 토큰이면 domain.ErrRefreshTokenAlreadyRotated를 반환하므로, 같은 토큰으로 동시에 재발급해도 한 번만 성공합니다.
+	Rotate(c context.Context, tokenHash string, rotatedAt time.Time) error
+	// RevokeFamily family의 폐기되지 않은 토큰을 모두 폐기합니다.
+	RevokeFamily(c context.Context, familyID string, revokedAt time.Time) error
+	// RevokeAll identifier의 폐기되지 않은 토큰을 모두 폐기합니다.
+	RevokeAll(c context.Context, identifier string, revokedAt time.Time) error
+	// DeleteExpired before 이전에 만료된 토큰을 삭제하고, 삭제된 토큰 수를 반환합니다.
+	DeleteExpired(c context.Context, before time.Time) (int, error)
+}
+
+type SessionRepository interface {
+	Create(c context.Context, session *domain.Session) error
+	Get(c context.Context, sessionID string) (*domain.Session, error)
+	// FindActive now 기준으로 폐기되거나 만료되지 않은 identifier의 세션을 최근에 생성된 순서로 반환합니다.
+	FindActive(c context.Context, identifier string, now time.Time) ([]domain.Session, error)
+	// RevokeFamily family의 폐기되지 않은 세션을 모두 폐기합니다.
+	RevokeFamily(c context.Context, familyID string, revokedAt time.Time) error
+	// RevokeAll revokedAt 기준으로 폐기되거나 만료되지 않은 identifier의 세션을 모두 폐기하고, 폐기된 세션 수를 반환합니다.
+	RevokeAll(c context.Context, identifier string, revokedAt time.Time) (int, error)
+	// DeleteExpired before 이전에 만료된 세션을 삭제하고, 삭제된 세션 수를 반환합니다.
+	DeleteExpired(c context.Context, before time.Time) (int, error)
 }
 
 type ItemRepository interface {
 	Create(c context.Context, item *domain.Item) error
 	Get(c context.Context, userID, itemID int) (*domain.Item, error)
-	Delete(c context.Context, userID, itemID int) error
+	// Delete 아이템을 휴지통으로 이동합니다.
+	// version이 0보다 큰 경우 현재 버전과 일치할 때만 이동하며, 일치하지 않으면 domain.ErrItemVersionMismatch를 반환합니다.
+	Delete(c context.Context, userID, itemID, version int) error
+	// Restore 휴지통의 아이템을 복원합니다.
+	Restore(c context.Context, userID, itemID int) error
+	// Purge 휴지통 여부와 관계없이 아이템을 영구 삭제합니다.
+	// version이 0보다 큰 경우 현재 버전과 일치할 때만 삭제하며, 일치하지 않으면 domain.ErrItemVersionMismatch를 반환합니다.
+	Purge(c context.Context, userID, itemID, version int) error
+	// PurgeDeleted deletedBefore 이전에 휴지통으로 이동된 모든 아이템을 영구 삭제하고, 삭제된 아이템 수를 반환합니다.
+	PurgeDeleted(c context.Context, deletedBefore time.Time) (int, error)
+	// Update input.Version이 0보다 큰 경우 현재 버전과 일치할 때만 수정하며, 일치하지 않으면 domain.ErrItemVersionMismatch를 반환합니다.
 	Update(c context.Context, userID, itemID int, input *UpdateItemInput) error
 	Find(c context.Context, input *FindItemInput) (*FindItemOutput, error)
+	// FindDeleted 휴지통의 아이템 목록을 조회합니다.
+	FindDeleted(c context.Context, input *FindDeletedItemInput) (*FindItemOutput, error)
+	// GetStamp 유저의 아이템이 변경되었는지 비교하기 위한 ItemStamp를 조회합니다.
+	GetStamp(c context.Context, userID int) (*ItemStamp, error)
+}
+
+// ItemStamp 휴지통을 포함한 유저의 아이템 수, 가장 큰 아이템 ID, 버전의 합입니다.
+// 아이템을 생성하면 MaxItemID가, 영구 삭제하면 Count가 달라지며, 수정, 삭제, 복원하면 버전이 증가하므로 VersionSum이 달라집니다.
+type ItemStamp struct {
+	Count      int
+	MaxItemID  int
+	VersionSum int
+}
+
+type ItemHistoryRepository interface {
+	Create(c context.Context, history *domain.ItemHistory) error
+	// Find 아이템의 변경 이력을 오래된 순으로 조회합니다.
+	Find(c context.Context, input *FindItemHistoryInput) (*FindItemHistoryOutput, error)
 }
 
 type UpdateItemInput struct {
@@ -44,6 +135,8 @@ type UpdateItemInput struct {
 	Barcode     *string          `validate:"omitnil,gt=0"`
 	Size        *domain.ItemSize `validate:"omitnil,gt=0"`
 	ExpiryAt    *time.Time       `validate:"omitnil,gt=0"`
+	// Version 수정 전 아이템의 버전이며, 0인 경우 버전을 확인하지 않습니다.
+	Version int `validate:"gte=0"`
 }
 
 func (i *UpdateItemInput) Validate() error {
@@ -65,14 +158,88 @@ func (i *UpdateItemInput) Validate() error {
 }
 
 type FindItemInput struct {
+	UserID int `validate:"required"`
+	// Keywords 이름 또는 이름의 초성에 키워드 중 하나 이상이 포함된 아이템만 조회하며, 비어 있으면 키워드로 거르지 않습니다.
+	Keywords []string `validate:"dive,required"`
+	// MinNameLength, MaxNameLength 정규화한 이름의 자모 수가 범위 안인 아이템만 조회합니다.
+	// 자모 수를 저장하기 전에 등록된 아이템은 범위와 관계없이 조회합니다.
+	MinNameLength *int `validate:"omitempty,gte=0"`
+	MaxNameLength *int `validate:"omitempty,gte=0"`
+	Filter        domain.ItemFilter
+	Sort          domain.ItemSortOrder
+	// Cursor nil이면 첫 페이지를 조회합니다.
+	Cursor *domain.ItemCursor
+	// WithTotal true인 경우에만 조건을 만족하는 전체 아이템 수를 조회합니다.
+	WithTotal bool
+	// WithFacets true인 경우에만 조건을 만족하는 아이템의 카테고리, 크기, 가격 구간별 아이템 수를 조회합니다.
+	WithFacets bool
+	// Limit 한 페이지의 아이템 수이며, 0이면 저장소의 기본값을 사용합니다.
+	Limit int `validate:"gte=0"`
+}
+
+func (i *FindItemInput) Validate() error {
+	if err := valid.ValidateStruct(i); err != nil {
+		return errors.WithStack(err)
+	}
+	if i.MinNameLength != nil && i.MaxNameLength != nil && *i.MinNameLength > *i.MaxNameLength {
+		return errors.Errorf("minNameLength(%d) > maxNameLength(%d)", *i.MinNameLength, *i.MaxNameLength)
+	}
+	if err := i.Filter.Validate(); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := i.Sort.Validate(); err != nil {
+		return errors.WithStack(err)
+	}
+	if i.Sort.Has(domain.ItemSortKeyRelevance) {
+		return errors.Errorf("unsupported ItemSortKey: %q", domain.ItemSortKeyRelevance)
+	}
+	if i.Cursor != nil && i.Cursor.Sort != i.Sort.String() {
+		return errors.Wrapf(domain.ErrInvalidItemCursor, "sort mismatch: %q != %q", i.Cursor.Sort, i.Sort.String())
+	}
+
+	return nil
+}
+
+// FindDeletedItemInput 휴지통의 아이템은 아이템 ID 순으로 조회합니다.
+type FindDeletedItemInput struct {
+	UserID int `validate:"required"`
+	// Cursor nil이면 첫 페이지를 조회합니다.
+	Cursor *domain.ItemCursor
+	// WithTotal true인 경우에만 휴지통의 전체 아이템 수를 조회합니다.
+	WithTotal bool
+	// Limit 한 페이지의 아이템 수이며, 0이면 저장소의 기본값을 사용합니다.
+	Limit int `validate:"gte=0"`
+}
+
+func (i *FindDeletedItemInput) Validate() error {
+	if err := valid.ValidateStruct(i); err != nil {
+		return errors.WithStack(err)
+	}
+	if i.Cursor != nil && len(i.Cursor.Sort) > 0 {
+		return errors.Wrapf(domain.ErrInvalidItemCursor, "sort mismatch: %q", i.Cursor.Sort)
+	}
+
+	return nil
+}
+
+type FindItemOutput struct {
+	// TotalCount WithTotal이 true인 경우에만 설정됩니다.
+	TotalCount int
+	// Facets Find에서 WithFacets가 true인 경우에만 설정됩니다.
+	Facets  *domain.ItemFacets
+	Items   []domain.Item
+	HasNext bool
+	HasPrev bool
+}
+
+type FindItemHistoryInput struct {
 	UserID      int `validate:"required"`
-	Keyword     string
+	ItemID      int `validate:"required"`
 	SearchAfter int
 }
 
-type FindItemOutput struct {
-	TotalCount  int
-	Items       []domain.Item
+type FindItemHistoryOutput struct {
+	Histories   []domain.ItemHistory
 	HasNext     bool
 	SearchAfter int
 }
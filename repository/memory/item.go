@@ -0,0 +1,504 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/internal/hangul"
+	"github.com/psi59/payhere-assignment/internal/valid"
+	"github.com/psi59/payhere-assignment/repository"
+)
+
+const findItemLimit = 10
+
+type ItemRepository struct {
+	db *DB
+}
+
+func NewItemRepository(db *DB) *ItemRepository {
+	return &ItemRepository{db: db}
+}
+
+func (r *ItemRepository) Create(c context.Context, item *domain.Item) error {
+	// 1. 파라메터 체크
+	switch {
+	case valid.IsNil(c):
+		return domain.ErrNilContext
+	case valid.IsNil(item):
+		return domain.ErrNilItem
+	}
+	if err := item.Validate(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	itemNameChosung, err := hangul.GetChosung(item.Name)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	itemNameLength, err := hangul.NameLength(item.Name)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	defer r.db.lock(c)()
+
+	// 2. 제약 조건 확인
+	if _, exists := r.db.users[item.UserID]; !exists {
+		return fmt.Errorf("foreign key constraint fails: user(%d) doesn't exist", item.UserID)
+	}
+	if _, exists := r.db.items[item.ID]; exists {
+		return fmt.Errorf("%w: duplicate item_id %d", domain.ErrItemAlreadyExists, item.ID)
+	}
+	if r.existsItemName(item.UserID, item.Name, item.ID) {
+		return fmt.Errorf("%w: duplicate item_name %q", domain.ErrItemAlreadyExists, item.Name)
+	}
+
+	// 3. 아이템 생성
+	record := Item{
+		ItemID:          item.ID,
+		UserID:          item.UserID,
+		Category:        item.Category,
+		ItemName:        item.Name,
+		ItemNameChosung: itemNameChosung,
+		ItemNameLength:  &itemNameLength,
+		Price:           item.Price,
+		Cost:            item.Cost,
+		Description:     item.Description,
+		Barcode:         item.Barcode,
+		ItemSize:        item.Size,
+		ExpiryAt:        item.ExpiryAt,
+		CreatedAt:       item.CreatedAt,
+		Version:         max(item.Version, 1),
+	}
+	if record.ItemID == 0 {
+		r.db.lastItemID++
+		record.ItemID = r.db.lastItemID
+	} else if record.ItemID > r.db.lastItemID {
+		r.db.lastItemID = record.ItemID
+	}
+	r.db.items[record.ItemID] = record
+	item.ID = record.ItemID
+	item.Version = record.Version
+
+	// 4. 결과 반환
+
+	return nil
+}
+
+func (r *ItemRepository) Get(c context.Context, userID, itemID int) (*domain.Item, error) {
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case userID < 1:
+		return nil, fmt.Errorf("invalid userID: %d", userID)
+	case itemID < 1:
+		return nil, fmt.Errorf("invalid itemID: %d", itemID)
+	}
+
+	defer r.db.rlock(c)()
+
+	record, exists := r.db.items[itemID]
+	if !exists || record.UserID != userID || record.DeletedAt != nil {
+		return nil, errors.WithStack(domain.ErrItemNotFound)
+	}
+
+	return record.Domain(), nil
+}
+
+func (r *ItemRepository) Delete(c context.Context, userID, itemID, version int) error {
+	switch {
+	case valid.IsNil(c):
+		return domain.ErrNilContext
+	case userID < 1:
+		return fmt.Errorf("invalid userID: %d", userID)
+	case itemID < 1:
+		return fmt.Errorf("invalid itemID: %d", itemID)
+	case version < 0:
+		return fmt.Errorf("invalid version: %d", version)
+	}
+
+	defer r.db.lock(c)()
+
+	record, exists := r.db.items[itemID]
+	if !exists || record.UserID != userID || record.DeletedAt != nil {
+		return errors.WithStack(domain.ErrItemNotFound)
+	}
+	if !record.matchVersion(version) {
+		return errors.WithStack(domain.ErrItemVersionMismatch)
+	}
+	deletedAt := time.Now()
+	record.DeletedAt = &deletedAt
+	record.Version++
+	r.db.items[itemID] = record
+
+	return nil
+}
+
+func (r *ItemRepository) Restore(c context.Context, userID, itemID int) error {
+	switch {
+	case valid.IsNil(c):
+		return domain.ErrNilContext
+	case userID < 1:
+		return fmt.Errorf("invalid userID: %d", userID)
+	case itemID < 1:
+		return fmt.Errorf("invalid itemID: %d", itemID)
+	}
+
+	defer r.db.lock(c)()
+
+	record, exists := r.db.items[itemID]
+	if !exists || record.UserID != userID || record.DeletedAt == nil {
+		return errors.WithStack(domain.ErrItemNotFound)
+	}
+	if r.existsItemName(userID, record.ItemName, itemID) {
+		return fmt.Errorf("%w: duplicate item_name %q", domain.ErrItemAlreadyExists, record.ItemName)
+	}
+	record.DeletedAt = nil
+	record.Version++
+	r.db.items[itemID] = record
+
+	return nil
+}
+
+func (r *ItemRepository) Purge(c context.Context, userID, itemID, version int) error {
+	switch {
+	case valid.IsNil(c):
+		return domain.ErrNilContext
+	case userID < 1:
+		return fmt.Errorf("invalid userID: %d", userID)
+	case itemID < 1:
+		return fmt.Errorf("invalid itemID: %d", itemID)
+	case version < 0:
+		return fmt.Errorf("invalid version: %d", version)
+	}
+
+	defer r.db.lock(c)()
+
+	record, exists := r.db.items[itemID]
+	if !exists || record.UserID != userID {
+		return errors.WithStack(domain.ErrItemNotFound)
+	}
+	if !record.matchVersion(version) {
+		return errors.WithStack(domain.ErrItemVersionMismatch)
+	}
+	delete(r.db.items, itemID)
+
+	return nil
+}
+
+func (r *ItemRepository) PurgeDeleted(c context.Context, deletedBefore time.Time) (int, error) {
+	switch {
+	case valid.IsNil(c):
+		return 0, domain.ErrNilContext
+	case deletedBefore.IsZero():
+		return 0, fmt.Errorf("zero deletedBefore")
+	}
+
+	defer r.db.lock(c)()
+
+	var purged int
+	for itemID, record := range r.db.items {
+		if record.DeletedAt != nil && record.DeletedAt.Before(deletedBefore) {
+			delete(r.db.items, itemID)
+			purged++
+		}
+	}
+
+	return purged, nil
+}
+
+func (r *ItemRepository) Update(c context.Context, userID, itemID int, input *repository.UpdateItemInput) error {
+	switch {
+	case valid.IsNil(c):
+		return domain.ErrNilContext
+	case userID < 1:
+		return fmt.Errorf("invalid userID: %d", userID)
+	case itemID < 1:
+		return fmt.Errorf("invalid itemID: %d", itemID)
+	case valid.IsNil(input):
+		return domain.ErrNilInput
+	}
+	if err := input.Validate(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	defer r.db.lock(c)()
+
+	record, exists := r.db.items[itemID]
+	if !exists || record.UserID != userID || record.DeletedAt != nil {
+		return errors.WithStack(domain.ErrItemNotFound)
+	}
+	if !record.matchVersion(input.Version) {
+		return errors.WithStack(domain.ErrItemVersionMismatch)
+	}
+	if !valid.IsNil(input.Name) && r.existsItemName(userID, *input.Name, itemID) {
+		return fmt.Errorf("%w: duplicate item_name %q", domain.ErrItemAlreadyExists, *input.Name)
+	}
+	if err := record.apply(input); err != nil {
+		return errors.WithStack(err)
+	}
+	record.Version++
+	r.db.items[itemID] = record
+
+	return nil
+}
+
+func (r *ItemRepository) Find(c context.Context, input *repository.FindItemInput) (*repository.FindItemOutput, error) {
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case valid.IsNil(input):
+		return nil, domain.ErrNilInput
+	}
+	if err := input.Validate(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	defer r.db.rlock(c)()
+
+	matched := make([]domain.Item, 0)
+	for _, record := range r.db.items {
+		if record.UserID == input.UserID && record.DeletedAt == nil && record.matchKeywords(input.Keywords) &&
+			record.matchNameLength(input.MinNameLength, input.MaxNameLength) && input.Filter.Match(record.Domain()) {
+			matched = append(matched, *record.Domain())
+		}
+	}
+	slices.SortFunc(matched, func(a, b domain.Item) int {
+		return input.Sort.Compare(&a, &b)
+	})
+
+	output := findPage(matched, input.Sort, input.Cursor, input.Limit)
+	if input.WithTotal {
+		output.TotalCount = len(matched)
+	}
+	if input.WithFacets {
+		counter := domain.NewItemFacetCounter()
+		for i := range matched {
+			counter.Add(&matched[i])
+		}
+		output.Facets = counter.Facets()
+	}
+
+	return output, nil
+}
+
+func (r *ItemRepository) FindDeleted(c context.Context, input *repository.FindDeletedItemInput) (*repository.FindItemOutput, error) {
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case valid.IsNil(input):
+		return nil, domain.ErrNilInput
+	}
+	if err := input.Validate(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	defer r.db.rlock(c)()
+
+	matched := make([]domain.Item, 0)
+	for _, record := range r.db.items {
+		if record.UserID == input.UserID && record.DeletedAt != nil {
+			matched = append(matched, *record.Domain())
+		}
+	}
+	// 정렬 기준이 없으면 아이템 ID 순으로 정렬합니다.
+	var order domain.ItemSortOrder
+	slices.SortFunc(matched, func(a, b domain.Item) int {
+		return order.Compare(&a, &b)
+	})
+
+	output := findPage(matched, order, input.Cursor, input.Limit)
+	if input.WithTotal {
+		output.TotalCount = len(matched)
+	}
+
+	return output, nil
+}
+
+func (r *ItemRepository) GetStamp(c context.Context, userID int) (*repository.ItemStamp, error) {
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case userID < 1:
+		return nil, fmt.Errorf("invalid userID: %d", userID)
+	}
+
+	defer r.db.rlock(c)()
+
+	var stamp repository.ItemStamp
+	for _, record := range r.db.items {
+		if record.UserID == userID {
+			stamp.Count++
+			stamp.MaxItemID = max(stamp.MaxItemID, record.ItemID)
+			stamp.VersionSum += record.Version
+		}
+	}
+
+	return &stamp, nil
+}
+
+// findPage order로 정렬된 matched에서 cursor 다음 페이지를 limit개까지 반환하고, 이전, 다음 페이지 존재 여부를 설정합니다.
+func findPage(matched []domain.Item, order domain.ItemSortOrder, cursor *domain.ItemCursor, limit int) *repository.FindItemOutput {
+	if limit == 0 {
+		limit = findItemLimit
+	}
+
+	// [start, end) 범위가 조회할 페이지입니다.
+	start, end := 0, min(len(matched), limit)
+	if cursor != nil {
+		// 정렬 순서는 아이템 ID까지 비교하므로 position 앞의 아이템은 모두 커서보다 앞에 있습니다.
+		position, found := slices.BinarySearchFunc(matched, cursor.Item(), func(item domain.Item, target *domain.Item) int {
+			return order.Compare(&item, target)
+		})
+		if cursor.Backward {
+			end = position
+			start = max(0, end-limit)
+		} else {
+			start = position
+			if found {
+				start++
+			}
+			end = min(len(matched), start+limit)
+		}
+	}
+
+	items := slices.Clone(matched[start:end])
+
+	return &repository.FindItemOutput{
+		Items:   items,
+		HasNext: len(items) > 0 && end < len(matched),
+		HasPrev: len(items) > 0 && start > 0,
+	}
+}
+
+// existsItemName 유저의 삭제되지 않은 아이템 중 excludeItemID를 제외하고 같은 이름의 아이템이 존재하는지 확인합니다.
+// uidx_user_id_item_name 유니크 키와 동일하게 대소문자를 구분하지 않습니다.
+func (r *ItemRepository) existsItemName(userID int, name string, excludeItemID int) bool {
+	for _, record := range r.db.items {
+		if record.ItemID == excludeItemID || record.UserID != userID || record.DeletedAt != nil {
+			continue
+		}
+		if strings.EqualFold(record.ItemName, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type Item struct {
+	ItemID          int
+	UserID          int
+	Category        string
+	ItemName        string
+	ItemNameChosung string
+	// ItemNameLength 정규화한 이름의 자모 수이며, nil이면 자모 수를 저장하기 전에 등록된 아이템입니다.
+	ItemNameLength *int
+	Price          int
+	Cost           int
+	Description    string
+	Barcode        string
+	ItemSize       domain.ItemSize
+	CreatedAt      time.Time
+	ExpiryAt       time.Time
+	Version        int
+	DeletedAt      *time.Time
+}
+
+func (i *Item) Domain() *domain.Item {
+	return &domain.Item{
+		ID:          i.ItemID,
+		UserID:      i.UserID,
+		Name:        i.ItemName,
+		Description: i.Description,
+		Price:       i.Price,
+		Cost:        i.Cost,
+		Category:    i.Category,
+		Barcode:     i.Barcode,
+		ExpiryAt:    i.ExpiryAt,
+		Size:        i.ItemSize,
+		CreatedAt:   i.CreatedAt,
+		Version:     i.Version,
+		DeletedAt:   i.DeletedAt,
+	}
+}
+
+// matchKeywords 키워드가 없거나, 아이템 이름 또는 이름의 초성에 키워드 중 하나 이상이 포함되어 있는지 확인합니다.
+// MySQL ngram FULLTEXT 인덱스의 구문 검색과 동일하게 대소문자를 구분하지 않습니다.
+func (i *Item) matchKeywords(keywords []string) bool {
+	if len(keywords) == 0 {
+		return true
+	}
+	name, chosung := strings.ToLower(i.ItemName), strings.ToLower(i.ItemNameChosung)
+	for _, keyword := range keywords {
+		k := strings.ToLower(keyword)
+		if strings.Contains(name, k) || strings.Contains(chosung, k) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchNameLength 이름의 자모 수가 범위 안인지 확인하며, 자모 수가 없는 아이템은 범위와 관계없이 조회합니다.
+func (i *Item) matchNameLength(minLength, maxLength *int) bool {
+	switch {
+	case i.ItemNameLength == nil:
+		return true
+	case minLength != nil && *i.ItemNameLength < *minLength:
+		return false
+	case maxLength != nil && *i.ItemNameLength > *maxLength:
+		return false
+	}
+
+	return true
+}
+
+// matchVersion version이 0인 경우 버전을 확인하지 않습니다.
+func (i *Item) matchVersion(version int) bool {
+	return version == 0 || i.Version == version
+}
+
+func (i *Item) apply(input *repository.UpdateItemInput) error {
+	if !valid.IsNil(input.Name) {
+		i.ItemName = *input.Name
+		c, err := hangul.GetChosung(i.ItemName)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		i.ItemNameChosung = c
+		length, err := hangul.NameLength(i.ItemName)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		i.ItemNameLength = &length
+	}
+	if !valid.IsNil(input.Description) {
+		i.Description = *input.Description
+	}
+	if !valid.IsNil(input.Price) {
+		i.Price = *input.Price
+	}
+	if !valid.IsNil(input.Cost) {
+		i.Cost = *input.Cost
+	}
+	if !valid.IsNil(input.Category) {
+		i.Category = *input.Category
+	}
+	if !valid.IsNil(input.Barcode) {
+		i.Barcode = *input.Barcode
+	}
+	if !valid.IsNil(input.Size) {
+		i.ItemSize = *input.Size
+	}
+	if !valid.IsNil(input.ExpiryAt) {
+		i.ExpiryAt = *input.ExpiryAt
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/internal/valid"
+	"github.com/psi59/payhere-assignment/repository"
+)
+
+const itemHistoryPageSize = 10
+
+type ItemHistoryRepository struct {
+	db *DB
+}
+
+func NewItemHistoryRepository(db *DB) *ItemHistoryRepository {
+	return &ItemHistoryRepository{db: db}
+}
+
+func (r *ItemHistoryRepository) Create(c context.Context, history *domain.ItemHistory) error {
+	// 1. 파라메터 체크
+	switch {
+	case valid.IsNil(c):
+		return domain.ErrNilContext
+	case valid.IsNil(history):
+		return domain.ErrNilItemHistory
+	}
+	if err := history.Validate(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	defer r.db.lock(c)()
+
+	// 2. 이력 생성, ID가 증가하는 순서로 저장되므로 슬라이스는 항상 정렬되어 있습니다.
+	r.db.lastItemHistoryID++
+	history.ID = r.db.lastItemHistoryID
+	changes := make([]domain.ItemFieldChange, len(history.Changes))
+	copy(changes, history.Changes)
+	r.db.itemHistories = append(r.db.itemHistories, ItemHistory{
+		HistoryID: history.ID,
+		ItemID:    history.ItemID,
+		UserID:    history.UserID,
+		ActorID:   history.ActorID,
+		RequestID: history.RequestID,
+		Action:    history.Action,
+		Changes:   changes,
+		CreatedAt: history.CreatedAt,
+	})
+
+	// 3. 결과 반환
+	return nil
+}
+
+func (r *ItemHistoryRepository) Find(c context.Context, input *repository.FindItemHistoryInput) (*repository.FindItemHistoryOutput, error) {
+	// 1. 파라메터 체크
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case valid.IsNil(input):
+		return nil, domain.ErrNilInput
+	}
+	if err := valid.ValidateStruct(input); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	defer r.db.rlock(c)()
+
+	// 2. 다음 페이지 여부를 확인하기 위해 한 건을 더 조회
+	rows := make([]ItemHistory, 0, itemHistoryPageSize+1)
+	for _, record := range r.db.itemHistories {
+		if record.UserID != input.UserID || record.ItemID != input.ItemID || record.HistoryID <= input.SearchAfter {
+			continue
+		}
+		rows = append(rows, record)
+		if len(rows) > itemHistoryPageSize {
+			break
+		}
+	}
+	hasNext := len(rows) > itemHistoryPageSize
+	if hasNext {
+		rows = rows[:itemHistoryPageSize]
+	}
+
+	// 3. 결과 반환
+	histories := make([]domain.ItemHistory, len(rows))
+	var nextSearchAfter int
+	for i := 0; i < len(rows); i++ {
+		histories[i] = *rows[i].Domain()
+	}
+	if len(rows) > 0 {
+		nextSearchAfter = rows[len(rows)-1].HistoryID
+	}
+
+	return &repository.FindItemHistoryOutput{
+		Histories:   histories,
+		HasNext:     hasNext,
+		SearchAfter: nextSearchAfter,
+	}, nil
+}
+
+type ItemHistory struct {
+	HistoryID int
+	ItemID    int
+	UserID    int
+	ActorID   int
+	RequestID string
+	Action    domain.ItemHistoryAction
+	Changes   []domain.ItemFieldChange
+	CreatedAt time.Time
+}
+
+func (h *ItemHistory) Domain() *domain.ItemHistory {
+	changes := make([]domain.ItemFieldChange, len(h.Changes))
+	copy(changes, h.Changes)
+
+	return &domain.ItemHistory{
+		ID:        h.HistoryID,
+		ItemID:    h.ItemID,
+		UserID:    h.UserID,
+		ActorID:   h.ActorID,
+		RequestID: h.RequestID,
+		Action:    h.Action,
+		Changes:   changes,
+		CreatedAt: h.CreatedAt,
+	}
+}
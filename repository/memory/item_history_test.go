@@ -0,0 +1,143 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/repository"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemHistoryRepository_Create(t *testing.T) {
+	ctx := context.TODO()
+	memDB := NewDB()
+	userRepo := NewUserRepository(memDB)
+	user := newTestUser(t)
+	err := userRepo.Create(ctx, user)
+	require.NoError(t, err)
+	itemRepo := NewItemRepository(memDB)
+	historyRepo := NewItemHistoryRepository(memDB)
+	item := newTestItem(t, user.ID)
+	err = itemRepo.Create(ctx, item)
+	require.NoError(t, err)
+
+	t.Run("OK", func(t *testing.T) {
+		history := newTestItemHistory(t, item)
+		err := historyRepo.Create(ctx, history)
+		assert.NoError(t, err)
+		assert.True(t, history.ID > 0)
+	})
+
+	t.Run("nil context", func(t *testing.T) {
+		history := newTestItemHistory(t, item)
+		err := historyRepo.Create(nil, history)
+		assert.Error(t, err)
+	})
+
+	t.Run("nil history", func(t *testing.T) {
+		err := historyRepo.Create(ctx, nil)
+		assert.Error(t, err)
+	})
+	t.Run("invalid history", func(t *testing.T) {
+		history := newTestItemHistory(t, item)
+		history.Action = "unknown"
+		err := historyRepo.Create(ctx, history)
+		assert.Error(t, err)
+	})
+}
+
+func TestItemHistoryRepository_Find(t *testing.T) {
+	ctx := context.TODO()
+	memDB := NewDB()
+	userRepo := NewUserRepository(memDB)
+	user := newTestUser(t)
+	err := userRepo.Create(ctx, user)
+	require.NoError(t, err)
+	itemRepo := NewItemRepository(memDB)
+	historyRepo := NewItemHistoryRepository(memDB)
+	item := newTestItem(t, user.ID)
+	err = itemRepo.Create(ctx, item)
+	require.NoError(t, err)
+	otherItem := newTestItem(t, user.ID)
+	err = itemRepo.Create(ctx, otherItem)
+	require.NoError(t, err)
+
+	histories := make([]*domain.ItemHistory, 15)
+	for i := range histories {
+		histories[i] = newTestItemHistory(t, item)
+		err := historyRepo.Create(ctx, histories[i])
+		require.NoError(t, err)
+		err = historyRepo.Create(ctx, newTestItemHistory(t, otherItem))
+		require.NoError(t, err)
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		output, err := historyRepo.Find(ctx, &repository.FindItemHistoryInput{
+			UserID: user.ID,
+			ItemID: item.ID,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, output.Histories, 10)
+		assert.True(t, output.HasNext)
+		assert.Equal(t, histories[9].ID, output.SearchAfter)
+		assert.Equal(t, histories[0].ID, output.Histories[0].ID)
+		assert.Equal(t, histories[0].RequestID, output.Histories[0].RequestID)
+		assert.Equal(t, histories[0].Changes, output.Histories[0].Changes)
+
+		output, err = historyRepo.Find(ctx, &repository.FindItemHistoryInput{
+			UserID:      user.ID,
+			ItemID:      item.ID,
+			SearchAfter: output.SearchAfter,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, output.Histories, 5)
+		assert.False(t, output.HasNext)
+		assert.Equal(t, histories[14].ID, output.SearchAfter)
+	})
+
+	t.Run("다른 사용자의 이력", func(t *testing.T) {
+		output, err := historyRepo.Find(ctx, &repository.FindItemHistoryInput{
+			UserID: user.ID + 1,
+			ItemID: item.ID,
+		})
+		assert.NoError(t, err)
+		assert.Empty(t, output.Histories)
+		assert.False(t, output.HasNext)
+	})
+
+	t.Run("nil context", func(t *testing.T) {
+		_, err := historyRepo.Find(nil, &repository.FindItemHistoryInput{UserID: user.ID, ItemID: item.ID})
+		assert.Error(t, err)
+	})
+
+	t.Run("nil input", func(t *testing.T) {
+		_, err := historyRepo.Find(ctx, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		_, err := historyRepo.Find(ctx, &repository.FindItemHistoryInput{UserID: user.ID})
+		assert.Error(t, err)
+	})
+}
+
+func newTestItemHistory(t *testing.T, item *domain.Item) *domain.ItemHistory {
+	oldPrice, newPrice := "1000", "2000"
+	history, err := domain.NewItemHistory(
+		item.UserID,
+		item.ID,
+		item.UserID,
+		gofakeit.UUID(),
+		domain.ItemHistoryActionUpdate,
+		[]domain.ItemFieldChange{{Field: "price", OldValue: &oldPrice, NewValue: &newPrice}},
+	)
+	require.NoError(t, err)
+	history.CreatedAt = time.Unix(time.Now().Unix(), 0)
+
+	return history
+}
@@ -0,0 +1,120 @@
+package memory
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// DB 메모리 기반 저장소입니다.
+// 로컬 데모와 외부 DB 없이 실행하는 통합 테스트를 위해 사용합니다.
+type DB struct {
+	mu sync.RWMutex
+
+	users          map[int]User
+	items          map[int]Item
+	itemHistories  []ItemHistory
+	tokenBlacklist map[string]AuthToken
+	refreshTokens  map[string]RefreshToken
+	sessions       map[string]Session
+
+	lastUserID        int
+	lastItemID        int
+	lastItemHistoryID int
+}
+
+func NewDB() *DB {
+	return &DB{
+		users:          make(map[int]User),
+		items:          make(map[int]Item),
+		tokenBlacklist: make(map[string]AuthToken),
+		refreshTokens:  make(map[string]RefreshToken),
+		sessions:       make(map[string]Session),
+	}
+}
+
+// Transactor 메모리 저장소의 db.Transactor입니다.
+// 트랜잭션이 끝날 때까지 DB의 잠금을 유지해 다른 요청의 변경과 섞이지 않도록 하며, fn이 에러를 반환하면 트랜잭션을 시작할 때의 스냅샷으로 되돌립니다.
+type Transactor struct {
+	db *DB
+}
+
+func NewTransactor(db *DB) *Transactor {
+	return &Transactor{db: db}
+}
+
+func (t *Transactor) Transaction(c context.Context, fn func(c context.Context) error) error {
+	if !t.db.inTransaction(c) {
+		t.db.mu.Lock()
+		defer t.db.mu.Unlock()
+		c = context.WithValue(c, transactionContextKey{}, t.db)
+	}
+
+	// 중첩된 트랜잭션은 savepoint와 같이 중첩된 트랜잭션의 변경만 되돌립니다.
+	snapshot := t.db.snapshot()
+	if err := fn(c); err != nil {
+		t.db.restore(snapshot)
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+type transactionContextKey struct{}
+
+// inTransaction c가 db의 트랜잭션 안에서 사용하는 컨텍스트인지 확인합니다.
+func (db *DB) inTransaction(c context.Context) bool {
+	tx, _ := c.Value(transactionContextKey{}).(*DB)
+	return tx == db
+}
+
+// lock 쓰기 잠금을 얻고 잠금을 해제하는 함수를 반환합니다.
+// 트랜잭션 안에서는 Transactor가 이미 잠금을 얻었으므로 잠그지 않습니다.
+func (db *DB) lock(c context.Context) (unlock func()) {
+	if db.inTransaction(c) {
+		return func() {}
+	}
+	db.mu.Lock()
+
+	return db.mu.Unlock
+}
+
+// rlock 읽기 잠금을 얻고 잠금을 해제하는 함수를 반환합니다.
+func (db *DB) rlock(c context.Context) (unlock func()) {
+	if db.inTransaction(c) {
+		return func() {}
+	}
+	db.mu.RLock()
+
+	return db.mu.RUnlock
+}
+
+// snapshot 트랜잭션을 되돌리기 위해 저장하는 DB의 복사본이며, 레코드는 값으로 저장하므로 맵과 슬라이스만 복사합니다.
+func (db *DB) snapshot() *DB {
+	return &DB{
+		users:             maps.Clone(db.users),
+		items:             maps.Clone(db.items),
+		itemHistories:     slices.Clip(db.itemHistories),
+		tokenBlacklist:    maps.Clone(db.tokenBlacklist),
+		refreshTokens:     maps.Clone(db.refreshTokens),
+		sessions:          maps.Clone(db.sessions),
+		lastUserID:        db.lastUserID,
+		lastItemID:        db.lastItemID,
+		lastItemHistoryID: db.lastItemHistoryID,
+	}
+}
+
+func (db *DB) restore(snapshot *DB) {
+	db.users = snapshot.users
+	db.items = snapshot.items
+	db.itemHistories = snapshot.itemHistories
+	db.tokenBlacklist = snapshot.tokenBlacklist
+	db.refreshTokens = snapshot.refreshTokens
+	db.sessions = snapshot.sessions
+	db.lastUserID = snapshot.lastUserID
+	db.lastItemID = snapshot.lastItemID
+	db.lastItemHistoryID = snapshot.lastItemHistoryID
+}
@@ -0,0 +1,118 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/repository"
+)
+
+func TestTransactor_Transaction(t *testing.T) {
+	ctx := context.TODO()
+	memDB := NewDB()
+	userRepo := NewUserRepository(memDB)
+	user := newTestUser(t)
+	require.NoError(t, userRepo.Create(ctx, user))
+	itemRepo := NewItemRepository(memDB)
+	itemHistoryRepo := NewItemHistoryRepository(memDB)
+	refreshTokenRepo := NewRefreshTokenRepository(memDB)
+	transactor := NewTransactor(memDB)
+
+	t.Run("OK", func(t *testing.T) {
+		item := newTestItem(t, user.ID)
+		err := transactor.Transaction(ctx, func(c context.Context) error {
+			if err := itemRepo.Create(c, item); err != nil {
+				return err
+			}
+			return itemHistoryRepo.Create(c, newTestItemHistory(t, item))
+		})
+		require.NoError(t, err)
+
+		_, err = itemRepo.Get(ctx, user.ID, item.ID)
+		assert.NoError(t, err)
+		assert.Len(t, memDB.itemHistories, 1)
+	})
+
+	t.Run("에러를 반환하면 되돌림", func(t *testing.T) {
+		token := newTestRefreshToken(xid.New().String())
+		require.NoError(t, refreshTokenRepo.Create(ctx, token))
+		lastItemID := memDB.lastItemID
+
+		item := newTestItem(t, user.ID)
+		wantErr := gofakeit.Error()
+		err := transactor.Transaction(ctx, func(c context.Context) error {
+			if err := itemRepo.Create(c, item); err != nil {
+				return err
+			}
+			if err := itemHistoryRepo.Create(c, newTestItemHistory(t, item)); err != nil {
+				return err
+			}
+			if err := refreshTokenRepo.RevokeFamily(c, token.FamilyID, time.Now()); err != nil {
+				return err
+			}
+			return wantErr
+		})
+		require.ErrorIs(t, err, wantErr)
+
+		_, err = itemRepo.Get(ctx, user.ID, item.ID)
+		assert.ErrorIs(t, err, domain.ErrItemNotFound)
+		assert.Len(t, memDB.itemHistories, 1)
+		assert.Equal(t, lastItemID, memDB.lastItemID)
+		got, err := refreshTokenRepo.Get(ctx, token.TokenHash)
+		require.NoError(t, err)
+		assert.Nil(t, got.RevokedAt)
+	})
+
+	t.Run("중첩된 트랜잭션", func(t *testing.T) {
+		outer, inner := newTestItem(t, user.ID), newTestItem(t, user.ID)
+		err := transactor.Transaction(ctx, func(c context.Context) error {
+			if err := itemRepo.Create(c, outer); err != nil {
+				return err
+			}
+			// 중첩된 트랜잭션의 변경만 되돌립니다.
+			err := transactor.Transaction(c, func(c context.Context) error {
+				if err := itemRepo.Create(c, inner); err != nil {
+					return err
+				}
+				return gofakeit.Error()
+			})
+			assert.Error(t, err)
+			return nil
+		})
+		require.NoError(t, err)
+
+		_, err = itemRepo.Get(ctx, user.ID, outer.ID)
+		assert.NoError(t, err)
+		_, err = itemRepo.Get(ctx, user.ID, inner.ID)
+		assert.ErrorIs(t, err, domain.ErrItemNotFound)
+	})
+
+	t.Run("트랜잭션이 끝날 때까지 다른 요청을 대기", func(t *testing.T) {
+		started := make(chan struct{})
+		stamps := make(chan *repository.ItemStamp, 1)
+		go func() {
+			<-started
+			stamp, err := itemRepo.GetStamp(ctx, user.ID)
+			assert.NoError(t, err)
+			stamps <- stamp
+		}()
+		err := transactor.Transaction(ctx, func(c context.Context) error {
+			close(started)
+			time.Sleep(50 * time.Millisecond)
+			return itemRepo.Create(c, newTestItem(t, user.ID))
+		})
+		require.NoError(t, err)
+
+		// 트랜잭션이 끝난 뒤에 조회하므로 트랜잭션에서 생성한 아이템이 반영되어 있습니다.
+		want, err := itemRepo.GetStamp(ctx, user.ID)
+		require.NoError(t, err)
+		assert.Equal(t, want, <-stamps)
+	})
+}
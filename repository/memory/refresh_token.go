@@ -0,0 +1,180 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/internal/valid"
+)
+
+type RefreshTokenRepository struct {
+	db *DB
+}
+
+func NewRefreshTokenRepository(db *DB) *RefreshTokenRepository {
+	return &RefreshTokenRepository{db: db}
+}
+
+func (r *RefreshTokenRepository) Create(c context.Context, token *domain.RefreshToken) error {
+	if valid.IsNil(c) {
+		return domain.ErrNilContext
+	}
+	if valid.IsNil(token) {
+		return domain.ErrNilRefreshToken
+	}
+	if err := token.Validate(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	defer r.db.lock(c)()
+
+	if _, exists := r.db.refreshTokens[token.TokenHash]; exists {
+		return fmt.Errorf("duplicate tokenHash: %q", token.TokenHash)
+	}
+	r.db.refreshTokens[token.TokenHash] = RefreshToken{
+		TokenHash:  token.TokenHash,
+		FamilyID:   token.FamilyID,
+		Identifier: token.Identifier,
+		ExpiresAt:  token.ExpiresAt,
+		CreatedAt:  token.CreatedAt,
+		RotatedAt:  token.RotatedAt,
+		RevokedAt:  token.RevokedAt,
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenRepository) Get(c context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	if valid.IsNil(c) {
+		return nil, domain.ErrNilContext
+	}
+	if len(tokenHash) == 0 {
+		return nil, fmt.Errorf("empty tokenHash")
+	}
+
+	defer r.db.rlock(c)()
+
+	record, exists := r.db.refreshTokens[tokenHash]
+	if !exists {
+		return nil, errors.WithStack(domain.ErrRefreshTokenNotFound)
+	}
+
+	return record.Domain(), nil
+}
+
+func (r *RefreshTokenRepository) Rotate(c context.Context, tokenHash string, rotatedAt time.Time) error {
+	switch {
+	case valid.IsNil(c):
+		return domain.ErrNilContext
+	case len(tokenHash) == 0:
+		return fmt.Errorf("empty tokenHash")
+	case rotatedAt.IsZero():
+		return fmt.Errorf("zero rotatedAt")
+	}
+
+	defer r.db.lock(c)()
+
+	record, exists := r.db.refreshTokens[tokenHash]
+	if !exists {
+		return errors.WithStack(domain.ErrRefreshTokenNotFound)
+	}
+	if record.RotatedAt != nil || record.RevokedAt != nil {
+		return errors.WithStack(domain.ErrRefreshTokenAlreadyRotated)
+	}
+	record.RotatedAt = &rotatedAt
+	r.db.refreshTokens[tokenHash] = record
+
+	return nil
+}
+
+func (r *RefreshTokenRepository) RevokeFamily(c context.Context, familyID string, revokedAt time.Time) error {
+	switch {
+	case valid.IsNil(c):
+		return domain.ErrNilContext
+	case len(familyID) == 0:
+		return fmt.Errorf("empty familyID")
+	case revokedAt.IsZero():
+		return fmt.Errorf("zero revokedAt")
+	}
+
+	defer r.db.lock(c)()
+
+	for tokenHash, record := range r.db.refreshTokens {
+		if record.FamilyID != familyID || record.RevokedAt != nil {
+			continue
+		}
+		record.RevokedAt = &revokedAt
+		r.db.refreshTokens[tokenHash] = record
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenRepository) RevokeAll(c context.Context, identifier string, revokedAt time.Time) error {
+	switch {
+	case valid.IsNil(c):
+		return domain.ErrNilContext
+	case len(identifier) == 0:
+		return fmt.Errorf("empty identifier")
+	case revokedAt.IsZero():
+		return fmt.Errorf("zero revokedAt")
+	}
+
+	defer r.db.lock(c)()
+
+	for tokenHash, record := range r.db.refreshTokens {
+		if record.Identifier != identifier || record.RevokedAt != nil {
+			continue
+		}
+		record.RevokedAt = &revokedAt
+		r.db.refreshTokens[tokenHash] = record
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenRepository) DeleteExpired(c context.Context, before time.Time) (int, error) {
+	switch {
+	case valid.IsNil(c):
+		return 0, domain.ErrNilContext
+	case before.IsZero():
+		return 0, fmt.Errorf("zero before")
+	}
+
+	defer r.db.lock(c)()
+
+	var deletedCount int
+	for tokenHash, record := range r.db.refreshTokens {
+		if record.ExpiresAt.Before(before) {
+			delete(r.db.refreshTokens, tokenHash)
+			deletedCount++
+		}
+	}
+
+	return deletedCount, nil
+}
+
+type RefreshToken struct {
+	TokenHash  string
+	FamilyID   string
+	Identifier string
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+	RotatedAt  *time.Time
+	RevokedAt  *time.Time
+}
+
+func (t *RefreshToken) Domain() *domain.RefreshToken {
+	return &domain.RefreshToken{
+		TokenHash:  t.TokenHash,
+		FamilyID:   t.FamilyID,
+		Identifier: t.Identifier,
+		ExpiresAt:  t.ExpiresAt,
+		CreatedAt:  t.CreatedAt,
+		RotatedAt:  t.RotatedAt,
+		RevokedAt:  t.RevokedAt,
+	}
+}
@@ -0,0 +1,249 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/psi59/payhere-assignment/domain"
+)
+
+func TestRefreshTokenRepository_Create(t *testing.T) {
+	ctx := context.TODO()
+	memDB := NewDB()
+	repo := NewRefreshTokenRepository(memDB)
+
+	t.Run("OK", func(t *testing.T) {
+		token := newTestRefreshToken(xid.New().String())
+		err := repo.Create(ctx, token)
+		require.NoError(t, err)
+	})
+
+	t.Run("nil Context", func(t *testing.T) {
+		err := repo.Create(nil, newTestRefreshToken(xid.New().String()))
+		require.Error(t, err)
+	})
+
+	t.Run("nil token", func(t *testing.T) {
+		err := repo.Create(ctx, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		err := repo.Create(ctx, &domain.RefreshToken{})
+		require.Error(t, err)
+	})
+}
+
+func TestRefreshTokenRepository_Get(t *testing.T) {
+	ctx := context.TODO()
+	memDB := NewDB()
+	repo := NewRefreshTokenRepository(memDB)
+
+	token := newTestRefreshToken(xid.New().String())
+	err := repo.Create(ctx, token)
+	require.NoError(t, err)
+
+	t.Run("OK", func(t *testing.T) {
+		got, err := repo.Get(ctx, token.TokenHash)
+		require.NoError(t, err)
+		require.Equal(t, token, got)
+	})
+
+	t.Run("token not exists", func(t *testing.T) {
+		got, err := repo.Get(ctx, gofakeit.LetterN(64))
+		require.ErrorIs(t, err, domain.ErrRefreshTokenNotFound)
+		require.Nil(t, got)
+	})
+
+	t.Run("nil Context", func(t *testing.T) {
+		got, err := repo.Get(nil, token.TokenHash)
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+
+	t.Run("empty tokenHash", func(t *testing.T) {
+		got, err := repo.Get(ctx, "")
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+}
+
+func TestRefreshTokenRepository_Rotate(t *testing.T) {
+	ctx := context.TODO()
+	memDB := NewDB()
+	repo := NewRefreshTokenRepository(memDB)
+	rotatedAt := time.Unix(time.Now().Unix(), 0).UTC()
+
+	t.Run("OK", func(t *testing.T) {
+		token := newTestRefreshToken(xid.New().String())
+		err := repo.Create(ctx, token)
+		require.NoError(t, err)
+
+		err = repo.Rotate(ctx, token.TokenHash, rotatedAt)
+		require.NoError(t, err)
+		got, err := repo.Get(ctx, token.TokenHash)
+		require.NoError(t, err)
+		require.Equal(t, &rotatedAt, got.RotatedAt)
+
+		// 이미 재발급에 사용된 토큰
+		err = repo.Rotate(ctx, token.TokenHash, rotatedAt)
+		require.ErrorIs(t, err, domain.ErrRefreshTokenAlreadyRotated)
+	})
+
+	t.Run("폐기된 토큰", func(t *testing.T) {
+		token := newTestRefreshToken(xid.New().String())
+		err := repo.Create(ctx, token)
+		require.NoError(t, err)
+		err = repo.RevokeFamily(ctx, token.FamilyID, rotatedAt)
+		require.NoError(t, err)
+
+		err = repo.Rotate(ctx, token.TokenHash, rotatedAt)
+		require.ErrorIs(t, err, domain.ErrRefreshTokenAlreadyRotated)
+	})
+
+	t.Run("token not exists", func(t *testing.T) {
+		err := repo.Rotate(ctx, gofakeit.LetterN(64), rotatedAt)
+		require.ErrorIs(t, err, domain.ErrRefreshTokenNotFound)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		err := repo.Rotate(nil, gofakeit.LetterN(64), rotatedAt)
+		require.Error(t, err)
+		err = repo.Rotate(ctx, "", rotatedAt)
+		require.Error(t, err)
+		err = repo.Rotate(ctx, gofakeit.LetterN(64), time.Time{})
+		require.Error(t, err)
+	})
+}
+
+func TestRefreshTokenRepository_RevokeFamily(t *testing.T) {
+	ctx := context.TODO()
+	memDB := NewDB()
+	repo := NewRefreshTokenRepository(memDB)
+	revokedAt := time.Unix(time.Now().Unix(), 0).UTC()
+
+	t.Run("OK", func(t *testing.T) {
+		familyID := xid.New().String()
+		tokens := []*domain.RefreshToken{newTestRefreshToken(familyID), newTestRefreshToken(familyID)}
+		other := newTestRefreshToken(xid.New().String())
+		for _, token := range append(tokens, other) {
+			err := repo.Create(ctx, token)
+			require.NoError(t, err)
+		}
+
+		err := repo.RevokeFamily(ctx, familyID, revokedAt)
+		require.NoError(t, err)
+		for _, token := range tokens {
+			got, err := repo.Get(ctx, token.TokenHash)
+			require.NoError(t, err)
+			require.Equal(t, &revokedAt, got.RevokedAt)
+		}
+		got, err := repo.Get(ctx, other.TokenHash)
+		require.NoError(t, err)
+		require.Nil(t, got.RevokedAt)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		err := repo.RevokeFamily(nil, xid.New().String(), revokedAt)
+		require.Error(t, err)
+		err = repo.RevokeFamily(ctx, "", revokedAt)
+		require.Error(t, err)
+		err = repo.RevokeFamily(ctx, xid.New().String(), time.Time{})
+		require.Error(t, err)
+	})
+}
+
+func TestRefreshTokenRepository_RevokeAll(t *testing.T) {
+	ctx := context.TODO()
+	memDB := NewDB()
+	repo := NewRefreshTokenRepository(memDB)
+	revokedAt := time.Unix(time.Now().Unix(), 0).UTC()
+
+	t.Run("OK", func(t *testing.T) {
+		identifier := xid.New().String()
+		tokens := []*domain.RefreshToken{newTestRefreshToken(xid.New().String()), newTestRefreshToken(xid.New().String())}
+		other := newTestRefreshToken(xid.New().String())
+		for _, token := range tokens {
+			token.Identifier = identifier
+		}
+		for _, token := range append(tokens, other) {
+			err := repo.Create(ctx, token)
+			require.NoError(t, err)
+		}
+
+		err := repo.RevokeAll(ctx, identifier, revokedAt)
+		require.NoError(t, err)
+		for _, token := range tokens {
+			got, err := repo.Get(ctx, token.TokenHash)
+			require.NoError(t, err)
+			require.Equal(t, &revokedAt, got.RevokedAt)
+		}
+		got, err := repo.Get(ctx, other.TokenHash)
+		require.NoError(t, err)
+		require.Nil(t, got.RevokedAt)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		err := repo.RevokeAll(nil, gofakeit.Numerify("###"), revokedAt)
+		require.Error(t, err)
+		err = repo.RevokeAll(ctx, "", revokedAt)
+		require.Error(t, err)
+		err = repo.RevokeAll(ctx, gofakeit.Numerify("###"), time.Time{})
+		require.Error(t, err)
+	})
+}
+
+func TestRefreshTokenRepository_DeleteExpired(t *testing.T) {
+	ctx := context.TODO()
+	memDB := NewDB()
+	repo := NewRefreshTokenRepository(memDB)
+
+	t.Run("OK", func(t *testing.T) {
+		now := time.Now()
+		expiredToken := newTestRefreshToken(xid.New().String())
+		expiredToken.ExpiresAt = now.Add(-time.Hour).Truncate(time.Second).UTC()
+		err := repo.Create(ctx, expiredToken)
+		require.NoError(t, err)
+		activeToken := newTestRefreshToken(xid.New().String())
+		err = repo.Create(ctx, activeToken)
+		require.NoError(t, err)
+
+		deletedCount, err := repo.DeleteExpired(ctx, now)
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, deletedCount, 1)
+
+		_, err = repo.Get(ctx, expiredToken.TokenHash)
+		require.ErrorIs(t, err, domain.ErrRefreshTokenNotFound)
+		got, err := repo.Get(ctx, activeToken.TokenHash)
+		require.NoError(t, err)
+		require.Equal(t, activeToken, got)
+	})
+
+	t.Run("nil Context", func(t *testing.T) {
+		deletedCount, err := repo.DeleteExpired(nil, time.Now())
+		require.Error(t, err)
+		require.Zero(t, deletedCount)
+	})
+
+	t.Run("zero before", func(t *testing.T) {
+		deletedCount, err := repo.DeleteExpired(ctx, time.Time{})
+		require.Error(t, err)
+		require.Zero(t, deletedCount)
+	})
+}
+
+func newTestRefreshToken(familyID string) *domain.RefreshToken {
+	now := time.Unix(time.Now().Unix(), 0).UTC()
+	return &domain.RefreshToken{
+		TokenHash:  gofakeit.LetterN(64),
+		FamilyID:   familyID,
+		Identifier: gofakeit.Numerify("###"),
+		ExpiresAt:  now.Add(time.Hour),
+		CreatedAt:  now,
+	}
+}
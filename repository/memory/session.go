@@ -0,0 +1,191 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/internal/valid"
+)
+
+type SessionRepository struct {
+	db *DB
+}
+
+func NewSessionRepository(db *DB) *SessionRepository {
+	return &SessionRepository{db: db}
+}
+
+func (r *SessionRepository) Create(c context.Context, session *domain.Session) error {
+	if valid.IsNil(c) {
+		return domain.ErrNilContext
+	}
+	if valid.IsNil(session) {
+		return domain.ErrNilSession
+	}
+	if err := session.Validate(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	defer r.db.lock(c)()
+
+	if _, exists := r.db.sessions[session.ID]; exists {
+		return fmt.Errorf("duplicate sessionID: %q", session.ID)
+	}
+	r.db.sessions[session.ID] = Session{
+		ID:         session.ID,
+		FamilyID:   session.FamilyID,
+		Identifier: session.Identifier,
+		IP:         session.IP,
+		UserAgent:  session.UserAgent,
+		CreatedAt:  session.CreatedAt,
+		ExpiresAt:  session.ExpiresAt,
+		RevokedAt:  session.RevokedAt,
+	}
+
+	return nil
+}
+
+func (r *SessionRepository) Get(c context.Context, sessionID string) (*domain.Session, error) {
+	if valid.IsNil(c) {
+		return nil, domain.ErrNilContext
+	}
+	if len(sessionID) == 0 {
+		return nil, fmt.Errorf("empty sessionID")
+	}
+
+	defer r.db.rlock(c)()
+
+	record, exists := r.db.sessions[sessionID]
+	if !exists {
+		return nil, errors.WithStack(domain.ErrSessionNotFound)
+	}
+
+	return record.Domain(), nil
+}
+
+func (r *SessionRepository) FindActive(c context.Context, identifier string, now time.Time) ([]domain.Session, error) {
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case len(identifier) == 0:
+		return nil, fmt.Errorf("empty identifier")
+	case now.IsZero():
+		return nil, fmt.Errorf("zero now")
+	}
+
+	defer r.db.rlock(c)()
+
+	sessions := make([]domain.Session, 0)
+	for _, record := range r.db.sessions {
+		if record.Identifier != identifier || !record.Domain().IsActive(now) {
+			continue
+		}
+		sessions = append(sessions, *record.Domain())
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		if !sessions[i].CreatedAt.Equal(sessions[j].CreatedAt) {
+			return sessions[i].CreatedAt.After(sessions[j].CreatedAt)
+		}
+
+		return sessions[i].ID > sessions[j].ID
+	})
+
+	return sessions, nil
+}
+
+func (r *SessionRepository) RevokeFamily(c context.Context, familyID string, revokedAt time.Time) error {
+	switch {
+	case valid.IsNil(c):
+		return domain.ErrNilContext
+	case len(familyID) == 0:
+		return fmt.Errorf("empty familyID")
+	case revokedAt.IsZero():
+		return fmt.Errorf("zero revokedAt")
+	}
+
+	defer r.db.lock(c)()
+
+	for sessionID, record := range r.db.sessions {
+		if record.FamilyID != familyID || record.RevokedAt != nil {
+			continue
+		}
+		record.RevokedAt = &revokedAt
+		r.db.sessions[sessionID] = record
+	}
+
+	return nil
+}
+
+func (r *SessionRepository) RevokeAll(c context.Context, identifier string, revokedAt time.Time) (int, error) {
+	switch {
+	case valid.IsNil(c):
+		return 0, domain.ErrNilContext
+	case len(identifier) == 0:
+		return 0, fmt.Errorf("empty identifier")
+	case revokedAt.IsZero():
+		return 0, fmt.Errorf("zero revokedAt")
+	}
+
+	defer r.db.lock(c)()
+
+	var revokedCount int
+	for sessionID, record := range r.db.sessions {
+		if record.Identifier != identifier || !record.Domain().IsActive(revokedAt) {
+			continue
+		}
+		record.RevokedAt = &revokedAt
+		r.db.sessions[sessionID] = record
+		revokedCount++
+	}
+
+	return revokedCount, nil
+}
+
+func (r *SessionRepository) DeleteExpired(c context.Context, before time.Time) (int, error) {
+	switch {
+	case valid.IsNil(c):
+		return 0, domain.ErrNilContext
+	case before.IsZero():
+		return 0, fmt.Errorf("zero before")
+	}
+
+	defer r.db.lock(c)()
+
+	var deletedCount int
+	for sessionID, record := range r.db.sessions {
+		if record.ExpiresAt.Before(before) {
+			delete(r.db.sessions, sessionID)
+			deletedCount++
+		}
+	}
+
+	return deletedCount, nil
+}
+
+type Session struct {
+	ID         string
+	FamilyID   string
+	Identifier string
+	IP         string
+	UserAgent  string
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	RevokedAt  *time.Time
+}
+
+func (s *Session) Domain() *domain.Session {
+	return &domain.Session{
+		ID:         s.ID,
+		FamilyID:   s.FamilyID,
+		Identifier: s.Identifier,
+		IP:         s.IP,
+		UserAgent:  s.UserAgent,
+		CreatedAt:  s.CreatedAt,
+		ExpiresAt:  s.ExpiresAt,
+		RevokedAt:  s.RevokedAt,
+	}
+}
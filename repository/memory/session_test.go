@@ -0,0 +1,244 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/psi59/payhere-assignment/domain"
+)
+
+func TestSessionRepository_Create(t *testing.T) {
+	ctx := context.TODO()
+	memDB := NewDB()
+	repo := NewSessionRepository(memDB)
+
+	t.Run("OK", func(t *testing.T) {
+		err := repo.Create(ctx, newTestSession(gofakeit.Numerify("###"), xid.New().String()))
+		require.NoError(t, err)
+	})
+
+	t.Run("nil Context", func(t *testing.T) {
+		err := repo.Create(nil, newTestSession(gofakeit.Numerify("###"), xid.New().String()))
+		require.Error(t, err)
+	})
+
+	t.Run("nil session", func(t *testing.T) {
+		err := repo.Create(ctx, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid session", func(t *testing.T) {
+		err := repo.Create(ctx, &domain.Session{})
+		require.Error(t, err)
+	})
+}
+
+func TestSessionRepository_Get(t *testing.T) {
+	ctx := context.TODO()
+	memDB := NewDB()
+	repo := NewSessionRepository(memDB)
+
+	session := newTestSession(gofakeit.Numerify("###"), xid.New().String())
+	err := repo.Create(ctx, session)
+	require.NoError(t, err)
+
+	t.Run("OK", func(t *testing.T) {
+		got, err := repo.Get(ctx, session.ID)
+		require.NoError(t, err)
+		require.Equal(t, session, got)
+	})
+
+	t.Run("session not exists", func(t *testing.T) {
+		got, err := repo.Get(ctx, xid.New().String())
+		require.ErrorIs(t, err, domain.ErrSessionNotFound)
+		require.Nil(t, got)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		got, err := repo.Get(nil, session.ID)
+		require.Error(t, err)
+		require.Nil(t, got)
+		got, err = repo.Get(ctx, "")
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+}
+
+func TestSessionRepository_FindActive(t *testing.T) {
+	ctx := context.TODO()
+	memDB := NewDB()
+	repo := NewSessionRepository(memDB)
+	now := time.Unix(time.Now().Unix(), 0).UTC()
+
+	t.Run("OK", func(t *testing.T) {
+		identifier := xid.New().String()
+		older := newTestSession(identifier, xid.New().String())
+		older.CreatedAt = now.Add(-time.Minute)
+		newer := newTestSession(identifier, xid.New().String())
+		revoked := newTestSession(identifier, xid.New().String())
+		revoked.RevokedAt = &now
+		expired := newTestSession(identifier, xid.New().String())
+		expired.ExpiresAt = now.Add(-time.Second)
+		other := newTestSession(xid.New().String(), xid.New().String())
+		for _, session := range []*domain.Session{older, newer, revoked, expired, other} {
+			err := repo.Create(ctx, session)
+			require.NoError(t, err)
+		}
+
+		got, err := repo.FindActive(ctx, identifier, now)
+		require.NoError(t, err)
+		require.Equal(t, []domain.Session{*newer, *older}, got)
+	})
+
+	t.Run("세션이 없음", func(t *testing.T) {
+		got, err := repo.FindActive(ctx, xid.New().String(), now)
+		require.NoError(t, err)
+		require.Empty(t, got)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		_, err := repo.FindActive(nil, xid.New().String(), now)
+		require.Error(t, err)
+		_, err = repo.FindActive(ctx, "", now)
+		require.Error(t, err)
+		_, err = repo.FindActive(ctx, xid.New().String(), time.Time{})
+		require.Error(t, err)
+	})
+}
+
+func TestSessionRepository_RevokeFamily(t *testing.T) {
+	ctx := context.TODO()
+	memDB := NewDB()
+	repo := NewSessionRepository(memDB)
+	revokedAt := time.Unix(time.Now().Unix(), 0).UTC()
+
+	t.Run("OK", func(t *testing.T) {
+		identifier := gofakeit.Numerify("###")
+		familyID := xid.New().String()
+		sessions := []*domain.Session{newTestSession(identifier, familyID), newTestSession(identifier, familyID)}
+		other := newTestSession(identifier, xid.New().String())
+		for _, session := range append(sessions, other) {
+			err := repo.Create(ctx, session)
+			require.NoError(t, err)
+		}
+
+		err := repo.RevokeFamily(ctx, familyID, revokedAt)
+		require.NoError(t, err)
+		for _, session := range sessions {
+			got, err := repo.Get(ctx, session.ID)
+			require.NoError(t, err)
+			require.Equal(t, &revokedAt, got.RevokedAt)
+		}
+		got, err := repo.Get(ctx, other.ID)
+		require.NoError(t, err)
+		require.Nil(t, got.RevokedAt)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		err := repo.RevokeFamily(nil, xid.New().String(), revokedAt)
+		require.Error(t, err)
+		err = repo.RevokeFamily(ctx, "", revokedAt)
+		require.Error(t, err)
+		err = repo.RevokeFamily(ctx, xid.New().String(), time.Time{})
+		require.Error(t, err)
+	})
+}
+
+func TestSessionRepository_RevokeAll(t *testing.T) {
+	ctx := context.TODO()
+	memDB := NewDB()
+	repo := NewSessionRepository(memDB)
+	revokedAt := time.Unix(time.Now().Unix(), 0).UTC()
+
+	t.Run("OK", func(t *testing.T) {
+		identifier := xid.New().String()
+		sessions := []*domain.Session{newTestSession(identifier, xid.New().String()), newTestSession(identifier, xid.New().String())}
+		expired := newTestSession(identifier, xid.New().String())
+		expired.ExpiresAt = revokedAt.Add(-time.Second)
+		other := newTestSession(xid.New().String(), xid.New().String())
+		for _, session := range append(sessions, expired, other) {
+			err := repo.Create(ctx, session)
+			require.NoError(t, err)
+		}
+
+		revokedCount, err := repo.RevokeAll(ctx, identifier, revokedAt)
+		require.NoError(t, err)
+		require.Equal(t, len(sessions), revokedCount)
+		for _, session := range sessions {
+			got, err := repo.Get(ctx, session.ID)
+			require.NoError(t, err)
+			require.Equal(t, &revokedAt, got.RevokedAt)
+		}
+		got, err := repo.Get(ctx, other.ID)
+		require.NoError(t, err)
+		require.Nil(t, got.RevokedAt)
+
+		// 이미 폐기된 세션
+		revokedCount, err = repo.RevokeAll(ctx, identifier, revokedAt)
+		require.NoError(t, err)
+		require.Zero(t, revokedCount)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		_, err := repo.RevokeAll(nil, xid.New().String(), revokedAt)
+		require.Error(t, err)
+		_, err = repo.RevokeAll(ctx, "", revokedAt)
+		require.Error(t, err)
+		_, err = repo.RevokeAll(ctx, xid.New().String(), time.Time{})
+		require.Error(t, err)
+	})
+}
+
+func TestSessionRepository_DeleteExpired(t *testing.T) {
+	ctx := context.TODO()
+	memDB := NewDB()
+	repo := NewSessionRepository(memDB)
+
+	t.Run("OK", func(t *testing.T) {
+		now := time.Now()
+		expiredSession := newTestSession(gofakeit.Numerify("###"), xid.New().String())
+		expiredSession.ExpiresAt = now.Add(-time.Hour).Truncate(time.Second).UTC()
+		err := repo.Create(ctx, expiredSession)
+		require.NoError(t, err)
+		activeSession := newTestSession(gofakeit.Numerify("###"), xid.New().String())
+		err = repo.Create(ctx, activeSession)
+		require.NoError(t, err)
+
+		deletedCount, err := repo.DeleteExpired(ctx, now)
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, deletedCount, 1)
+
+		_, err = repo.Get(ctx, expiredSession.ID)
+		require.ErrorIs(t, err, domain.ErrSessionNotFound)
+		got, err := repo.Get(ctx, activeSession.ID)
+		require.NoError(t, err)
+		require.Equal(t, activeSession, got)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		deletedCount, err := repo.DeleteExpired(nil, time.Now())
+		require.Error(t, err)
+		require.Zero(t, deletedCount)
+		deletedCount, err = repo.DeleteExpired(ctx, time.Time{})
+		require.Error(t, err)
+		require.Zero(t, deletedCount)
+	})
+}
+
+func newTestSession(identifier, familyID string) *domain.Session {
+	now := time.Unix(time.Now().Unix(), 0).UTC()
+	return &domain.Session{
+		ID:         xid.New().String(),
+		FamilyID:   familyID,
+		Identifier: identifier,
+		IP:         gofakeit.IPv4Address(),
+		UserAgent:  gofakeit.UserAgent(),
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(time.Hour),
+	}
+}
@@ -0,0 +1,111 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/internal/valid"
+)
+
+type TokenBlacklistRepository struct {
+	db *DB
+}
+
+func NewTokenBlacklistRepository(db *DB) *TokenBlacklistRepository {
+	return &TokenBlacklistRepository{db: db}
+}
+
+func (r *TokenBlacklistRepository) Create(c context.Context, token *domain.AuthToken) error {
+	if valid.IsNil(c) {
+		return domain.ErrNilContext
+	}
+	if valid.IsNil(token) {
+		return domain.ErrNilAuthToken
+	}
+	if err := token.Validate(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	defer r.db.lock(c)()
+
+	if _, exists := r.db.tokenBlacklist[token.ID]; exists {
+		return errors.WithStack(domain.ErrTokenBlacklistAlreadyExists)
+	}
+	r.db.tokenBlacklist[token.ID] = AuthToken{JTI: token.ID, ExpiresAt: token.ExpiresAt}
+
+	return nil
+}
+
+func (r *TokenBlacklistRepository) Get(c context.Context, tokenID string) (*domain.AuthToken, error) {
+	if valid.IsNil(c) {
+		return nil, domain.ErrNilContext
+	}
+	if len(tokenID) == 0 {
+		return nil, fmt.Errorf("empty tokenID")
+	}
+
+	defer r.db.rlock(c)()
+
+	record, exists := r.db.tokenBlacklist[tokenID]
+	if !exists {
+		return nil, errors.WithStack(domain.ErrTokenBlacklistNotFound)
+	}
+
+	return record.Domain(), nil
+}
+
+func (r *TokenBlacklistRepository) FindActive(c context.Context, now time.Time) ([]domain.AuthToken, error) {
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case now.IsZero():
+		return nil, fmt.Errorf("zero now")
+	}
+
+	defer r.db.rlock(c)()
+
+	tokens := make([]domain.AuthToken, 0, len(r.db.tokenBlacklist))
+	for _, record := range r.db.tokenBlacklist {
+		if record.ExpiresAt.After(now) {
+			tokens = append(tokens, *record.Domain())
+		}
+	}
+
+	return tokens, nil
+}
+
+func (r *TokenBlacklistRepository) DeleteExpired(c context.Context, before time.Time) (int, error) {
+	switch {
+	case valid.IsNil(c):
+		return 0, domain.ErrNilContext
+	case before.IsZero():
+		return 0, fmt.Errorf("zero before")
+	}
+
+	defer r.db.lock(c)()
+
+	var deletedCount int
+	for tokenID, record := range r.db.tokenBlacklist {
+		if record.ExpiresAt.Before(before) {
+			delete(r.db.tokenBlacklist, tokenID)
+			deletedCount++
+		}
+	}
+
+	return deletedCount, nil
+}
+
+type AuthToken struct {
+	JTI       string
+	ExpiresAt time.Time
+}
+
+func (t *AuthToken) Domain() *domain.AuthToken {
+	return &domain.AuthToken{
+		ID:        t.JTI,
+		ExpiresAt: t.ExpiresAt,
+	}
+}
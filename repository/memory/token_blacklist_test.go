@@ -0,0 +1,167 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/psi59/payhere-assignment/domain"
+	"github.com/rs/xid"
+)
+
+func TestTokenBlacklistRepository_Create(t *testing.T) {
+	ctx := context.TODO()
+	memDB := NewDB()
+	repo := NewTokenBlacklistRepository(memDB)
+
+	t.Run("OK", func(t *testing.T) {
+		token := newTestTokenBlacklist()
+		err := repo.Create(ctx, token)
+		require.NoError(t, err)
+	})
+
+	t.Run("nil Context", func(t *testing.T) {
+		token := newTestTokenBlacklist()
+		err := repo.Create(nil, token)
+		require.Error(t, err)
+	})
+
+	t.Run("nil token", func(t *testing.T) {
+		err := repo.Create(ctx, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		err := repo.Create(ctx, &domain.AuthToken{})
+		require.Error(t, err)
+	})
+
+	t.Run("Duplicate Token", func(t *testing.T) {
+		token := newTestTokenBlacklist()
+		err := repo.Create(ctx, token)
+		require.NoError(t, err)
+
+		err = repo.Create(ctx, token)
+		require.ErrorIs(t, err, domain.ErrTokenBlacklistAlreadyExists)
+	})
+}
+
+func TestTokenBlacklistRepository_Get(t *testing.T) {
+	ctx := context.TODO()
+	memDB := NewDB()
+	repo := NewTokenBlacklistRepository(memDB)
+
+	token := newTestTokenBlacklist()
+	err := repo.Create(ctx, token)
+	require.NoError(t, err)
+
+	t.Run("OK", func(t *testing.T) {
+		got, err := repo.Get(ctx, token.ID)
+		require.NoError(t, err)
+		require.Equal(t, token, got)
+	})
+
+	t.Run("token not exists", func(t *testing.T) {
+		got, err := repo.Get(ctx, gofakeit.UUID())
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+
+	t.Run("nil Context", func(t *testing.T) {
+		got, err := repo.Get(nil, gofakeit.UUID())
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+
+	t.Run("empty tokenID", func(t *testing.T) {
+		got, err := repo.Get(ctx, "")
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+
+}
+
+func TestTokenBlacklistRepository_FindActive(t *testing.T) {
+	ctx := context.TODO()
+	memDB := NewDB()
+	repo := NewTokenBlacklistRepository(memDB)
+
+	t.Run("OK", func(t *testing.T) {
+		now := time.Now()
+		expiredToken := newTestTokenBlacklist()
+		expiredToken.ExpiresAt = now.Add(-time.Hour).Truncate(time.Second).UTC()
+		activeToken := newTestTokenBlacklist()
+		activeToken.ExpiresAt = now.Add(time.Hour).Truncate(time.Second).UTC()
+		for _, token := range []*domain.AuthToken{expiredToken, activeToken} {
+			err := repo.Create(ctx, token)
+			require.NoError(t, err)
+		}
+
+		got, err := repo.FindActive(ctx, now)
+		require.NoError(t, err)
+		require.Contains(t, got, *activeToken)
+		require.NotContains(t, got, *expiredToken)
+	})
+
+	t.Run("nil Context", func(t *testing.T) {
+		got, err := repo.FindActive(nil, time.Now())
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+
+	t.Run("zero now", func(t *testing.T) {
+		got, err := repo.FindActive(ctx, time.Time{})
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+}
+
+func TestTokenBlacklistRepository_DeleteExpired(t *testing.T) {
+	ctx := context.TODO()
+	memDB := NewDB()
+	repo := NewTokenBlacklistRepository(memDB)
+
+	t.Run("OK", func(t *testing.T) {
+		now := time.Now()
+		expiredToken := newTestTokenBlacklist()
+		expiredToken.ExpiresAt = now.Add(-time.Hour).UTC()
+		err := repo.Create(ctx, expiredToken)
+		require.NoError(t, err)
+		activeToken := newTestTokenBlacklist()
+		activeToken.ExpiresAt = now.Add(time.Hour).UTC()
+		err = repo.Create(ctx, activeToken)
+		require.NoError(t, err)
+
+		deletedCount, err := repo.DeleteExpired(ctx, now)
+		require.NoError(t, err)
+		require.Equal(t, 1, deletedCount)
+
+		_, err = repo.Get(ctx, expiredToken.ID)
+		require.ErrorIs(t, err, domain.ErrTokenBlacklistNotFound)
+		got, err := repo.Get(ctx, activeToken.ID)
+		require.NoError(t, err)
+		require.Equal(t, activeToken, got)
+	})
+
+	t.Run("nil Context", func(t *testing.T) {
+		deletedCount, err := repo.DeleteExpired(nil, time.Now())
+		require.Error(t, err)
+		require.Zero(t, deletedCount)
+	})
+
+	t.Run("zero before", func(t *testing.T) {
+		deletedCount, err := repo.DeleteExpired(ctx, time.Time{})
+		require.Error(t, err)
+		require.Zero(t, deletedCount)
+	})
+}
+
+func newTestTokenBlacklist() *domain.AuthToken {
+	return &domain.AuthToken{
+		ID:        xid.New().String(),
+		ExpiresAt: time.Unix(time.Now().Unix(), 0).UTC(),
+	}
+}
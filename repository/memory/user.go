@@ -0,0 +1,155 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/internal/valid"
+	"github.com/psi59/payhere-assignment/repository"
+)
+
+type UserRepository struct {
+	db *DB
+}
+
+func NewUserRepository(db *DB) *UserRepository {
+	return &UserRepository{db: db}
+}
+
+func (r *UserRepository) Create(c context.Context, user *domain.User) error {
+	switch {
+	case valid.IsNil(c):
+		return domain.ErrNilContext
+	case valid.IsNil(user):
+		return domain.ErrNilUser
+	}
+
+	defer r.db.lock(c)()
+
+	for _, record := range r.db.users {
+		if record.PhoneNumber == user.PhoneNumber {
+			return fmt.Errorf("%w: duplicate phone_number %q", domain.ErrUserAlreadyExists, user.PhoneNumber)
+		}
+	}
+	if _, exists := r.db.users[user.ID]; exists {
+		return fmt.Errorf("%w: duplicate user_id %d", domain.ErrUserAlreadyExists, user.ID)
+	}
+
+	record := User{
+		UserID:              user.ID,
+		PhoneNumber:         user.PhoneNumber,
+		Password:            user.Password,
+		CreatedAt:           user.CreatedAt,
+		DuplicateItemPolicy: user.DuplicateItemPolicy,
+		TokensValidAfter:    user.TokensValidAfter,
+	}
+	if len(record.DuplicateItemPolicy) == 0 {
+		record.DuplicateItemPolicy = domain.DuplicateItemPolicyWarn
+	}
+	if record.UserID == 0 {
+		r.db.lastUserID++
+		record.UserID = r.db.lastUserID
+	} else if record.UserID > r.db.lastUserID {
+		r.db.lastUserID = record.UserID
+	}
+	r.db.users[record.UserID] = record
+	user.ID = record.UserID
+	user.DuplicateItemPolicy = record.DuplicateItemPolicy
+
+	return nil
+}
+
+func (r *UserRepository) Get(c context.Context, userID int) (*domain.User, error) {
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case userID < 1:
+		return nil, fmt.Errorf("invalid userID")
+	}
+
+	defer r.db.rlock(c)()
+
+	record, exists := r.db.users[userID]
+	if !exists {
+		return nil, domain.ErrUserNotFound
+	}
+
+	return record.Domain(), nil
+}
+
+func (r *UserRepository) GetByPhoneNumber(c context.Context, phoneNumber string) (*domain.User, error) {
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case len(phoneNumber) == 0:
+		return nil, fmt.Errorf("empty phoneNumber")
+	}
+
+	defer r.db.rlock(c)()
+
+	for _, record := range r.db.users {
+		if record.PhoneNumber == phoneNumber {
+			return record.Domain(), nil
+		}
+	}
+
+	return nil, domain.ErrUserNotFound
+}
+
+func (r *UserRepository) Update(c context.Context, userID int, input *repository.UpdateUserInput) error {
+	switch {
+	case valid.IsNil(c):
+		return domain.ErrNilContext
+	case userID < 1:
+		return fmt.Errorf("invalid userID: %d", userID)
+	case valid.IsNil(input):
+		return domain.ErrNilInput
+	}
+	if err := input.Validate(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	defer r.db.lock(c)()
+
+	record, exists := r.db.users[userID]
+	if !exists {
+		return errors.WithStack(domain.ErrUserNotFound)
+	}
+	if input.DuplicateItemPolicy != nil {
+		record.DuplicateItemPolicy = *input.DuplicateItemPolicy
+	}
+	if input.Password != nil {
+		record.Password = *input.Password
+	}
+	if input.TokensValidAfter != nil {
+		tokensValidAfter := *input.TokensValidAfter
+		record.TokensValidAfter = &tokensValidAfter
+	}
+	r.db.users[userID] = record
+
+	return nil
+}
+
+type User struct {
+	UserID              int
+	PhoneNumber         string
+	Password            string
+	CreatedAt           time.Time
+	DuplicateItemPolicy domain.DuplicateItemPolicy
+	TokensValidAfter    *time.Time
+}
+
+func (u *User) Domain() *domain.User {
+	return &domain.User{
+		ID:                  u.UserID,
+		PhoneNumber:         u.PhoneNumber,
+		Password:            u.Password,
+		CreatedAt:           u.CreatedAt,
+		DuplicateItemPolicy: u.DuplicateItemPolicy,
+		TokensValidAfter:    u.TokensValidAfter,
+	}
+}
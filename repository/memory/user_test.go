@@ -1,4 +1,4 @@
-package mysql
+package memory
 
 import (
 	"context"
@@ -8,13 +8,14 @@ import (
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/jinzhu/copier"
 	"github.com/psi59/payhere-assignment/domain"
-	"github.com/psi59/payhere-assignment/internal/db"
+	"github.com/psi59/payhere-assignment/repository"
 	"github.com/stretchr/testify/require"
 )
 
 func TestUserRepository_Create(t *testing.T) {
-	repo := NewUserRepository()
-	ctx := db.ContextWithConn(context.TODO(), conn)
+	ctx := context.TODO()
+	memDB := NewDB()
+	repo := NewUserRepository(memDB)
 
 	t.Run("OK", func(t *testing.T) {
 		user := newTestUser(t)
@@ -34,12 +35,6 @@ func TestUserRepository_Create(t *testing.T) {
 		require.Error(t, err)
 	})
 
-	t.Run("context without conn", func(t *testing.T) {
-		user := newTestUser(t)
-		err := repo.Create(context.TODO(), user)
-		require.Error(t, err)
-	})
-
 	t.Run("Duplicated PhoneNumber", func(t *testing.T) {
 		user := newTestUser(t)
 		err := repo.Create(ctx, user)
@@ -66,8 +61,9 @@ func newTestUser(t *testing.T) *domain.User {
 }
 
 func TestUserRepository_Get(t *testing.T) {
-	repo := NewUserRepository()
-	ctx := db.ContextWithConn(context.TODO(), conn)
+	ctx := context.TODO()
+	memDB := NewDB()
+	repo := NewUserRepository(memDB)
 
 	user := newTestUser(t)
 	err := repo.Create(ctx, user)
@@ -91,12 +87,6 @@ func TestUserRepository_Get(t *testing.T) {
 		require.Nil(t, got)
 	})
 
-	t.Run("context without conn", func(t *testing.T) {
-		got, err := repo.Get(context.TODO(), user.ID)
-		require.Error(t, err)
-		require.Nil(t, got)
-	})
-
 	t.Run("UserNotFound", func(t *testing.T) {
 		got, err := repo.Get(ctx, gofakeit.IntRange(10000, 20000))
 		require.Error(t, err)
@@ -105,8 +95,9 @@ func TestUserRepository_Get(t *testing.T) {
 }
 
 func TestUserRepository_GetByPhoneNumber(t *testing.T) {
-	repo := NewUserRepository()
-	ctx := db.ContextWithConn(context.TODO(), conn)
+	ctx := context.TODO()
+	memDB := NewDB()
+	repo := NewUserRepository(memDB)
 
 	user := newTestUser(t)
 	err := repo.Create(ctx, user)
@@ -130,15 +121,83 @@ func TestUserRepository_GetByPhoneNumber(t *testing.T) {
 		require.Nil(t, got)
 	})
 
-	t.Run("context without conn", func(t *testing.T) {
-		got, err := repo.GetByPhoneNumber(context.TODO(), user.PhoneNumber)
+	t.Run("UserNotFound", func(t *testing.T) {
+		got, err := repo.GetByPhoneNumber(ctx, gofakeit.Phone())
 		require.Error(t, err)
 		require.Nil(t, got)
 	})
+}
 
-	t.Run("UserNotFound", func(t *testing.T) {
-		got, err := repo.GetByPhoneNumber(ctx, gofakeit.Phone())
+func TestUserRepository_Update(t *testing.T) {
+	ctx := context.TODO()
+	memDB := NewDB()
+	repo := NewUserRepository(memDB)
+
+	user := newTestUser(t)
+	err := repo.Create(ctx, user)
+	require.NoError(t, err)
+	require.Equal(t, domain.DuplicateItemPolicyWarn, user.DuplicateItemPolicy)
+
+	policy := domain.DuplicateItemPolicyBlock
+	input := &repository.UpdateUserInput{DuplicateItemPolicy: &policy}
+
+	t.Run("OK", func(t *testing.T) {
+		err := repo.Update(ctx, user.ID, input)
+		require.NoError(t, err)
+
+		got, err := repo.Get(ctx, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, domain.DuplicateItemPolicyBlock, got.DuplicateItemPolicy)
+
+		// 값이 같아도 에러가 발생하지 않습니다.
+		err = repo.Update(ctx, user.ID, input)
+		require.NoError(t, err)
+	})
+
+	t.Run("비밀번호 및 토큰 무효화 시각", func(t *testing.T) {
+		password := gofakeit.LetterN(60)
+		tokensValidAfter := time.Unix(time.Now().Unix(), 0).UTC()
+		err := repo.Update(ctx, user.ID, &repository.UpdateUserInput{
+			Password:         &password,
+			TokensValidAfter: &tokensValidAfter,
+		})
+		require.NoError(t, err)
+
+		got, err := repo.Get(ctx, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, password, got.Password)
+		require.Equal(t, &tokensValidAfter, got.TokensValidAfter)
+		require.Equal(t, domain.DuplicateItemPolicyBlock, got.DuplicateItemPolicy)
+	})
+
+	t.Run("nil Context", func(t *testing.T) {
+		err := repo.Update(nil, user.ID, input)
 		require.Error(t, err)
-		require.Nil(t, got)
+	})
+
+	t.Run("invalid userID", func(t *testing.T) {
+		err := repo.Update(ctx, gofakeit.IntRange(-10, 0), input)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		invalid := domain.DuplicateItemPolicy("ignore")
+		err := repo.Update(ctx, user.ID, &repository.UpdateUserInput{DuplicateItemPolicy: &invalid})
+		require.Error(t, err)
+
+		err = repo.Update(ctx, user.ID, &repository.UpdateUserInput{})
+		require.Error(t, err)
+
+		empty := ""
+		err = repo.Update(ctx, user.ID, &repository.UpdateUserInput{Password: &empty})
+		require.Error(t, err)
+
+		err = repo.Update(ctx, user.ID, &repository.UpdateUserInput{TokensValidAfter: &time.Time{}})
+		require.Error(t, err)
+	})
+
+	t.Run("UserNotFound", func(t *testing.T) {
+		err := repo.Update(ctx, gofakeit.IntRange(10000, 20000), input)
+		require.ErrorIs(t, err, domain.ErrUserNotFound)
 	})
 }
@@ -0,0 +1,55 @@
+package mysql
+
+import (
+	"strconv"
+
+	"gorm.io/gorm"
+
+	"github.com/psi59/payhere-assignment/repository/rdb"
+)
+
+// Dialect MySQL 쿼리입니다.
+var Dialect rdb.Dialect = dialect{}
+
+type dialect struct{}
+
+func (dialect) IsDuplicateEntry(err error) bool {
+	return IsDuplicateEntry(err)
+}
+
+// WhereKeyword ngram FULLTEXT 인덱스로 키워드를 구문 검색합니다.
+func (dialect) WhereKeyword(queryBuilder *gorm.DB, keyword string) *gorm.DB {
+	return queryBuilder.Where("MATCH(item_name, item_name_chosung) AGAINST(? IN BOOLEAN MODE)", strconv.Quote(keyword))
+}
+
+func (dialect) Time(expression string) string {
+	return expression
+}
+
+func (dialect) DeleteLimit(table, _, condition string) string {
+	return "DELETE FROM " + table + " WHERE " + condition + " LIMIT ?"
+}
+
+func NewUserRepository() *rdb.UserRepository {
+	return rdb.NewUserRepository(Dialect)
+}
+
+func NewItemRepository() *rdb.ItemRepository {
+	return rdb.NewItemRepository(Dialect)
+}
+
+func NewItemHistoryRepository() *rdb.ItemHistoryRepository {
+	return rdb.NewItemHistoryRepository()
+}
+
+func NewTokenBlacklistRepository() *rdb.TokenBlacklistRepository {
+	return rdb.NewTokenBlacklistRepository(Dialect)
+}
+
+func NewRefreshTokenRepository() *rdb.RefreshTokenRepository {
+	return rdb.NewRefreshTokenRepository(Dialect)
+}
+
+func NewSessionRepository() *rdb.SessionRepository {
+	return rdb.NewSessionRepository(Dialect)
+}
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS token_blacklist;
+DROP TABLE IF EXISTS items;
+DROP TABLE IF EXISTS users;
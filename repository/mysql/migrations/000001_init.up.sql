@@ -1,8 +1,4 @@
-CREATE DATABASE IF NOT EXISTS payhere COLLATE utf8_general_ci;
-
-# USE payhere;
-
-CREATE TABLE users
+CREATE TABLE IF NOT EXISTS users
 (
     user_id      BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
     phone_number CHAR(13)                           NOT NULL,
@@ -12,7 +8,7 @@ CREATE TABLE users
         UNIQUE (phone_number)
 );
 
-CREATE TABLE items
+CREATE TABLE IF NOT EXISTS items
 (
     item_id           BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
     user_id           BIGINT UNSIGNED                    NOT NULL,
@@ -34,8 +30,8 @@ CREATE TABLE items
             ON DELETE CASCADE
 );
 
-CREATE TABLE token_blacklist
+CREATE TABLE IF NOT EXISTS token_blacklist
 (
     token      VARCHAR(500) NOT NULL PRIMARY KEY,
     expires_at datetime     NOT NULL
-);
\ No newline at end of file
+);
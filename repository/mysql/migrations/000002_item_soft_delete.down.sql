@@ -0,0 +1,13 @@
+-- 휴지통의 아이템은 이름이 중복될 수 있으므로 영구 삭제합니다.
+DELETE FROM items WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE items
+    DROP INDEX uidx_user_id_item_name,
+    ADD CONSTRAINT uidx_user_id_item_name
+        UNIQUE (user_id, item_name);
+
+ALTER TABLE items
+    DROP INDEX idx_deleted_at,
+    DROP INDEX idx_user_id_deleted_at,
+    DROP COLUMN alive,
+    DROP COLUMN deleted_at;
@@ -0,0 +1,12 @@
+-- 휴지통으로 이동된 아이템은 deleted_at이 설정됩니다.
+-- alive는 삭제되지 않은 아이템만 1, 삭제된 아이템은 NULL이므로 uidx_user_id_item_name은 삭제되지 않은 아이템 사이에서만 중복을 검사합니다.
+ALTER TABLE items
+    ADD COLUMN deleted_at DATETIME NULL,
+    ADD COLUMN alive      TINYINT AS (IF(deleted_at IS NULL, 1, NULL)) STORED,
+    ADD INDEX idx_user_id_deleted_at (user_id, deleted_at),
+    ADD INDEX idx_deleted_at (deleted_at);
+
+ALTER TABLE items
+    DROP INDEX uidx_user_id_item_name,
+    ADD CONSTRAINT uidx_user_id_item_name
+        UNIQUE (user_id, item_name, alive);
@@ -0,0 +1,16 @@
+-- 영구 삭제된 아이템의 이력도 보관하기 위해 items 테이블을 참조하지 않습니다.
+CREATE TABLE IF NOT EXISTS item_histories
+(
+    history_id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    item_id    BIGINT UNSIGNED                                                NOT NULL,
+    user_id    BIGINT UNSIGNED                                                NOT NULL,
+    actor_id   BIGINT UNSIGNED                                                NOT NULL,
+    request_id VARCHAR(64)                                                    NOT NULL,
+    action     ENUM ('create', 'update', 'delete', 'restore', 'purge')        NOT NULL,
+    changes    JSON                                                           NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP                             NOT NULL,
+    INDEX idx_user_id_item_id_history_id (user_id, item_id, history_id),
+    CONSTRAINT item_histories_ibfk_1
+        FOREIGN KEY (user_id) REFERENCES users (user_id)
+            ON DELETE CASCADE
+);
@@ -0,0 +1,7 @@
+ALTER TABLE items
+    DROP INDEX idx_user_id_barcode,
+    DROP INDEX idx_user_id_created_at,
+    DROP INDEX idx_user_id_expiry_at,
+    DROP INDEX idx_user_id_cost,
+    DROP INDEX idx_user_id_price,
+    DROP INDEX idx_user_id_category;
@@ -0,0 +1,10 @@
+-- 아이템 목록 조회 조건을 위한 인덱스입니다.
+-- InnoDB의 보조 인덱스는 기본 키(item_id)를 포함하므로 item_id 순서로 페이지를 나눌 때도 사용됩니다.
+-- item_size는 값의 종류가 적어 인덱스를 추가하지 않습니다.
+ALTER TABLE items
+    ADD INDEX idx_user_id_category (user_id, category),
+    ADD INDEX idx_user_id_price (user_id, price),
+    ADD INDEX idx_user_id_cost (user_id, cost),
+    ADD INDEX idx_user_id_expiry_at (user_id, expiry_at),
+    ADD INDEX idx_user_id_created_at (user_id, created_at),
+    ADD INDEX idx_user_id_barcode (user_id, barcode);
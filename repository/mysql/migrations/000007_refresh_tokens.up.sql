@@ -0,0 +1,13 @@
+-- 토큰 대신 SHA-256 해시를 저장합니다.
+CREATE TABLE IF NOT EXISTS refresh_tokens
+(
+    token_hash CHAR(64)                           NOT NULL PRIMARY KEY,
+    family_id  VARCHAR(20)                        NOT NULL,
+    identifier VARCHAR(100)                       NOT NULL,
+    expires_at DATETIME                           NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    rotated_at DATETIME,
+    revoked_at DATETIME,
+    INDEX idx_family_id (family_id),
+    INDEX idx_expires_at (expires_at)
+);
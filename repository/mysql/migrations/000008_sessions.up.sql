@@ -0,0 +1,15 @@
+-- 액세스 토큰의 jti를 아이디로 사용합니다.
+CREATE TABLE IF NOT EXISTS sessions
+(
+    id         VARCHAR(20)                        NOT NULL PRIMARY KEY,
+    family_id  VARCHAR(20)                        NOT NULL,
+    identifier VARCHAR(100)                       NOT NULL,
+    ip         VARCHAR(45)                        NOT NULL,
+    user_agent VARCHAR(512)                       NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    expires_at DATETIME                           NOT NULL,
+    revoked_at DATETIME,
+    INDEX idx_identifier (identifier),
+    INDEX idx_family_id (family_id),
+    INDEX idx_expires_at (expires_at)
+);
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS token_blacklist;
+
+CREATE TABLE IF NOT EXISTS token_blacklist
+(
+    token      VARCHAR(500) NOT NULL PRIMARY KEY,
+    expires_at datetime     NOT NULL
+);
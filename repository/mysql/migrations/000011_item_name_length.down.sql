@@ -0,0 +1,3 @@
+ALTER TABLE items
+    DROP INDEX idx_user_id_item_name_length,
+    DROP COLUMN item_name_length;
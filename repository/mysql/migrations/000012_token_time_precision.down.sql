@@ -0,0 +1,4 @@
+ALTER TABLE refresh_tokens
+    MODIFY COLUMN created_at DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL;
+ALTER TABLE users
+    MODIFY COLUMN tokens_valid_after DATETIME NULL;
@@ -0,0 +1,5 @@
+-- 토큰 무효화 시각을 마이크로초 단위의 토큰 발급 시각과 비교하므로 초 단위로 반올림하지 않도록 정밀도를 늘립니다.
+ALTER TABLE users
+    MODIFY COLUMN tokens_valid_after DATETIME(6) NULL;
+ALTER TABLE refresh_tokens
+    MODIFY COLUMN created_at DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6) NOT NULL;
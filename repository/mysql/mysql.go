@@ -1,6 +1,9 @@
 package mysql
 
 import (
+	"embed"
+	"io/fs"
+
 	"github.com/go-sql-driver/mysql"
 	"github.com/pkg/errors"
 )
@@ -17,3 +20,16 @@ func IsDuplicateEntry(err error) bool {
 
 	return false
 }
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// Migrations 스키마 마이그레이션 파일을 반환합니다.
+func Migrations() fs.FS {
+	sub, err := fs.Sub(migrations, "migrations")
+	if err != nil {
+		panic(err)
+	}
+
+	return sub
+}
@@ -1,8 +1,8 @@
 package mysql
 
 import (
+	"context"
 	"fmt"
-	"io/ioutil"
 	"os"
 	"testing"
 
@@ -10,7 +10,11 @@ import (
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/pkg/errors"
+	"github.com/psi59/payhere-assignment/internal/db"
+	"github.com/psi59/payhere-assignment/internal/migrate"
+	"github.com/psi59/payhere-assignment/repository/rdb/rdbtest"
 	"github.com/rs/xid"
+	"github.com/stretchr/testify/suite"
 	gorm_mysql "gorm.io/driver/mysql"
 	"gorm.io/gorm"
 )
@@ -69,17 +73,12 @@ func createTestDatabase(dbName string) error {
 		return errors.Wrap(err, "failed to connect database")
 	}
 
-	currentDir, err := os.Getwd()
+	migrator, err := migrate.New(Migrations())
 	if err != nil {
-		return errors.Wrap(err, "failed to get current working directory for createTestDatabase")
+		return errors.WithStack(err)
 	}
-	query, err := ioutil.ReadFile(fmt.Sprintf("%s/tables.sql", currentDir))
-	if err != nil {
-		return errors.Wrap(err, "failed to read table sql file")
-	}
-
-	if err := conn.Exec(string(query)).Error; err != nil {
-		return errors.Wrap(err, "failed to execute table creates query")
+	if _, err := migrator.Up(db.ContextWithConn(context.Background(), conn)); err != nil {
+		return errors.Wrap(err, "failed to migrate database")
 	}
 	conn = conn.Debug()
 
@@ -107,3 +106,11 @@ func getEnv(k, defaultValue string) string {
 
 	return v
 }
+
+func TestRepository(t *testing.T) {
+	suite.Run(t, &rdbtest.Suite{Conn: conn, Dialect: Dialect})
+}
+
+func BenchmarkItemRepository_Find(b *testing.B) {
+	rdbtest.BenchmarkItemRepositoryFind(b, conn, Dialect)
+}
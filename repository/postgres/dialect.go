@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/psi59/payhere-assignment/repository/rdb"
+)
+
+// Dialect PostgreSQL 쿼리입니다.
+var Dialect rdb.Dialect = dialect{}
+
+type dialect struct{}
+
+func (dialect) IsDuplicateEntry(err error) bool {
+	return IsDuplicateEntry(err)
+}
+
+// WhereKeyword ILIKE는 ngram 구문 검색과 같은 부분 일치를, word_similarity(<%)는 오타가 포함된 키워드를 검색합니다.
+// 두 연산자 모두 idx_trgm_item_name 인덱스를 사용합니다.
+func (dialect) WhereKeyword(queryBuilder *gorm.DB, keyword string) *gorm.DB {
+	pattern := "%" + escapeLike(keyword) + "%"
+	return queryBuilder.Where(
+		"(item_name ILIKE ? OR item_name_chosung ILIKE ? OR ? <% item_name OR ? <% item_name_chosung)",
+		pattern, pattern, keyword, keyword,
+	)
+}
+
+func (dialect) Time(expression string) string {
+	return expression
+}
+
+// DeleteLimit PostgreSQL은 DELETE 문의 LIMIT을 지원하지 않으므로 서브쿼리로 삭제할 행을 고릅니다.
+func (dialect) DeleteLimit(table, key, condition string) string {
+	return "DELETE FROM " + table + " WHERE " + key + " IN (SELECT " + key + " FROM " + table + " WHERE " + condition + " LIMIT ?)"
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+func NewUserRepository() *rdb.UserRepository {
+	return rdb.NewUserRepository(Dialect)
+}
+
+func NewItemRepository() *rdb.ItemRepository {
+	return rdb.NewItemRepository(Dialect)
+}
+
+func NewItemHistoryRepository() *rdb.ItemHistoryRepository {
+	return rdb.NewItemHistoryRepository()
+}
+
+func NewTokenBlacklistRepository() *rdb.TokenBlacklistRepository {
+	return rdb.NewTokenBlacklistRepository(Dialect)
+}
+
+func NewRefreshTokenRepository() *rdb.RefreshTokenRepository {
+	return rdb.NewRefreshTokenRepository(Dialect)
+}
+
+func NewSessionRepository() *rdb.SessionRepository {
+	return rdb.NewSessionRepository(Dialect)
+}
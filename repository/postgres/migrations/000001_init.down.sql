@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS token_blacklist;
+DROP TABLE IF EXISTS items;
+DROP TABLE IF EXISTS users;
+DROP TYPE IF EXISTS item_size;
@@ -0,0 +1,51 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+DO
+$$
+BEGIN
+    CREATE TYPE item_size AS ENUM ('small', 'large');
+EXCEPTION
+    WHEN duplicate_object THEN NULL;
+END
+$$;
+
+CREATE TABLE IF NOT EXISTS users
+(
+    user_id      BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    phone_number VARCHAR(13)                         NOT NULL,
+    password     VARCHAR(72)                         NOT NULL,
+    created_at   TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CONSTRAINT phone_number
+        UNIQUE (phone_number)
+);
+
+CREATE TABLE IF NOT EXISTS items
+(
+    item_id           BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    user_id           BIGINT                              NOT NULL,
+    category          VARCHAR(100)                        NOT NULL,
+    item_name         VARCHAR(100)                        NOT NULL,
+    item_name_chosung VARCHAR(100)                        NOT NULL,
+    price             INTEGER                             NOT NULL CHECK (price >= 0),
+    cost              INTEGER                             NOT NULL CHECK (cost >= 0),
+    description       TEXT                                NOT NULL,
+    barcode           VARCHAR(100)                        NOT NULL,
+    item_size         item_size                           NOT NULL,
+    created_at        TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    expiry_at         TIMESTAMP                           NOT NULL,
+    CONSTRAINT items_ibfk_1
+        FOREIGN KEY (user_id) REFERENCES users (user_id)
+            ON DELETE CASCADE
+);
+
+-- MySQL의 utf8_general_ci 유니크 키와 동일하게 대소문자를 구분하지 않습니다.
+CREATE UNIQUE INDEX IF NOT EXISTS uidx_user_id_item_name ON items (user_id, LOWER(item_name));
+
+-- MySQL의 ngram FULLTEXT 인덱스를 대신하는 trigram 인덱스
+CREATE INDEX IF NOT EXISTS idx_trgm_item_name ON items USING GIN (item_name gin_trgm_ops, item_name_chosung gin_trgm_ops);
+
+CREATE TABLE IF NOT EXISTS token_blacklist
+(
+    token      VARCHAR(500) NOT NULL PRIMARY KEY,
+    expires_at TIMESTAMP    NOT NULL
+);
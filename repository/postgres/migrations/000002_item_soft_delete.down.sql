@@ -0,0 +1,13 @@
+-- 휴지통의 아이템은 이름이 중복될 수 있으므로 영구 삭제합니다.
+DELETE FROM items WHERE deleted_at IS NOT NULL;
+
+DROP INDEX idx_deleted_at;
+
+DROP INDEX idx_user_id_deleted_at;
+
+DROP INDEX uidx_user_id_item_name;
+
+CREATE UNIQUE INDEX uidx_user_id_item_name ON items (user_id, LOWER(item_name));
+
+ALTER TABLE items
+    DROP COLUMN deleted_at;
@@ -0,0 +1,11 @@
+-- 휴지통으로 이동된 아이템은 deleted_at이 설정되며, 삭제되지 않은 아이템 사이에서만 이름 중복을 검사합니다.
+ALTER TABLE items
+    ADD COLUMN deleted_at TIMESTAMP NULL;
+
+DROP INDEX uidx_user_id_item_name;
+
+CREATE UNIQUE INDEX uidx_user_id_item_name ON items (user_id, LOWER(item_name)) WHERE deleted_at IS NULL;
+
+CREATE INDEX idx_user_id_deleted_at ON items (user_id, deleted_at);
+
+CREATE INDEX idx_deleted_at ON items (deleted_at) WHERE deleted_at IS NOT NULL;
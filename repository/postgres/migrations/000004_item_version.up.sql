@@ -0,0 +1,3 @@
+-- 낙관적 동시성 제어를 위한 버전이며, 아이템이 변경될 때마다 1씩 증가합니다.
+ALTER TABLE items
+    ADD COLUMN IF NOT EXISTS version INTEGER DEFAULT 1 NOT NULL CHECK (version > 0);
@@ -0,0 +1,13 @@
+-- 아이템 목록 조회 조건을 위한 인덱스이며, 휴지통의 아이템은 조회하지 않으므로 제외합니다.
+-- item_size는 값의 종류가 적어 인덱스를 추가하지 않습니다.
+CREATE INDEX IF NOT EXISTS idx_user_id_category ON items (user_id, category) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_user_id_price ON items (user_id, price) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_user_id_cost ON items (user_id, cost) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_user_id_expiry_at ON items (user_id, expiry_at) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_user_id_created_at ON items (user_id, created_at) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_user_id_barcode ON items (user_id, barcode) WHERE deleted_at IS NULL;
@@ -0,0 +1,15 @@
+-- 토큰 대신 SHA-256 해시를 저장합니다.
+CREATE TABLE IF NOT EXISTS refresh_tokens
+(
+    token_hash CHAR(64)                            NOT NULL PRIMARY KEY,
+    family_id  VARCHAR(20)                         NOT NULL,
+    identifier VARCHAR(100)                        NOT NULL,
+    expires_at TIMESTAMP                           NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    rotated_at TIMESTAMP,
+    revoked_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_family_id ON refresh_tokens (family_id);
+
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_expires_at ON refresh_tokens (expires_at);
@@ -0,0 +1,3 @@
+-- 이 시각 이전에 발급된 토큰은 모두 거부합니다.
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS tokens_valid_after TIMESTAMP;
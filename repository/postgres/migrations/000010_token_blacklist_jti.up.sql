@@ -0,0 +1,11 @@
+-- 토큰 전체 대신 jti로 블랙리스트를 조회합니다.
+-- 기존 행은 토큰 전체를 키로 사용하므로 옮기지 않으며, 로그아웃한 토큰은 세션이 폐기되어 계속 거부됩니다.
+DROP TABLE IF EXISTS token_blacklist;
+
+CREATE TABLE IF NOT EXISTS token_blacklist
+(
+    jti        VARCHAR(20) NOT NULL PRIMARY KEY,
+    expires_at TIMESTAMP   NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_token_blacklist_expires_at ON token_blacklist (expires_at);
@@ -0,0 +1,35 @@
+package postgres
+
+import (
+	"embed"
+	"io/fs"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
+)
+
+const (
+	ErrCodeUniqueViolation = "23505"
+)
+
+func IsDuplicateEntry(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == ErrCodeUniqueViolation
+	}
+
+	return false
+}
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// Migrations 스키마 마이그레이션 파일을 반환합니다.
+func Migrations() fs.FS {
+	sub, err := fs.Sub(migrations, "migrations")
+	if err != nil {
+		panic(err)
+	}
+
+	return sub
+}
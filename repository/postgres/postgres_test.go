@@ -0,0 +1,122 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
+	"github.com/psi59/payhere-assignment/internal/db"
+	"github.com/psi59/payhere-assignment/internal/migrate"
+	"github.com/psi59/payhere-assignment/repository/rdb/rdbtest"
+	"github.com/rs/xid"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultTestDBDSN = "host=127.0.0.1 port=5432 user=postgres password=1234 dbname=postgres sslmode=disable TimeZone=UTC"
+)
+
+var conn *gorm.DB
+
+func TestMain(m *testing.M) {
+	dbName := fmt.Sprintf("payhere_%s", xid.New())
+	if err := createTestDatabase(dbName); err != nil {
+		log.Fatal().Err(err).Send()
+	}
+	code := m.Run()
+	if err := deleteTestDatabase(dbName); err != nil {
+		log.Fatal().Err(err).Send()
+	}
+	os.Exit(code)
+}
+
+func createTestDatabase(dbName string) error {
+	dsn := getEnv("TEST_POSTGRES_DSN", defaultTestDBDSN)
+	pgConfig, err := pgconn.ParseConfig(dsn)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse dsn")
+	}
+
+	adminConn, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if err != nil {
+		return errors.Wrap(err, "failed to connect database")
+	}
+	if err := adminConn.Exec("CREATE DATABASE " + dbName + " ENCODING 'UTF8' TEMPLATE template0 LC_CTYPE 'C.UTF-8' LC_COLLATE 'C.UTF-8'").Error; err != nil {
+		return errors.Wrap(err, "failed to create database")
+	}
+	if err := closeConn(adminConn); err != nil {
+		return errors.WithStack(err)
+	}
+
+	testDSN := fmt.Sprintf(
+		"host=%s port=%d user=%s password=%s dbname=%s sslmode=disable TimeZone=UTC",
+		pgConfig.Host, pgConfig.Port, pgConfig.User, pgConfig.Password, dbName,
+	)
+	log.Debug().Msgf("DSN string : %s", testDSN)
+	conn, err = gorm.Open(postgres.Open(testDSN), &gorm.Config{DisableNestedTransaction: true})
+	if err != nil {
+		return errors.Wrap(err, "failed to connect database")
+	}
+
+	migrator, err := migrate.New(Migrations())
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if _, err := migrator.Up(db.ContextWithConn(context.Background(), conn)); err != nil {
+		return errors.Wrap(err, "failed to migrate database")
+	}
+	conn = conn.Debug()
+
+	return nil
+}
+
+func deleteTestDatabase(dbName string) error {
+	if err := closeConn(conn); err != nil {
+		return errors.WithStack(err)
+	}
+
+	adminConn, err := gorm.Open(postgres.Open(getEnv("TEST_POSTGRES_DSN", defaultTestDBDSN)), &gorm.Config{})
+	if err != nil {
+		return errors.Wrap(err, "failed to connect database")
+	}
+	defer func() {
+		_ = closeConn(adminConn)
+	}()
+	if err := adminConn.Exec("DROP DATABASE " + dbName).Error; err != nil {
+		return errors.Wrapf(err, "failed to delete database(%s)", dbName)
+	}
+
+	return nil
+}
+
+func closeConn(conn *gorm.DB) error {
+	goDB, err := conn.DB()
+	if err != nil {
+		return errors.Wrap(err, "failed to get golang db")
+	}
+
+	return errors.WithStack(goDB.Close())
+}
+
+func getEnv(k, defaultValue string) string {
+	v := os.Getenv(k)
+	if v == "" {
+		return defaultValue
+	}
+
+	return v
+}
+
+func TestRepository(t *testing.T) {
+	suite.Run(t, &rdbtest.Suite{Conn: conn, Dialect: Dialect})
+}
+
+func BenchmarkItemRepository_Find(b *testing.B) {
+	rdbtest.BenchmarkItemRepositoryFind(b, conn, Dialect)
+}
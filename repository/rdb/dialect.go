@@ -0,0 +1,18 @@
+package rdb
+
+import (
+	"gorm.io/gorm"
+)
+
+// Dialect 저장소 구현 중 DB마다 다른 쿼리를 만듭니다.
+type Dialect interface {
+	// IsDuplicateEntry 기본 키 또는 유니크 키 중복 에러인지 확인합니다.
+	IsDuplicateEntry(err error) bool
+	// WhereKeyword 아이템 이름 또는 이름의 초성에 키워드가 포함된 아이템만 조회하도록 조건을 추가합니다.
+	WhereKeyword(queryBuilder *gorm.DB, keyword string) *gorm.DB
+	// Time 시각 컬럼 또는 placeholder를 시각 순서대로 비교하고 정렬할 수 있는 식으로 변환합니다.
+	Time(expression string) string
+	// DeleteLimit table에서 condition을 만족하는 행을 최대 한 배치만큼 삭제하는 쿼리를 반환합니다.
+	// 배치 크기는 condition의 인자 다음 인자로 전달하며, key는 table의 기본 키 컬럼입니다.
+	DeleteLimit(table, key, condition string) string
+}
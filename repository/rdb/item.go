@@ -0,0 +1,722 @@
+package rdb
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/internal/db"
+	"github.com/psi59/payhere-assignment/internal/hangul"
+	"github.com/psi59/payhere-assignment/internal/valid"
+	"github.com/psi59/payhere-assignment/repository"
+	"gorm.io/gorm"
+)
+
+// findItemPageSize Limit을 지정하지 않은 경우 아이템 목록 한 페이지의 아이템 수입니다.
+const findItemPageSize = 10
+
+type ItemRepository struct {
+	dialect Dialect
+}
+
+func NewItemRepository(dialect Dialect) *ItemRepository {
+	return &ItemRepository{dialect: dialect}
+}
+
+func (r *ItemRepository) Create(c context.Context, item *domain.Item) error {
+	// 1. 파라메터 체크
+	switch {
+	case valid.IsNil(c):
+		return domain.ErrNilContext
+	case valid.IsNil(item):
+		return domain.ErrNilItem
+	}
+	if err := item.Validate(); err != nil {
+		return errors.WithStack(err)
+	}
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	itemNameChosung, err := hangul.GetChosung(item.Name)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	itemNameLength, err := hangul.NameLength(item.Name)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// 2. 아이템 생성
+	record := &Item{
+		ItemID:          item.ID,
+		UserID:          item.UserID,
+		Category:        item.Category,
+		ItemName:        item.Name,
+		ItemNameChosung: itemNameChosung,
+		ItemNameLength:  &itemNameLength,
+		Price:           item.Price,
+		Cost:            item.Cost,
+		Description:     item.Description,
+		Barcode:         item.Barcode,
+		ItemSize:        item.Size,
+		ExpiryAt:        item.ExpiryAt,
+		CreatedAt:       item.CreatedAt,
+		Version:         max(item.Version, 1),
+	}
+	if err := conn.Create(record).Error; err != nil {
+		if r.dialect.IsDuplicateEntry(err) {
+			return errors.Wrap(domain.ErrItemAlreadyExists, err.Error())
+		}
+
+		return errors.WithStack(err)
+	}
+	item.ID = record.ItemID
+	item.Version = record.Version
+
+	// 3. 결과 반환
+
+	return nil
+}
+
+func (r *ItemRepository) Get(c context.Context, userID, itemID int) (*domain.Item, error) {
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case userID < 1:
+		return nil, fmt.Errorf("invalid userID: %d", userID)
+	case itemID < 1:
+		return nil, fmt.Errorf("invalid itemID: %d", itemID)
+	}
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var record Item
+	if err := conn.Where("user_id=?", userID).Where("item_id=?", itemID).Where("deleted_at IS NULL").Take(&record).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.Wrap(domain.ErrItemNotFound, err.Error())
+		}
+
+		return nil, errors.WithStack(err)
+	}
+
+	return record.Domain(), nil
+}
+
+func (r *ItemRepository) Delete(c context.Context, userID, itemID, version int) error {
+	switch {
+	case valid.IsNil(c):
+		return domain.ErrNilContext
+	case userID < 1:
+		return fmt.Errorf("invalid userID: %d", userID)
+	case itemID < 1:
+		return fmt.Errorf("invalid itemID: %d", itemID)
+	case version < 0:
+		return fmt.Errorf("invalid version: %d", version)
+	}
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	query := func() *gorm.DB {
+		return conn.Model(&Item{}).Where("user_id=?", userID).Where("item_id=?", itemID).Where("deleted_at IS NULL")
+	}
+	result := withVersion(query(), version).Updates(map[string]any{
+		"deleted_at": time.Now(),
+		"version":    gorm.Expr("version + 1"),
+	})
+	if err := result.Error; err != nil {
+		return errors.WithStack(err)
+	}
+	if result.RowsAffected == 0 {
+		return r.conditionalWriteError(query(), version)
+	}
+
+	return nil
+}
+
+func (r *ItemRepository) Restore(c context.Context, userID, itemID int) error {
+	switch {
+	case valid.IsNil(c):
+		return domain.ErrNilContext
+	case userID < 1:
+		return fmt.Errorf("invalid userID: %d", userID)
+	case itemID < 1:
+		return fmt.Errorf("invalid itemID: %d", itemID)
+	}
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	result := conn.Model(&Item{}).
+		Where("user_id=?", userID).
+		Where("item_id=?", itemID).
+		Where("deleted_at IS NOT NULL").
+		Updates(map[string]any{
+			"deleted_at": nil,
+			"version":    gorm.Expr("version + 1"),
+		})
+	if err := result.Error; err != nil {
+		if r.dialect.IsDuplicateEntry(err) {
+			return errors.Wrap(domain.ErrItemAlreadyExists, err.Error())
+		}
+
+		return errors.WithStack(err)
+	}
+	if result.RowsAffected == 0 {
+		return errors.WithStack(domain.ErrItemNotFound)
+	}
+
+	return nil
+}
+
+func (r *ItemRepository) Purge(c context.Context, userID, itemID, version int) error {
+	switch {
+	case valid.IsNil(c):
+		return domain.ErrNilContext
+	case userID < 1:
+		return fmt.Errorf("invalid userID: %d", userID)
+	case itemID < 1:
+		return fmt.Errorf("invalid itemID: %d", itemID)
+	case version < 0:
+		return fmt.Errorf("invalid version: %d", version)
+	}
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	query := func() *gorm.DB {
+		return conn.Model(&Item{}).Where("user_id=?", userID).Where("item_id=?", itemID)
+	}
+	result := withVersion(query(), version).Delete(&Item{})
+	if err := result.Error; err != nil {
+		return errors.WithStack(err)
+	}
+	if result.RowsAffected == 0 {
+		return r.conditionalWriteError(query(), version)
+	}
+
+	return nil
+}
+
+func (r *ItemRepository) PurgeDeleted(c context.Context, deletedBefore time.Time) (int, error) {
+	switch {
+	case valid.IsNil(c):
+		return 0, domain.ErrNilContext
+	case deletedBefore.IsZero():
+		return 0, fmt.Errorf("zero deletedBefore")
+	}
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	result := conn.Where("deleted_at < ?", deletedBefore).Delete(&Item{})
+	if err := result.Error; err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return int(result.RowsAffected), nil
+}
+
+func (r *ItemRepository) Update(c context.Context, userID, itemID int, input *repository.UpdateItemInput) error {
+	switch {
+	case valid.IsNil(c):
+		return domain.ErrNilContext
+	case userID < 1:
+		return fmt.Errorf("invalid userID: %d", userID)
+	case itemID < 1:
+		return fmt.Errorf("invalid itemID: %d", itemID)
+	case valid.IsNil(input):
+		return domain.ErrNilInput
+	}
+	if err := input.Validate(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	updateColumns, err := createUpdateColumns(input)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	query := func() *gorm.DB {
+		return conn.Model(&Item{}).Where("user_id = ?", userID).Where("item_id = ?", itemID).Where("deleted_at IS NULL")
+	}
+	result := withVersion(query(), input.Version).Updates(updateColumns)
+	if err := result.Error; err != nil {
+		if r.dialect.IsDuplicateEntry(err) {
+			return errors.Wrap(domain.ErrItemAlreadyExists, err.Error())
+		}
+		return errors.WithStack(err)
+	}
+	if result.RowsAffected == 0 {
+		return r.conditionalWriteError(query(), input.Version)
+	}
+
+	return nil
+}
+
+func (r *ItemRepository) Find(c context.Context, input *repository.FindItemInput) (*repository.FindItemOutput, error) {
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case valid.IsNil(input):
+		return nil, domain.ErrNilInput
+	}
+	if err := input.Validate(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	output, err := r.findPage(r.createFindQuery(conn, input, input.Cursor), input.Cursor, input.Limit)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if input.WithTotal {
+		if output.TotalCount, err = r.getCount(r.createFindQuery(conn, input, nil)); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+	if input.WithFacets {
+		if output.Facets, err = r.getFacets(conn, input); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+
+	return output, nil
+}
+
+func (r *ItemRepository) FindDeleted(c context.Context, input *repository.FindDeletedItemInput) (*repository.FindItemOutput, error) {
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case valid.IsNil(input):
+		return nil, domain.ErrNilInput
+	}
+	if err := input.Validate(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	output, err := r.findPage(r.applySort(r.createTrashQuery(conn, input.UserID), nil, input.Cursor), input.Cursor, input.Limit)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if input.WithTotal {
+		if output.TotalCount, err = r.getCount(r.createTrashQuery(conn, input.UserID)); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+
+	return output, nil
+}
+
+func (r *ItemRepository) GetStamp(c context.Context, userID int) (*repository.ItemStamp, error) {
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case userID < 1:
+		return nil, fmt.Errorf("invalid userID: %d", userID)
+	}
+
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var stamp repository.ItemStamp
+	if err := conn.Model(&Item{}).
+		Select("COUNT(*) AS count, COALESCE(MAX(item_id), 0) AS max_item_id, COALESCE(SUM(version), 0) AS version_sum").
+		Where("user_id = ?", userID).
+		Scan(&stamp).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &stamp, nil
+}
+
+// findPage applySort로 정렬한 query에서 cursor 다음 페이지를 limit개까지 조회하고, 이전, 다음 페이지 존재 여부를 설정합니다.
+func (r *ItemRepository) findPage(query *gorm.DB, cursor *domain.ItemCursor, limit int) (*repository.FindItemOutput, error) {
+	if limit == 0 {
+		limit = findItemPageSize
+	}
+
+	// 다음 페이지 여부를 확인하기 위해 한 건을 더 조회
+	rows := make([]Item, 0)
+	if err := query.Limit(limit + 1).Find(&rows).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+	hasMore := len(rows) > limit
+	if hasMore {
+		rows = rows[:limit]
+	}
+	// 이전 페이지는 역순으로 조회하므로 다시 정렬 순서로 되돌립니다.
+	backward := cursor != nil && cursor.Backward
+	if backward {
+		slices.Reverse(rows)
+	}
+
+	items := make([]domain.Item, len(rows))
+	for i := 0; i < len(rows); i++ {
+		items[i] = *rows[i].Domain()
+	}
+	output := &repository.FindItemOutput{
+		Items: items,
+	}
+	if len(items) > 0 {
+		// 커서 위치의 아이템은 조회 방향의 반대쪽에 있으므로 커서가 있으면 반대쪽 페이지가 존재합니다.
+		output.HasNext, output.HasPrev = hasMore, cursor != nil
+		if backward {
+			output.HasNext, output.HasPrev = true, hasMore
+		}
+	}
+
+	return output, nil
+}
+
+func (r *ItemRepository) createTrashQuery(conn *gorm.DB, userID int) *gorm.DB {
+	return conn.Model(&Item{}).Where("user_id=?", userID).Where("deleted_at IS NOT NULL")
+}
+
+// conditionalWriteError 변경된 행이 없는 경우, 버전 조건 없이 아이템이 존재하면 버전 불일치로 판단합니다.
+func (r *ItemRepository) conditionalWriteError(query *gorm.DB, version int) error {
+	if version < 1 {
+		return errors.WithStack(domain.ErrItemNotFound)
+	}
+	cnt, err := r.getCount(query)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if cnt == 0 {
+		return errors.WithStack(domain.ErrItemNotFound)
+	}
+
+	return errors.WithStack(domain.ErrItemVersionMismatch)
+}
+
+func (r *ItemRepository) getCount(queryBuilder *gorm.DB) (int, error) {
+	var cnt int64
+	if err := queryBuilder.Count(&cnt).Error; err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return int(cnt), nil
+}
+
+func (r *ItemRepository) createFindQuery(conn *gorm.DB, input *repository.FindItemInput, cursor *domain.ItemCursor) *gorm.DB {
+	return r.applySort(r.createFilterQuery(conn, input), input.Sort, cursor)
+}
+
+// createFilterQuery 정렬과 커서 위치 조건 없이 조회 조건만 적용한 쿼리를 만듭니다.
+func (r *ItemRepository) createFilterQuery(conn *gorm.DB, input *repository.FindItemInput) *gorm.DB {
+	queryBuilder := conn.Model(&Item{}).Where("user_id=?", input.UserID).Where("deleted_at IS NULL")
+	if len(input.Keywords) > 0 {
+		// 키워드별 조건을 OR로 묶습니다.
+		conditions := r.dialect.WhereKeyword(conn.Session(&gorm.Session{NewDB: true}), input.Keywords[0])
+		for _, k := range input.Keywords[1:] {
+			conditions = conditions.Or(r.dialect.WhereKeyword(conn.Session(&gorm.Session{NewDB: true}), k))
+		}
+		queryBuilder = queryBuilder.Where(conditions)
+	}
+	if input.MinNameLength != nil || input.MaxNameLength != nil {
+		conditions := conn.Session(&gorm.Session{NewDB: true})
+		if input.MinNameLength != nil {
+			conditions = conditions.Where("item_name_length >= ?", *input.MinNameLength)
+		}
+		if input.MaxNameLength != nil {
+			conditions = conditions.Where("item_name_length <= ?", *input.MaxNameLength)
+		}
+		queryBuilder = queryBuilder.Where(conditions.Or("item_name_length IS NULL"))
+	}
+
+	return r.applyFilter(queryBuilder, &input.Filter)
+}
+
+// getFacets 조회 조건을 만족하는 아이템을 카테고리, 크기, 가격 구간별로 셉니다.
+func (r *ItemRepository) getFacets(conn *gorm.DB, input *repository.FindItemInput) (*domain.ItemFacets, error) {
+	type facetRow struct {
+		Value string
+		Cnt   int
+	}
+	counter := domain.NewItemFacetCounter()
+	groups := []struct {
+		expression string
+		add        func(row facetRow) error
+	}{
+		{expression: "category", add: func(row facetRow) error {
+			counter.AddCategory(row.Value, row.Cnt)
+			return nil
+		}},
+		{expression: "item_size", add: func(row facetRow) error {
+			counter.AddSize(domain.ItemSize(row.Value), row.Cnt)
+			return nil
+		}},
+		{expression: priceBucketExpression, add: func(row facetRow) error {
+			index, err := strconv.Atoi(row.Value)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			counter.AddPriceBucket(index, row.Cnt)
+			return nil
+		}},
+	}
+	for _, group := range groups {
+		rows := make([]facetRow, 0)
+		if err := r.createFilterQuery(conn, input).Select(group.expression + " AS value, COUNT(*) AS cnt").Group("value").Scan(&rows).Error; err != nil {
+			return nil, errors.WithStack(err)
+		}
+		for _, row := range rows {
+			if err := group.add(row); err != nil {
+				return nil, errors.WithStack(err)
+			}
+		}
+	}
+
+	return counter.Facets(), nil
+}
+
+// priceBucketExpression 가격이 속한 domain.ItemPriceBucketBounds 구간의 위치를 구하는 식입니다.
+var priceBucketExpression = func() string {
+	var b strings.Builder
+	b.WriteString("CASE")
+	for i, bound := range domain.ItemPriceBucketBounds {
+		fmt.Fprintf(&b, " WHEN price < %d THEN %d", bound, i)
+	}
+	fmt.Fprintf(&b, " ELSE %d END", len(domain.ItemPriceBucketBounds))
+
+	return b.String()
+}()
+
+// itemSortColumn 정렬 기준의 컬럼과 커서 값을 비교할 때 사용할 placeholder 입니다.
+type itemSortColumn struct {
+	column      string
+	placeholder string
+}
+
+// sortColumn 정렬 기준의 컬럼을 반환하며, 시각 컬럼은 Dialect.Time으로 변환해 비교합니다.
+func (r *ItemRepository) sortColumn(key domain.ItemSortKey) itemSortColumn {
+	switch key {
+	case domain.ItemSortKeyName:
+		return itemSortColumn{column: "item_name", placeholder: "?"}
+	case domain.ItemSortKeyPrice:
+		return itemSortColumn{column: "price", placeholder: "?"}
+	case domain.ItemSortKeyCost:
+		return itemSortColumn{column: "cost", placeholder: "?"}
+	case domain.ItemSortKeyExpiryAt:
+		return itemSortColumn{column: r.dialect.Time("expiry_at"), placeholder: r.dialect.Time("?")}
+	case domain.ItemSortKeyCreatedAt:
+		return itemSortColumn{column: r.dialect.Time("created_at"), placeholder: r.dialect.Time("?")}
+	}
+
+	return itemSortColumn{}
+}
+
+// applySort 정렬 순서와 커서 위치 조건을 쿼리에 추가합니다.
+// 이전 페이지는 정렬 순서를 뒤집어 커서 앞쪽의 아이템을 조회합니다.
+func (r *ItemRepository) applySort(queryBuilder *gorm.DB, order domain.ItemSortOrder, cursor *domain.ItemCursor) *gorm.DB {
+	type sortColumn struct {
+		itemSortColumn
+		desc  bool
+		value any
+	}
+	backward := cursor != nil && cursor.Backward
+	columns := make([]sortColumn, 0, len(order)+1)
+	for _, s := range order {
+		column := sortColumn{itemSortColumn: r.sortColumn(s.Key), desc: s.Desc != backward}
+		if cursor != nil {
+			column.value = cursor.Value(s.Key)
+		}
+		columns = append(columns, column)
+	}
+	tiebreaker := sortColumn{itemSortColumn: itemSortColumn{column: "item_id", placeholder: "?"}, desc: backward}
+	if cursor != nil {
+		tiebreaker.value = cursor.ID
+	}
+	columns = append(columns, tiebreaker)
+
+	for _, column := range columns {
+		if column.desc {
+			queryBuilder = queryBuilder.Order(column.column + " DESC")
+		} else {
+			queryBuilder = queryBuilder.Order(column.column + " ASC")
+		}
+	}
+	if cursor == nil {
+		return queryBuilder
+	}
+
+	// 정렬 방향이 섞여 있을 수 있으므로 (k1 > v1) OR (k1 = v1 AND k2 > v2) OR ... 형태로 커서 이후의 아이템을 조회합니다.
+	conditions := make([]string, len(columns))
+	args := make([]any, 0, len(columns)*(len(columns)+1)/2)
+	for i, column := range columns {
+		terms := make([]string, 0, i+1)
+		for _, prev := range columns[:i] {
+			terms = append(terms, prev.column+" = "+prev.placeholder)
+			args = append(args, prev.value)
+		}
+		op := " > "
+		if column.desc {
+			op = " < "
+		}
+		terms = append(terms, column.column+op+column.placeholder)
+		args = append(args, column.value)
+		conditions[i] = "(" + strings.Join(terms, " AND ") + ")"
+	}
+
+	return queryBuilder.Where("("+strings.Join(conditions, " OR ")+")", args...)
+}
+
+// applyFilter 조회 조건을 쿼리에 추가합니다.
+func (r *ItemRepository) applyFilter(queryBuilder *gorm.DB, filter *domain.ItemFilter) *gorm.DB {
+	if len(filter.Categories) > 0 {
+		queryBuilder = queryBuilder.Where("category IN ?", filter.Categories)
+	}
+	if len(filter.Size) > 0 {
+		queryBuilder = queryBuilder.Where("item_size = ?", filter.Size)
+	}
+	if filter.MinPrice != nil {
+		queryBuilder = queryBuilder.Where("price >= ?", *filter.MinPrice)
+	}
+	if filter.MaxPrice != nil {
+		queryBuilder = queryBuilder.Where("price <= ?", *filter.MaxPrice)
+	}
+	if filter.MinCost != nil {
+		queryBuilder = queryBuilder.Where("cost >= ?", *filter.MinCost)
+	}
+	if filter.MaxCost != nil {
+		queryBuilder = queryBuilder.Where("cost <= ?", *filter.MaxCost)
+	}
+	if filter.ExpiresAfter != nil {
+		queryBuilder = queryBuilder.Where(r.dialect.Time("expiry_at")+" >= "+r.dialect.Time("?"), *filter.ExpiresAfter)
+	}
+	if filter.ExpiresBefore != nil {
+		queryBuilder = queryBuilder.Where(r.dialect.Time("expiry_at")+" < "+r.dialect.Time("?"), *filter.ExpiresBefore)
+	}
+	if filter.CreatedAfter != nil {
+		queryBuilder = queryBuilder.Where(r.dialect.Time("created_at")+" >= "+r.dialect.Time("?"), *filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		queryBuilder = queryBuilder.Where(r.dialect.Time("created_at")+" < "+r.dialect.Time("?"), *filter.CreatedBefore)
+	}
+	if len(filter.Barcode) > 0 {
+		queryBuilder = queryBuilder.Where("barcode = ?", filter.Barcode)
+	}
+
+	return queryBuilder
+}
+
+type Item struct {
+	ItemID          int    `gorm:"item_id;primaryKey"`
+	UserID          int    `gorm:"user_id"`
+	Category        string `gorm:"category"`
+	ItemName        string `gorm:"item_name"`
+	ItemNameChosung string `gorm:"item_name_chosung"`
+	// ItemNameLength 정규화한 이름의 자모 수이며, 자모 수를 저장하기 전에 등록된 아이템은 nil입니다.
+	ItemNameLength *int            `gorm:"item_name_length"`
+	Price          int             `gorm:"price"`
+	Cost           int             `gorm:"cost"`
+	Description    string          `gorm:"description"`
+	Barcode        string          `gorm:"barcode"`
+	ItemSize       domain.ItemSize `gorm:"item_size"`
+	CreatedAt      time.Time       `gorm:"created_at"`
+	ExpiryAt       time.Time       `gorm:"expiry_at"`
+	Version        int             `gorm:"version"`
+	DeletedAt      *time.Time      `gorm:"deleted_at"`
+}
+
+func (i *Item) TableName() string {
+	return "items"
+}
+
+func (i *Item) Domain() *domain.Item {
+	return &domain.Item{
+		ID:          i.ItemID,
+		UserID:      i.UserID,
+		Name:        i.ItemName,
+		Description: i.Description,
+		Price:       i.Price,
+		Cost:        i.Cost,
+		Category:    i.Category,
+		Barcode:     i.Barcode,
+		ExpiryAt:    i.ExpiryAt,
+		Size:        i.ItemSize,
+		CreatedAt:   i.CreatedAt,
+		Version:     i.Version,
+		DeletedAt:   i.DeletedAt,
+	}
+}
+
+// withVersion version이 0보다 큰 경우 버전이 일치하는 아이템만 조회하도록 조건을 추가합니다.
+func withVersion(queryBuilder *gorm.DB, version int) *gorm.DB {
+	if version > 0 {
+		return queryBuilder.Where("version = ?", version)
+	}
+
+	return queryBuilder
+}
+
+// createUpdateColumns 수정할 컬럼 목록을 생성하며, 버전은 항상 1 증가합니다.
+func createUpdateColumns(input *repository.UpdateItemInput) (map[string]any, error) {
+	columns := map[string]any{
+		"version": gorm.Expr("version + 1"),
+	}
+	if !valid.IsNil(input.Name) {
+		c, err := hangul.GetChosung(*input.Name)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		length, err := hangul.NameLength(*input.Name)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		columns["item_name"] = *input.Name
+		columns["item_name_chosung"] = c
+		columns["item_name_length"] = length
+	}
+	if !valid.IsNil(input.Description) {
+		columns["description"] = *input.Description
+	}
+	if !valid.IsNil(input.Price) {
+		columns["price"] = *input.Price
+	}
+	if !valid.IsNil(input.Cost) {
+		columns["cost"] = *input.Cost
+	}
+	if !valid.IsNil(input.Category) {
+		columns["category"] = *input.Category
+	}
+	if !valid.IsNil(input.Barcode) {
+		columns["barcode"] = *input.Barcode
+	}
+	if !valid.IsNil(input.Size) {
+		columns["item_size"] = *input.Size
+	}
+	if !valid.IsNil(input.ExpiryAt) {
+		columns["expiry_at"] = *input.ExpiryAt
+	}
+
+	return columns, nil
+}
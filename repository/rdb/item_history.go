@@ -0,0 +1,150 @@
+package rdb
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/internal/db"
+	"github.com/psi59/payhere-assignment/internal/valid"
+	"github.com/psi59/payhere-assignment/repository"
+)
+
+const itemHistoryPageSize = 10
+
+type ItemHistoryRepository struct{}
+
+func NewItemHistoryRepository() *ItemHistoryRepository {
+	return &ItemHistoryRepository{}
+}
+
+func (r *ItemHistoryRepository) Create(c context.Context, history *domain.ItemHistory) error {
+	// 1. 파라메터 체크
+	switch {
+	case valid.IsNil(c):
+		return domain.ErrNilContext
+	case valid.IsNil(history):
+		return domain.ErrNilItemHistory
+	}
+	if err := history.Validate(); err != nil {
+		return errors.WithStack(err)
+	}
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	changes, err := json.Marshal(history.Changes)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// 2. 이력 생성
+	record := &ItemHistory{
+		HistoryID: history.ID,
+		ItemID:    history.ItemID,
+		UserID:    history.UserID,
+		ActorID:   history.ActorID,
+		RequestID: history.RequestID,
+		Action:    history.Action,
+		Changes:   string(changes),
+		CreatedAt: history.CreatedAt,
+	}
+	if err := conn.Create(record).Error; err != nil {
+		return errors.WithStack(err)
+	}
+	history.ID = record.HistoryID
+
+	// 3. 결과 반환
+	return nil
+}
+
+func (r *ItemHistoryRepository) Find(c context.Context, input *repository.FindItemHistoryInput) (*repository.FindItemHistoryOutput, error) {
+	// 1. 파라메터 체크
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case valid.IsNil(input):
+		return nil, domain.ErrNilInput
+	}
+	if err := valid.ValidateStruct(input); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// 2. 다음 페이지 여부를 확인하기 위해 한 건을 더 조회
+	queryBuilder := conn.Model(&ItemHistory{}).
+		Where("user_id=?", input.UserID).
+		Where("item_id=?", input.ItemID).
+		Order("history_id ASC").
+		Limit(itemHistoryPageSize + 1)
+	if input.SearchAfter > 0 {
+		queryBuilder = queryBuilder.Where("history_id > ?", input.SearchAfter)
+	}
+	rows := make([]ItemHistory, 0)
+	if err := queryBuilder.Find(&rows).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+	hasNext := len(rows) > itemHistoryPageSize
+	if hasNext {
+		rows = rows[:itemHistoryPageSize]
+	}
+
+	// 3. 결과 반환
+	histories := make([]domain.ItemHistory, len(rows))
+	var nextSearchAfter int
+	for i := 0; i < len(rows); i++ {
+		history, err := rows[i].Domain()
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		histories[i] = *history
+	}
+	if len(rows) > 0 {
+		nextSearchAfter = rows[len(rows)-1].HistoryID
+	}
+
+	return &repository.FindItemHistoryOutput{
+		Histories:   histories,
+		HasNext:     hasNext,
+		SearchAfter: nextSearchAfter,
+	}, nil
+}
+
+type ItemHistory struct {
+	HistoryID int                      `gorm:"history_id;primaryKey"`
+	ItemID    int                      `gorm:"item_id"`
+	UserID    int                      `gorm:"user_id"`
+	ActorID   int                      `gorm:"actor_id"`
+	RequestID string                   `gorm:"request_id"`
+	Action    domain.ItemHistoryAction `gorm:"action"`
+	Changes   string                   `gorm:"changes"`
+	CreatedAt time.Time                `gorm:"created_at"`
+}
+
+func (h *ItemHistory) TableName() string {
+	return "item_histories"
+}
+
+func (h *ItemHistory) Domain() (*domain.ItemHistory, error) {
+	changes := make([]domain.ItemFieldChange, 0)
+	if err := json.Unmarshal([]byte(h.Changes), &changes); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &domain.ItemHistory{
+		ID:        h.HistoryID,
+		ItemID:    h.ItemID,
+		UserID:    h.UserID,
+		ActorID:   h.ActorID,
+		RequestID: h.RequestID,
+		Action:    h.Action,
+		Changes:   changes,
+		CreatedAt: h.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,1344 @@
+package rdbtest
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/psi59/payhere-assignment/repository"
+
+	"github.com/jinzhu/copier"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/psi59/payhere-assignment/domain"
+
+	"fmt"
+	"github.com/psi59/payhere-assignment/internal/db"
+	"github.com/psi59/payhere-assignment/internal/hangul"
+	"github.com/psi59/payhere-assignment/repository/rdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func (s *Suite) TestItemRepository_Create() {
+	t := s.T()
+	ctx := db.ContextWithConn(context.TODO(), s.Conn)
+	userRepo := rdb.NewUserRepository(s.Dialect)
+	user := newTestUser(t)
+	err := userRepo.Create(ctx, user)
+	assert.NoError(t, err)
+	itemRepo := rdb.NewItemRepository(s.Dialect)
+
+	t.Run("OK", func(t *testing.T) {
+		item := newTestItem(t, user.ID)
+		err := itemRepo.Create(ctx, item)
+		assert.NoError(t, err)
+		assert.True(t, item.ID > 0)
+	})
+
+	t.Run("nil context", func(t *testing.T) {
+		item := newTestItem(t, user.ID)
+		err := itemRepo.Create(nil, item)
+		assert.Error(t, err)
+	})
+
+	t.Run("nil item", func(t *testing.T) {
+		err := itemRepo.Create(ctx, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("context without conn", func(t *testing.T) {
+		item := newTestItem(t, user.ID)
+		err := itemRepo.Create(context.TODO(), item)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid item", func(t *testing.T) {
+		item := newTestItem(t, user.ID)
+		item.UserID = 0
+		err := itemRepo.Create(ctx, item)
+		assert.Error(t, err)
+	})
+
+	t.Run("중복 아이템", func(t *testing.T) {
+		item := newTestItem(t, user.ID)
+		var dupl domain.Item
+		err := copier.Copy(&dupl, item)
+		assert.NoError(t, err)
+
+		err = itemRepo.Create(ctx, item)
+		assert.NoError(t, err)
+		assert.True(t, item.ID > 0)
+
+		err = itemRepo.Create(ctx, &dupl)
+		assert.ErrorIs(t, err, domain.ErrItemAlreadyExists)
+	})
+}
+
+func (s *Suite) TestItemRepository_Get() {
+	t := s.T()
+	ctx := db.ContextWithConn(context.TODO(), s.Conn)
+	userRepo := rdb.NewUserRepository(s.Dialect)
+	user := newTestUser(t)
+	err := userRepo.Create(ctx, user)
+	assert.NoError(t, err)
+	itemRepo := rdb.NewItemRepository(s.Dialect)
+	item := newTestItem(t, user.ID)
+	err = itemRepo.Create(ctx, item)
+	assert.NoError(t, err)
+
+	t.Run("OK", func(t *testing.T) {
+		got, err := itemRepo.Get(ctx, item.UserID, item.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, item, got)
+	})
+
+	t.Run("nil context", func(t *testing.T) {
+		got, err := itemRepo.Get(nil, item.UserID, item.ID)
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("invalid userID", func(t *testing.T) {
+		got, err := itemRepo.Get(ctx, 0, item.ID)
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("invalid itemID", func(t *testing.T) {
+		got, err := itemRepo.Get(ctx, item.UserID, 0)
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("context without conn", func(t *testing.T) {
+		got, err := itemRepo.Get(context.TODO(), item.UserID, item.ID)
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("item not found", func(t *testing.T) {
+		got, err := itemRepo.Get(ctx, gofakeit.Number(1000, 2000), item.ID)
+		assert.Error(t, err, domain.ErrItemNotFound)
+		assert.Nil(t, got)
+
+		got, err = itemRepo.Get(ctx, item.UserID, gofakeit.Number(1000, 2000))
+		assert.Error(t, err, domain.ErrItemNotFound)
+		assert.Nil(t, got)
+	})
+}
+
+func (s *Suite) TestItemRepository_Delete() {
+	t := s.T()
+	ctx := db.ContextWithConn(context.TODO(), s.Conn)
+	userRepo := rdb.NewUserRepository(s.Dialect)
+	user := newTestUser(t)
+	err := userRepo.Create(ctx, user)
+	assert.NoError(t, err)
+	itemRepo := rdb.NewItemRepository(s.Dialect)
+	item := newTestItem(t, user.ID)
+	err = itemRepo.Create(ctx, item)
+	assert.NoError(t, err)
+
+	t.Run("OK", func(t *testing.T) {
+		err := itemRepo.Delete(ctx, item.UserID, item.ID, 0)
+		assert.NoError(t, err)
+	})
+
+	t.Run("버전 불일치", func(t *testing.T) {
+		item := newTestItem(t, user.ID)
+		err := itemRepo.Create(ctx, item)
+		assert.NoError(t, err)
+
+		err = itemRepo.Delete(ctx, item.UserID, item.ID, item.Version+1)
+		assert.ErrorIs(t, err, domain.ErrItemVersionMismatch)
+		err = itemRepo.Delete(ctx, item.UserID, item.ID, item.Version)
+		assert.NoError(t, err)
+	})
+
+	t.Run("nil context", func(t *testing.T) {
+		err := itemRepo.Delete(nil, item.UserID, item.ID, 0)
+		assert.Error(t, err)
+
+	})
+
+	t.Run("invalid userID", func(t *testing.T) {
+		err := itemRepo.Delete(ctx, 0, item.ID, 0)
+		assert.Error(t, err)
+
+	})
+
+	t.Run("invalid itemID", func(t *testing.T) {
+		err := itemRepo.Delete(ctx, item.UserID, 0, 0)
+		assert.Error(t, err)
+
+	})
+
+	t.Run("context without conn", func(t *testing.T) {
+		err := itemRepo.Delete(context.TODO(), item.UserID, item.ID, 0)
+		assert.Error(t, err)
+
+	})
+
+	t.Run("휴지통으로 이동된 아이템 조회", func(t *testing.T) {
+		got, err := itemRepo.Get(ctx, item.UserID, item.ID)
+		assert.ErrorIs(t, err, domain.ErrItemNotFound)
+		assert.Nil(t, got)
+	})
+
+	t.Run("item not found", func(t *testing.T) {
+		err := itemRepo.Delete(ctx, item.UserID, item.ID, 0)
+		assert.ErrorIs(t, err, domain.ErrItemNotFound)
+
+		err = itemRepo.Delete(ctx, item.UserID, gofakeit.Number(100000, 200000), 0)
+		assert.ErrorIs(t, err, domain.ErrItemNotFound)
+	})
+
+	t.Run("삭제된 아이템과 같은 이름으로 생성", func(t *testing.T) {
+		newItem := newTestItem(t, user.ID)
+		newItem.Name = item.Name
+		err := itemRepo.Create(ctx, newItem)
+		assert.NoError(t, err)
+
+		err = itemRepo.Delete(ctx, newItem.UserID, newItem.ID, 0)
+		assert.NoError(t, err)
+	})
+}
+
+func (s *Suite) TestItemRepository_Restore() {
+	t := s.T()
+	ctx := db.ContextWithConn(context.TODO(), s.Conn)
+	userRepo := rdb.NewUserRepository(s.Dialect)
+	user := newTestUser(t)
+	err := userRepo.Create(ctx, user)
+	assert.NoError(t, err)
+	itemRepo := rdb.NewItemRepository(s.Dialect)
+
+	t.Run("OK", func(t *testing.T) {
+		item := newTestItem(t, user.ID)
+		err := itemRepo.Create(ctx, item)
+		assert.NoError(t, err)
+		err = itemRepo.Delete(ctx, item.UserID, item.ID, 0)
+		assert.NoError(t, err)
+
+		err = itemRepo.Restore(ctx, item.UserID, item.ID)
+		assert.NoError(t, err)
+
+		got, err := itemRepo.Get(ctx, item.UserID, item.ID)
+		assert.NoError(t, err)
+		// 휴지통 이동과 복원으로 버전이 2 증가합니다.
+		item.Version += 2
+		assert.Equal(t, item, got)
+	})
+
+	t.Run("nil context", func(t *testing.T) {
+		err := itemRepo.Restore(nil, user.ID, 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid userID", func(t *testing.T) {
+		err := itemRepo.Restore(ctx, 0, 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid itemID", func(t *testing.T) {
+		err := itemRepo.Restore(ctx, user.ID, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("context without conn", func(t *testing.T) {
+		err := itemRepo.Restore(context.TODO(), user.ID, 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("삭제되지 않은 아이템", func(t *testing.T) {
+		item := newTestItem(t, user.ID)
+		err := itemRepo.Create(ctx, item)
+		assert.NoError(t, err)
+
+		err = itemRepo.Restore(ctx, item.UserID, item.ID)
+		assert.ErrorIs(t, err, domain.ErrItemNotFound)
+	})
+
+	t.Run("이름 중복", func(t *testing.T) {
+		item := newTestItem(t, user.ID)
+		err := itemRepo.Create(ctx, item)
+		assert.NoError(t, err)
+		err = itemRepo.Delete(ctx, item.UserID, item.ID, 0)
+		assert.NoError(t, err)
+
+		newItem := newTestItem(t, user.ID)
+		newItem.Name = item.Name
+		err = itemRepo.Create(ctx, newItem)
+		assert.NoError(t, err)
+
+		err = itemRepo.Restore(ctx, item.UserID, item.ID)
+		assert.ErrorIs(t, err, domain.ErrItemAlreadyExists)
+	})
+}
+
+func (s *Suite) TestItemRepository_Purge() {
+	t := s.T()
+	ctx := db.ContextWithConn(context.TODO(), s.Conn)
+	userRepo := rdb.NewUserRepository(s.Dialect)
+	user := newTestUser(t)
+	err := userRepo.Create(ctx, user)
+	assert.NoError(t, err)
+	itemRepo := rdb.NewItemRepository(s.Dialect)
+
+	t.Run("OK", func(t *testing.T) {
+		item := newTestItem(t, user.ID)
+		err := itemRepo.Create(ctx, item)
+		assert.NoError(t, err)
+
+		err = itemRepo.Purge(ctx, item.UserID, item.ID, 0)
+		assert.NoError(t, err)
+
+		err = itemRepo.Restore(ctx, item.UserID, item.ID)
+		assert.ErrorIs(t, err, domain.ErrItemNotFound)
+	})
+
+	t.Run("휴지통의 아이템", func(t *testing.T) {
+		item := newTestItem(t, user.ID)
+		err := itemRepo.Create(ctx, item)
+		assert.NoError(t, err)
+		err = itemRepo.Delete(ctx, item.UserID, item.ID, 0)
+		assert.NoError(t, err)
+
+		err = itemRepo.Purge(ctx, item.UserID, item.ID, 0)
+		assert.NoError(t, err)
+
+		err = itemRepo.Restore(ctx, item.UserID, item.ID)
+		assert.ErrorIs(t, err, domain.ErrItemNotFound)
+	})
+
+	t.Run("버전 불일치", func(t *testing.T) {
+		item := newTestItem(t, user.ID)
+		err := itemRepo.Create(ctx, item)
+		assert.NoError(t, err)
+
+		err = itemRepo.Purge(ctx, item.UserID, item.ID, item.Version+1)
+		assert.ErrorIs(t, err, domain.ErrItemVersionMismatch)
+		err = itemRepo.Purge(ctx, item.UserID, item.ID, item.Version)
+		assert.NoError(t, err)
+	})
+
+	t.Run("nil context", func(t *testing.T) {
+		err := itemRepo.Purge(nil, user.ID, 1, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid userID", func(t *testing.T) {
+		err := itemRepo.Purge(ctx, 0, 1, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid itemID", func(t *testing.T) {
+		err := itemRepo.Purge(ctx, user.ID, 0, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("context without conn", func(t *testing.T) {
+		err := itemRepo.Purge(context.TODO(), user.ID, 1, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("item not found", func(t *testing.T) {
+		err := itemRepo.Purge(ctx, user.ID, gofakeit.Number(100000, 200000), 0)
+		assert.ErrorIs(t, err, domain.ErrItemNotFound)
+	})
+}
+
+func (s *Suite) TestItemRepository_PurgeDeleted() {
+	t := s.T()
+	ctx := db.ContextWithConn(context.TODO(), s.Conn)
+	userRepo := rdb.NewUserRepository(s.Dialect)
+	user := newTestUser(t)
+	err := userRepo.Create(ctx, user)
+	assert.NoError(t, err)
+	itemRepo := rdb.NewItemRepository(s.Dialect)
+
+	t.Run("OK", func(t *testing.T) {
+		deleted := newTestItem(t, user.ID)
+		err := itemRepo.Create(ctx, deleted)
+		assert.NoError(t, err)
+		err = itemRepo.Delete(ctx, deleted.UserID, deleted.ID, 0)
+		assert.NoError(t, err)
+		alive := newTestItem(t, user.ID)
+		err = itemRepo.Create(ctx, alive)
+		assert.NoError(t, err)
+
+		purged, err := itemRepo.PurgeDeleted(ctx, time.Now().Add(-time.Hour))
+		assert.NoError(t, err)
+		assert.Equal(t, 0, purged)
+
+		purged, err = itemRepo.PurgeDeleted(ctx, time.Now().Add(time.Hour))
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, purged, 1)
+
+		err = itemRepo.Restore(ctx, deleted.UserID, deleted.ID)
+		assert.ErrorIs(t, err, domain.ErrItemNotFound)
+		_, err = itemRepo.Get(ctx, alive.UserID, alive.ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("nil context", func(t *testing.T) {
+		_, err := itemRepo.PurgeDeleted(nil, time.Now())
+		assert.Error(t, err)
+	})
+
+	t.Run("zero deletedBefore", func(t *testing.T) {
+		_, err := itemRepo.PurgeDeleted(ctx, time.Time{})
+		assert.Error(t, err)
+	})
+
+	t.Run("context without conn", func(t *testing.T) {
+		_, err := itemRepo.PurgeDeleted(context.TODO(), time.Now())
+		assert.Error(t, err)
+	})
+}
+
+func (s *Suite) TestItemRepository_FindDeleted() {
+	t := s.T()
+	ctx := db.ContextWithConn(context.TODO(), s.Conn)
+	userRepo := rdb.NewUserRepository(s.Dialect)
+	user := newTestUser(t)
+	err := userRepo.Create(ctx, user)
+	assert.NoError(t, err)
+	itemRepo := rdb.NewItemRepository(s.Dialect)
+
+	deletedItems := make([]*domain.Item, 0)
+	for i := 0; i < 15; i++ {
+		item := newTestItem(t, user.ID)
+		err := itemRepo.Create(ctx, item)
+		assert.NoError(t, err)
+		if i%5 == 0 {
+			continue
+		}
+		err = itemRepo.Delete(ctx, item.UserID, item.ID, 0)
+		assert.NoError(t, err)
+		deletedItems = append(deletedItems, item)
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		got, err := itemRepo.FindDeleted(ctx, &repository.FindDeletedItemInput{UserID: user.ID, WithTotal: true})
+		assert.NoError(t, err)
+		assert.Equal(t, len(deletedItems), got.TotalCount)
+		assert.Len(t, got.Items, 10)
+		assert.True(t, got.HasNext)
+		assert.False(t, got.HasPrev)
+		for i, item := range got.Items {
+			assert.Equal(t, deletedItems[i].ID, item.ID)
+			assert.NotNil(t, item.DeletedAt)
+		}
+
+		next := domain.NewItemCursor(nil, &got.Items[len(got.Items)-1], false)
+		got, err = itemRepo.FindDeleted(ctx, &repository.FindDeletedItemInput{UserID: user.ID, Cursor: next})
+		assert.NoError(t, err)
+		assert.Zero(t, got.TotalCount)
+		assert.Len(t, got.Items, len(deletedItems)-10)
+		assert.False(t, got.HasNext)
+		assert.True(t, got.HasPrev)
+		assert.Equal(t, deletedItems[10].ID, got.Items[0].ID)
+
+		prev := domain.NewItemCursor(nil, &got.Items[0], true)
+		got, err = itemRepo.FindDeleted(ctx, &repository.FindDeletedItemInput{UserID: user.ID, Cursor: prev, Limit: 3})
+		assert.NoError(t, err)
+		assert.Len(t, got.Items, 3)
+		assert.True(t, got.HasNext)
+		assert.True(t, got.HasPrev)
+		assert.Equal(t, deletedItems[7].ID, got.Items[0].ID)
+	})
+
+	t.Run("정렬 순서가 다른 커서", func(t *testing.T) {
+		cursor := domain.NewItemCursor(domain.ItemSortOrder{{Key: domain.ItemSortKeyName}}, deletedItems[0], false)
+		got, err := itemRepo.FindDeleted(ctx, &repository.FindDeletedItemInput{UserID: user.ID, Cursor: cursor})
+		assert.ErrorIs(t, err, domain.ErrInvalidItemCursor)
+		assert.Nil(t, got)
+	})
+
+	t.Run("nil context", func(t *testing.T) {
+		got, err := itemRepo.FindDeleted(nil, &repository.FindDeletedItemInput{UserID: user.ID})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("nil input", func(t *testing.T) {
+		got, err := itemRepo.FindDeleted(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		got, err := itemRepo.FindDeleted(ctx, &repository.FindDeletedItemInput{})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("context without conn", func(t *testing.T) {
+		_, err := itemRepo.FindDeleted(context.TODO(), &repository.FindDeletedItemInput{UserID: user.ID})
+		assert.Error(t, err)
+	})
+}
+
+func (s *Suite) TestItemRepository_Update() {
+	t := s.T()
+	ctx := db.ContextWithConn(context.TODO(), s.Conn)
+	userRepo := rdb.NewUserRepository(s.Dialect)
+	user := newTestUser(t)
+	err := userRepo.Create(ctx, user)
+	assert.NoError(t, err)
+	itemRepo := rdb.NewItemRepository(s.Dialect)
+
+	t.Run("OK", func(t *testing.T) {
+		item := newTestItem(t, user.ID)
+		err = itemRepo.Create(ctx, item)
+		assert.NoError(t, err)
+
+		name := gofakeit.UUID()
+		description := gofakeit.SentenceSimple()
+		price := gofakeit.Number(1000, 10000)
+		cost := gofakeit.Number(1000, 10000)
+		category := gofakeit.SentenceSimple()
+		barcode := gofakeit.RandomString([]string{"coffee", "tea", "desert"})
+		size := domain.ItemSizeLarge
+		expiryAt := time.Unix(gofakeit.FutureDate().Unix(), 0).UTC()
+		input := &repository.UpdateItemInput{
+			Name:        &name,
+			Description: &description,
+			Price:       &price,
+			Cost:        &cost,
+			Category:    &category,
+			Barcode:     &barcode,
+			Size:        &size,
+			ExpiryAt:    &expiryAt,
+		}
+		err := itemRepo.Update(ctx, item.UserID, item.ID, input)
+		assert.NoError(t, err)
+
+		var expected domain.Item
+		err = copier.Copy(&expected, item)
+		assert.NoError(t, err)
+		got, err := itemRepo.Get(ctx, item.UserID, item.ID)
+		assert.NoError(t, err)
+
+		expected.Name = name
+		expected.Description = description
+		expected.Price = price
+		expected.Cost = cost
+		expected.Category = category
+		expected.Barcode = barcode
+		expected.Size = size
+		expected.ExpiryAt = expiryAt
+		expected.Version = item.Version + 1
+
+		assert.Equal(t, &expected, got)
+	})
+
+	t.Run("부분 업데이트", func(t *testing.T) {
+		item := newTestItem(t, user.ID)
+		err = itemRepo.Create(ctx, item)
+		assert.NoError(t, err)
+
+		name := gofakeit.UUID()
+		expiryAt := time.Unix(gofakeit.FutureDate().Unix(), 0).UTC()
+		input := &repository.UpdateItemInput{
+			Name:     &name,
+			ExpiryAt: &expiryAt,
+		}
+		err := itemRepo.Update(ctx, item.UserID, item.ID, input)
+		assert.NoError(t, err)
+
+		var expected domain.Item
+		err = copier.Copy(&expected, item)
+		assert.NoError(t, err)
+		got, err := itemRepo.Get(ctx, item.UserID, item.ID)
+		assert.NoError(t, err)
+
+		expected.Name = name
+		expected.ExpiryAt = expiryAt
+		expected.Version = item.Version + 1
+
+		assert.Equal(t, &expected, got)
+	})
+
+	t.Run("버전 일치", func(t *testing.T) {
+		item := newTestItem(t, user.ID)
+		err = itemRepo.Create(ctx, item)
+		assert.NoError(t, err)
+
+		price := gofakeit.Number(1000, 10000)
+		err := itemRepo.Update(ctx, item.UserID, item.ID, &repository.UpdateItemInput{Price: &price, Version: item.Version})
+		assert.NoError(t, err)
+
+		got, err := itemRepo.Get(ctx, item.UserID, item.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, price, got.Price)
+		assert.Equal(t, item.Version+1, got.Version)
+	})
+
+	t.Run("버전 불일치", func(t *testing.T) {
+		item := newTestItem(t, user.ID)
+		err = itemRepo.Create(ctx, item)
+		assert.NoError(t, err)
+
+		price := gofakeit.Number(1000, 10000)
+		err := itemRepo.Update(ctx, item.UserID, item.ID, &repository.UpdateItemInput{Price: &price})
+		assert.NoError(t, err)
+		err = itemRepo.Update(ctx, item.UserID, item.ID, &repository.UpdateItemInput{Price: &price, Version: item.Version})
+		assert.ErrorIs(t, err, domain.ErrItemVersionMismatch)
+	})
+
+	t.Run("item not found", func(t *testing.T) {
+		price := gofakeit.Number(1000, 10000)
+		err := itemRepo.Update(ctx, user.ID, gofakeit.Number(100000, 200000), &repository.UpdateItemInput{Price: &price, Version: 1})
+		assert.ErrorIs(t, err, domain.ErrItemNotFound)
+	})
+
+	t.Run("nil context", func(t *testing.T) {
+		item := newTestItem(t, user.ID)
+		err = itemRepo.Create(ctx, item)
+		assert.NoError(t, err)
+
+		name := gofakeit.Drink()
+		expiryAt := time.Unix(gofakeit.FutureDate().Unix(), 0).UTC()
+		input := &repository.UpdateItemInput{
+			Name:     &name,
+			ExpiryAt: &expiryAt,
+		}
+
+		err = itemRepo.Update(nil, item.UserID, item.ID, input)
+		assert.Error(t, err)
+
+	})
+
+	t.Run("invalid userID", func(t *testing.T) {
+		item := newTestItem(t, user.ID)
+		err = itemRepo.Create(ctx, item)
+		assert.NoError(t, err)
+
+		name := gofakeit.Drink()
+		expiryAt := time.Unix(gofakeit.FutureDate().Unix(), 0).UTC()
+		input := &repository.UpdateItemInput{
+			Name:     &name,
+			ExpiryAt: &expiryAt,
+		}
+		err := itemRepo.Update(ctx, 0, item.ID, input)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid itemID", func(t *testing.T) {
+		item := newTestItem(t, user.ID)
+		err = itemRepo.Create(ctx, item)
+		assert.NoError(t, err)
+
+		name := gofakeit.Drink()
+		expiryAt := time.Unix(gofakeit.FutureDate().Unix(), 0).UTC()
+		input := &repository.UpdateItemInput{
+			Name:     &name,
+			ExpiryAt: &expiryAt,
+		}
+		err := itemRepo.Update(ctx, item.UserID, 0, input)
+		assert.Error(t, err)
+	})
+
+	t.Run("nil input", func(t *testing.T) {
+		item := newTestItem(t, user.ID)
+		err = itemRepo.Create(ctx, item)
+		assert.NoError(t, err)
+		err := itemRepo.Update(ctx, item.UserID, item.ID, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		item := newTestItem(t, user.ID)
+		err = itemRepo.Create(ctx, item)
+		assert.NoError(t, err)
+		err := itemRepo.Update(ctx, item.UserID, item.ID, &repository.UpdateItemInput{})
+		assert.Error(t, err)
+	})
+
+	t.Run("context without conn", func(t *testing.T) {
+		item := newTestItem(t, user.ID)
+		err = itemRepo.Create(ctx, item)
+		assert.NoError(t, err)
+
+		name := gofakeit.Drink()
+		expiryAt := time.Unix(gofakeit.FutureDate().Unix(), 0).UTC()
+		input := &repository.UpdateItemInput{
+			Name:     &name,
+			ExpiryAt: &expiryAt,
+		}
+		err := itemRepo.Update(context.TODO(), item.UserID, item.ID, input)
+		assert.Error(t, err)
+	})
+
+	t.Run("이름 중복", func(t *testing.T) {
+		item := newTestItem(t, user.ID)
+		err = itemRepo.Create(ctx, item)
+		assert.NoError(t, err)
+
+		item2 := newTestItem(t, user.ID)
+		err = itemRepo.Create(ctx, item2)
+		assert.NoError(t, err)
+
+		expiryAt := time.Unix(gofakeit.FutureDate().Unix(), 0).UTC()
+		input := &repository.UpdateItemInput{
+			Name:     &item2.Name,
+			ExpiryAt: &expiryAt,
+		}
+		err := itemRepo.Update(ctx, item.UserID, item.ID, input)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, domain.ErrItemAlreadyExists)
+	})
+}
+
+func (s *Suite) TestItemRepository_Find() {
+	t := s.T()
+	ctx := db.ContextWithConn(context.TODO(), s.Conn)
+	userRepo := rdb.NewUserRepository(s.Dialect)
+	user := newTestUser(t)
+	err := userRepo.Create(ctx, user)
+	assert.NoError(t, err)
+	itemRepo := rdb.NewItemRepository(s.Dialect)
+
+	for _, itemName := range []string{
+		"슈크림 라떼",
+		"카페 아메리카노",
+		"카페 라떼",
+		"사케라또 아포가토",
+		"스파클링 시트러스 에스프레소",
+		"클래식 아포가토",
+		"사케라또 비안코 오버 아이스",
+		"아이스 다크 초콜릿 모카",
+		"아이스 바닐라 빈 라떼",
+		"코르타도",
+		"에스프레소",
+		"아이스 리벤더 카페 브레베",
+		"프렌치 애플 타르트 나이트로",
+		"벨벳 다크 모카 나이트로",
+		"리저브 나이트로",
+		"콜드 브루 몰트",
+		"콜드 브루 플로트",
+		"리저브 콜드 브루",
+		"아이스 에콰도르 로하",
+		"아이스 선드라이드 브라질 아이피 에스테이트",
+		"아이스 슬라웨시 토라자 사판 빌리지",
+		"아이스 에이지드 수마트라 빈티지 2021",
+		"아이스 코스타리카 나랑호",
+		"콜드 브루 오트 라떼",
+		"돌체 콜드 브루",
+		"바닐라 크림 콜드 브루",
+		"콜드 브루",
+		"나이트로 바닐라 크림",
+		"나이트로 콜드 브루",
+		"제주 비자림 콜드 브루",
+		"아이스 블론드 에스프레소 라떼",
+		"아이스 블론드 바닐라 더블 샷 마키아또",
+		"아이스 블론드 스타벅스 돌체 라떼",
+		"아이스 블론드 카페 라떼",
+		"아이스 블론드 카페 아메리카노",
+		"아이스 별다방 바닐라 라떼",
+		"바닐라 플랫 화이트",
+		"아이스 스타벅스 돌체 라떼",
+		"아이스 카페 모카",
+		"아이스 카페 아메리카노",
+		"아이스 카페 라떼",
+		"아이스 카푸치노",
+		"아이스 카라멜 마키아또",
+		"아이스 화이트 초콜릿 모카",
+		"커피 스타벅스 더블 샷",
+		"바닐라 스타벅스 더블 샷",
+		"헤이즐넛 스타벅스 더블샷",
+		"에스프레스",
+		"에스프레소 마키아또",
+		"에스프레소 콘 파나",
+		"제주 별다방 땅콩 라떼",
+		"아이스 디카페인 스타벅스 돌체 라떼",
+		"아이스 디카페인 카라멜 마키아또",
+		"아이스 디카페인 카페 라떼",
+		"아이스 디카페인 카페 아메리카노",
+		"아이스 1/2 디카페인 스타벅스 돌체 라떼",
+		"아이스 1/2디카페인 카라멜 마키아또",
+		"아이스 1/2디카페인 카페 라떼",
+		"아이스 1/2디카페인 카페 아메리카노",
+		"돌체 카라멜 칩 커피 프라푸치노",
+		"더블 에스프레소 칩 프라푸치노",
+		"제주 유기농 말차로 만든 크림 프라푸치노",
+		"자바 칩 프라푸치노",
+		"화이트 딸기 크림 프라푸치노",
+		"초콜릿 크림 칩 프라푸치노",
+		"화이트 초콜릿 모카 프라푸치노",
+		"모카 프라푸치노",
+		"카라멜 프라푸치노",
+		"에스프레소 프라푸치노",
+		"바닐라 크림 프라푸치노",
+		"제주 까망 크림 프라푸치노",
+		"제주 쑥떡 크림 프라푸치노",
+		"제주 별다아 땅콩 프라푸치노",
+		"화이트 타이거 프라푸치노",
+		"돌체 딸기 크림 프라푸치노",
+		"트리플 초콜릿 칩 커피 프라푸치노",
+		"트리플 초콜릿 칩 크림 프라푸치노",
+		"딸기 레몬 블렌디드",
+		"민트 초콜릿 칩 블렌디드",
+		"딸기 딜라이트 요거트 블렌디드",
+		"피치&레몬 블렌디드",
+		"망고 바나나 블렌디드",
+		"망고 패션 후르츠 블렌디드",
+		"제주 천혜향 블랙 티 블렌디드",
+		"쿨 라임 피지오",
+		"블랙 티 레모네이드 피지오",
+		"패션 탱고 티 레모네이드 피지오",
+		"스타벅스 파인애플 선셋 아이스티",
+		"아이스 패션 푸르트 티",
+		"아이스 유자 민트 티",
+		"아이스 돌체 블랙 밀크 티",
+		"피치 젤리 아이스티",
+		"아이스 제주 유기농 말차로 만든 라떼",
+		"아이스 차이 티 라떼",
+		"아이스 라임 패션 티",
+		"아이스 자몽 허니 블랙티",
+		"아이스 제주 유기 녹차",
+		"아이스 잉글리쉬 브렉퍼스트 티",
+		"아이스 얼 그레이 티",
+		"아이스 유스베리 티",
+		"아이스 히비스커스 블렌드 티",
+		"아이스 민트 블렌드 티",
+		"아이스 캐모마일 블렌드 티",
+		"아이스 별궁 오미자 유스베리 티",
+		"아이스 콩고물 블랙 밀크 티",
+		"아이스 푸를 청귤 민트 티",
+		"아이스 허니 얼 그레이 밀크 티",
+		"아이스 피치 히비스커스 티",
+		"오늘의 커피",
+		"아이스 커피",
+		"아이스 시그니처 초콜릿",
+		"스팀 우유",
+		"우유",
+		"제주 쑥쑥 라떼",
+		"아이스 제주 까망 라떼",
+		"제주 청귤 레모네이드",
+		"플러피 판다 아이스 초콜릿",
+		"스타벅스 슬래머",
+		"파이팅 청귤",
+		"도와줘 흑흑",
+		"퍼플베리 굿",
+		"기운내라임",
+		"한방에 쭉 감당",
+		"햇사과 주스",
+		"수박주스",
+		"딸리주스",
+		"망고주스",
+		"케일&사과주스",
+		"한라봉 주스",
+		"토마토주스",
+		"블루베리 요거트",
+		"치아씨드 요거트",
+	} {
+		item := newTestItem(t, user.ID)
+		item.Name = itemName
+		err = itemRepo.Create(ctx, item)
+		assert.NoError(t, err)
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		page1, err := itemRepo.Find(ctx, &repository.FindItemInput{
+			UserID:    user.ID,
+			Keywords:  []string{"라떼"},
+			WithTotal: true,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 19, page1.TotalCount)
+		assert.Equal(t, 10, len(page1.Items))
+		assert.True(t, page1.HasNext)
+		assert.False(t, page1.HasPrev)
+
+		page2, err := itemRepo.Find(ctx, &repository.FindItemInput{
+			UserID:   user.ID,
+			Keywords: []string{"라떼"},
+			Cursor:   domain.NewItemCursor(nil, &page1.Items[len(page1.Items)-1], false),
+		})
+		assert.NoError(t, err)
+		// 전체 아이템 수는 WithTotal이 true인 경우에만 조회합니다.
+		assert.Zero(t, page2.TotalCount)
+		assert.Equal(t, 9, len(page2.Items))
+		assert.False(t, page2.HasNext)
+		assert.True(t, page2.HasPrev)
+	})
+
+	t.Run("초성 검색", func(t *testing.T) {
+		got, err := itemRepo.Find(ctx, &repository.FindItemInput{
+			UserID:    user.ID,
+			Keywords:  []string{"ㅋㅍ ㄹㄸ"},
+			WithTotal: true,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 5, got.TotalCount)
+		assert.Equal(t, 5, len(got.Items))
+		assert.False(t, got.HasNext)
+	})
+
+	t.Run("키워드 중 하나 이상 포함", func(t *testing.T) {
+		got, err := itemRepo.Find(ctx, &repository.FindItemInput{
+			UserID:    user.ID,
+			Keywords:  []string{"ㅋㅍ ㄹㄸ", "아메리카노"},
+			WithTotal: true,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 10, got.TotalCount)
+		assert.Equal(t, 10, len(got.Items))
+		assert.False(t, got.HasNext)
+	})
+
+	t.Run("전문 검색", func(t *testing.T) {
+		got, err := itemRepo.Find(ctx, &repository.FindItemInput{
+			UserID:    user.ID,
+			Keywords:  []string{"아메리카노"},
+			WithTotal: true,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 5, got.TotalCount)
+		assert.Equal(t, 5, len(got.Items))
+		assert.False(t, got.HasNext)
+	})
+
+	t.Run("nil context", func(t *testing.T) {
+		got, err := itemRepo.Find(nil, &repository.FindItemInput{
+			UserID:   user.ID,
+			Keywords: []string{"라떼"},
+		})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("nil input", func(t *testing.T) {
+		got, err := itemRepo.Find(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("context without conn", func(t *testing.T) {
+		got, err := itemRepo.Find(context.TODO(), &repository.FindItemInput{
+			UserID:   user.ID,
+			Keywords: []string{"라떼"},
+		})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		got, err := itemRepo.Find(ctx, &repository.FindItemInput{
+			UserID:   0,
+			Keywords: []string{"라떼"},
+		})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+}
+
+func (s *Suite) TestItemRepository_Find_Filter() {
+	t := s.T()
+	ctx := db.ContextWithConn(context.TODO(), s.Conn)
+	userRepo := rdb.NewUserRepository(s.Dialect)
+	user := newTestUser(t)
+	err := userRepo.Create(ctx, user)
+	assert.NoError(t, err)
+	itemRepo := rdb.NewItemRepository(s.Dialect)
+
+	now := time.Unix(time.Now().Unix(), 0).UTC()
+	kst := time.FixedZone("KST", 9*60*60)
+	items := make([]*domain.Item, 4)
+	for i := range items {
+		item := newTestItem(t, user.ID)
+		item.Category = []string{"coffee", "coffee", "tea", "desert"}[i]
+		item.Size = []domain.ItemSize{domain.ItemSizeSmall, domain.ItemSizeLarge, domain.ItemSizeSmall, domain.ItemSizeLarge}[i]
+		item.Price = 1000 * (i + 1)
+		item.Cost = 500 * (i + 1)
+		item.Barcode = gofakeit.Numerify("##################")
+		// 시간대가 다른 시각도 같은 기준으로 비교되는지 확인하기 위해 KST로 저장합니다.
+		item.ExpiryAt = now.AddDate(0, 0, i+1).In(kst)
+		item.CreatedAt = now.Add(time.Duration(i-4) * time.Hour)
+		err = itemRepo.Create(ctx, item)
+		assert.NoError(t, err)
+		items[i] = item
+	}
+
+	intPtr := func(v int) *int { return &v }
+	timePtr := func(v time.Time) *time.Time { return &v }
+	tests := []struct {
+		name   string
+		filter domain.ItemFilter
+		want   []int
+	}{
+		{name: "카테고리", filter: domain.ItemFilter{Categories: []string{"coffee", "tea"}}, want: []int{0, 1, 2}},
+		{name: "크기", filter: domain.ItemFilter{Size: domain.ItemSizeLarge}, want: []int{1, 3}},
+		{name: "가격 범위", filter: domain.ItemFilter{MinPrice: intPtr(2000), MaxPrice: intPtr(3000)}, want: []int{1, 2}},
+		{name: "원가 범위", filter: domain.ItemFilter{MaxCost: intPtr(1000)}, want: []int{0, 1}},
+		{name: "유통기한 범위", filter: domain.ItemFilter{ExpiresAfter: timePtr(now.AddDate(0, 0, 2)), ExpiresBefore: timePtr(now.AddDate(0, 0, 4))}, want: []int{1, 2}},
+		{name: "생성일 범위", filter: domain.ItemFilter{CreatedAfter: timePtr(now.Add(-2 * time.Hour))}, want: []int{2, 3}},
+		{name: "바코드", filter: domain.ItemFilter{Barcode: items[3].Barcode}, want: []int{3}},
+		{name: "여러 조건", filter: domain.ItemFilter{Categories: []string{"coffee"}, Size: domain.ItemSizeSmall}, want: []int{0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := itemRepo.Find(ctx, &repository.FindItemInput{
+				UserID:    user.ID,
+				Filter:    tt.filter,
+				WithTotal: true,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, len(tt.want), got.TotalCount)
+			var gotIDs, wantIDs []int
+			for _, item := range got.Items {
+				gotIDs = append(gotIDs, item.ID)
+			}
+			for _, i := range tt.want {
+				wantIDs = append(wantIDs, items[i].ID)
+			}
+			assert.ElementsMatch(t, wantIDs, gotIDs)
+		})
+	}
+
+	t.Run("집계", func(t *testing.T) {
+		got, err := itemRepo.Find(ctx, &repository.FindItemInput{
+			UserID:     user.ID,
+			Filter:     domain.ItemFilter{Size: domain.ItemSizeLarge},
+			WithFacets: true,
+		})
+		assert.NoError(t, err)
+		require.NotNil(t, got.Facets)
+		assert.Equal(t, []domain.ItemFacetCount{{Value: "coffee", Count: 1}, {Value: "desert", Count: 1}}, got.Facets.Categories)
+		assert.Equal(t, []domain.ItemFacetCount{{Value: "small", Count: 0}, {Value: "large", Count: 2}}, got.Facets.Sizes)
+		counts := make([]int, len(got.Facets.PriceBuckets))
+		for i, bucket := range got.Facets.PriceBuckets {
+			counts[i] = bucket.Count
+		}
+		assert.Equal(t, []int{0, 1, 1, 0, 0, 0}, counts)
+
+		// 집계는 WithFacets가 true인 경우에만 조회합니다.
+		got, err = itemRepo.Find(ctx, &repository.FindItemInput{UserID: user.ID})
+		assert.NoError(t, err)
+		assert.Nil(t, got.Facets)
+	})
+
+	t.Run("잘못된 범위", func(t *testing.T) {
+		got, err := itemRepo.Find(ctx, &repository.FindItemInput{
+			UserID: user.ID,
+			Filter: domain.ItemFilter{MinPrice: intPtr(3000), MaxPrice: intPtr(1000)},
+		})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+}
+
+func (s *Suite) TestItemRepository_Find_NameLength() {
+	t := s.T()
+	ctx := db.ContextWithConn(context.TODO(), s.Conn)
+	userRepo := rdb.NewUserRepository(s.Dialect)
+	user := newTestUser(t)
+	err := userRepo.Create(ctx, user)
+	assert.NoError(t, err)
+	itemRepo := rdb.NewItemRepository(s.Dialect)
+
+	// 자모 수는 각각 4, 10, 16이며, 마지막 아이템은 자모 수를 저장하기 전에 등록된 아이템입니다.
+	items := make([]*domain.Item, 4)
+	for i, name := range []string{"라떼", "아메리카노", "아이스 아메리카노", "콜드 브루"} {
+		item := newTestItem(t, user.ID)
+		item.Name = name
+		err = itemRepo.Create(ctx, item)
+		assert.NoError(t, err)
+		items[i] = item
+	}
+	err = s.Conn.Model(&rdb.Item{}).Where("item_id = ?", items[3].ID).Update("item_name_length", nil).Error
+	require.NoError(t, err)
+
+	intPtr := func(v int) *int { return &v }
+	t.Run("OK", func(t *testing.T) {
+		got, err := itemRepo.Find(ctx, &repository.FindItemInput{
+			UserID:        user.ID,
+			MinNameLength: intPtr(6),
+			MaxNameLength: intPtr(16),
+		})
+		assert.NoError(t, err)
+		var gotIDs []int
+		for _, item := range got.Items {
+			gotIDs = append(gotIDs, item.ID)
+		}
+		assert.ElementsMatch(t, []int{items[1].ID, items[2].ID, items[3].ID}, gotIDs)
+	})
+
+	t.Run("이름을 수정하면 자모 수도 수정", func(t *testing.T) {
+		name := "아이스 라떼"
+		err := itemRepo.Update(ctx, user.ID, items[3].ID, &repository.UpdateItemInput{Name: &name})
+		require.NoError(t, err)
+
+		got, err := itemRepo.Find(ctx, &repository.FindItemInput{
+			UserID:        user.ID,
+			MaxNameLength: intPtr(8),
+		})
+		assert.NoError(t, err)
+		var gotIDs []int
+		for _, item := range got.Items {
+			gotIDs = append(gotIDs, item.ID)
+		}
+		assert.ElementsMatch(t, []int{items[0].ID}, gotIDs)
+	})
+
+	t.Run("잘못된 범위", func(t *testing.T) {
+		got, err := itemRepo.Find(ctx, &repository.FindItemInput{
+			UserID:        user.ID,
+			MinNameLength: intPtr(10),
+			MaxNameLength: intPtr(6),
+		})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+}
+
+func (s *Suite) TestItemRepository_GetStamp() {
+	t := s.T()
+	ctx := db.ContextWithConn(context.TODO(), s.Conn)
+	userRepo := rdb.NewUserRepository(s.Dialect)
+	user := newTestUser(t)
+	err := userRepo.Create(ctx, user)
+	assert.NoError(t, err)
+	itemRepo := rdb.NewItemRepository(s.Dialect)
+	get := func(t *testing.T) repository.ItemStamp {
+		stamp, err := itemRepo.GetStamp(ctx, user.ID)
+		require.NoError(t, err)
+		return *stamp
+	}
+	assert.Equal(t, repository.ItemStamp{}, get(t))
+
+	item := newTestItem(t, user.ID)
+	err = itemRepo.Create(ctx, item)
+	require.NoError(t, err)
+	stamps := []repository.ItemStamp{get(t)}
+	assert.Equal(t, repository.ItemStamp{Count: 1, MaxItemID: item.ID, VersionSum: 1}, stamps[0])
+
+	// 아이템을 변경할 때마다 stamp가 달라집니다.
+	price := item.Price + 1
+	require.NoError(t, itemRepo.Update(ctx, user.ID, item.ID, &repository.UpdateItemInput{Price: &price}))
+	stamps = append(stamps, get(t))
+	require.NoError(t, itemRepo.Delete(ctx, user.ID, item.ID, 0))
+	stamps = append(stamps, get(t))
+	require.NoError(t, itemRepo.Restore(ctx, user.ID, item.ID))
+	stamps = append(stamps, get(t))
+	other := newTestItem(t, user.ID)
+	require.NoError(t, itemRepo.Create(ctx, other))
+	stamps = append(stamps, get(t))
+	require.NoError(t, itemRepo.Purge(ctx, user.ID, other.ID, 0))
+	stamps = append(stamps, get(t))
+	for i := 1; i < len(stamps); i++ {
+		assert.NotEqual(t, stamps[i-1], stamps[i], "%d", i)
+	}
+	// 생성한 아이템을 영구 삭제하면 아이템이 같으므로 생성 전과 stamp가 같습니다.
+	assert.Equal(t, stamps[3], stamps[5])
+
+	t.Run("nil context", func(t *testing.T) {
+		got, err := itemRepo.GetStamp(nil, user.ID)
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("invalid userID", func(t *testing.T) {
+		got, err := itemRepo.GetStamp(ctx, 0)
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+}
+
+func (s *Suite) TestItemRepository_Find_Sort() {
+	t := s.T()
+	ctx := db.ContextWithConn(context.TODO(), s.Conn)
+	userRepo := rdb.NewUserRepository(s.Dialect)
+	user := newTestUser(t)
+	err := userRepo.Create(ctx, user)
+	assert.NoError(t, err)
+	itemRepo := rdb.NewItemRepository(s.Dialect)
+
+	now := time.Unix(time.Now().Unix(), 0).UTC()
+	kst := time.FixedZone("KST", 9*60*60)
+	items := make([]domain.Item, 25)
+	for i := range items {
+		item := newTestItem(t, user.ID)
+		// 정렬 기준 값이 같은 아이템이 여러 페이지에 걸치도록 가격과 유통기한을 중복해서 설정합니다.
+		item.Price = 1000 * (i%4 + 1)
+		item.ExpiryAt = now.AddDate(0, 0, i%3).In(kst)
+		err = itemRepo.Create(ctx, item)
+		assert.NoError(t, err)
+		items[i] = *item
+	}
+
+	for _, sort := range []string{"", "-price", "price,-expiryAt", "expiryAt,name", "-createdAt,cost"} {
+		t.Run(sort, func(t *testing.T) {
+			order, err := domain.ParseItemSortOrder(sort)
+			assert.NoError(t, err)
+			want := make([]int, len(items))
+			sorted := slices.Clone(items)
+			slices.SortFunc(sorted, func(a, b domain.Item) int {
+				return order.Compare(&a, &b)
+			})
+			for i := range sorted {
+				want[i] = sorted[i].ID
+			}
+
+			// 다음 페이지가 없을 때까지 조회한 뒤 이전 페이지를 다시 조회합니다.
+			var pages [][]int
+			var all []int
+			var cursor *domain.ItemCursor
+			for {
+				got, err := itemRepo.Find(ctx, &repository.FindItemInput{UserID: user.ID, Sort: order, Cursor: cursor, WithTotal: true})
+				assert.NoError(t, err)
+				assert.Equal(t, len(items), got.TotalCount)
+				assert.Equal(t, len(pages) > 0, got.HasPrev)
+				ids := make([]int, len(got.Items))
+				for i := range got.Items {
+					ids[i] = got.Items[i].ID
+				}
+				pages = append(pages, ids)
+				all = append(all, ids...)
+				if !got.HasNext {
+					break
+				}
+				cursor = domain.NewItemCursor(order, &got.Items[len(got.Items)-1], false)
+			}
+			assert.Equal(t, want, all)
+			assert.Len(t, pages, 3)
+
+			cursor = domain.NewItemCursor(order, &sorted[20], true)
+			for i := len(pages) - 2; i >= 0; i-- {
+				got, err := itemRepo.Find(ctx, &repository.FindItemInput{UserID: user.ID, Sort: order, Cursor: cursor})
+				assert.NoError(t, err)
+				assert.True(t, got.HasNext)
+				assert.Equal(t, i > 0, got.HasPrev)
+				ids := make([]int, len(got.Items))
+				for j := range got.Items {
+					ids[j] = got.Items[j].ID
+				}
+				assert.Equal(t, pages[i], ids)
+				cursor = domain.NewItemCursor(order, &got.Items[0], true)
+			}
+		})
+	}
+
+	t.Run("limit", func(t *testing.T) {
+		order := domain.ItemSortOrder{{Key: domain.ItemSortKeyPrice}}
+		var all []int
+		var cursor *domain.ItemCursor
+		for page := 0; ; page++ {
+			got, err := itemRepo.Find(ctx, &repository.FindItemInput{UserID: user.ID, Sort: order, Cursor: cursor, Limit: 7})
+			assert.NoError(t, err)
+			assert.Equal(t, min(7, len(items)-page*7), len(got.Items))
+			for i := range got.Items {
+				all = append(all, got.Items[i].ID)
+			}
+			if !got.HasNext {
+				break
+			}
+			cursor = domain.NewItemCursor(order, &got.Items[len(got.Items)-1], false)
+		}
+		assert.Len(t, all, len(items))
+
+		// 마지막 페이지의 첫 아이템에서 이전 페이지를 조회합니다.
+		first := slices.IndexFunc(items, func(item domain.Item) bool {
+			return item.ID == all[21]
+		})
+		got, err := itemRepo.Find(ctx, &repository.FindItemInput{UserID: user.ID, Sort: order, Cursor: domain.NewItemCursor(order, &items[first], true), Limit: 7})
+		assert.NoError(t, err)
+		ids := make([]int, len(got.Items))
+		for i := range got.Items {
+			ids[i] = got.Items[i].ID
+		}
+		assert.Equal(t, all[14:21], ids)
+		assert.True(t, got.HasPrev)
+		assert.True(t, got.HasNext)
+	})
+
+	t.Run("정렬 순서가 다른 커서", func(t *testing.T) {
+		got, err := itemRepo.Find(ctx, &repository.FindItemInput{
+			UserID: user.ID,
+			Sort:   domain.ItemSortOrder{{Key: domain.ItemSortKeyPrice}},
+			Cursor: domain.NewItemCursor(nil, &items[0], false),
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidItemCursor)
+		assert.Nil(t, got)
+	})
+}
+
+// BenchmarkItemRepositoryFind 아이템이 많은 유저의 목록 조회 성능을 측정합니다.
+// 전체 아이템 수 조회(withTotal) 여부에 따른 차이를 비교합니다.
+func BenchmarkItemRepositoryFind(b *testing.B, conn *gorm.DB, dialect rdb.Dialect) {
+	const seedItemCount = 10000
+	ctx := db.ContextWithConn(context.TODO(), conn.Session(&gorm.Session{Logger: logger.Discard}))
+	user, err := domain.NewUser(gofakeit.Regex(`^01\d{8,9}$`), gofakeit.Password(true, true, true, true, true, 10), time.Now())
+	require.NoError(b, err)
+	require.NoError(b, rdb.NewUserRepository(dialect).Create(ctx, user))
+
+	records := make([]rdb.Item, seedItemCount)
+	for i := range records {
+		name := fmt.Sprintf("%s %s %d", gofakeit.RandomString([]string{"아이스", "따뜻한"}), gofakeit.RandomString([]string{"카페 라떼", "아메리카노", "콜드 브루"}), i)
+		chosung, err := hangul.GetChosung(name)
+		require.NoError(b, err)
+		records[i] = rdb.Item{
+			UserID:          user.ID,
+			Category:        gofakeit.RandomString([]string{"coffee", "tea", "desert"}),
+			ItemName:        name,
+			ItemNameChosung: chosung,
+			Price:           gofakeit.Number(1000, 10000),
+			Cost:            gofakeit.Number(500, 1000),
+			Description:     gofakeit.SentenceSimple(),
+			Barcode:         gofakeit.Numerify("##################"),
+			ItemSize:        domain.ItemSizeSmall,
+			CreatedAt:       time.Now(),
+			ExpiryAt:        gofakeit.FutureDate(),
+			Version:         1,
+		}
+	}
+	seedConn, err := db.ConnFromContext(ctx)
+	require.NoError(b, err)
+	require.NoError(b, seedConn.CreateInBatches(records, 500).Error)
+
+	itemRepo := rdb.NewItemRepository(dialect)
+	for _, bm := range []struct {
+		name  string
+		input repository.FindItemInput
+	}{
+		{name: "전체", input: repository.FindItemInput{UserID: user.ID}},
+		{name: "전체 withTotal", input: repository.FindItemInput{UserID: user.ID, WithTotal: true}},
+		{name: "키워드", input: repository.FindItemInput{UserID: user.ID, Keywords: []string{"라떼"}}},
+		{name: "키워드 withTotal", input: repository.FindItemInput{UserID: user.ID, Keywords: []string{"라떼"}, WithTotal: true}},
+		{name: "정렬", input: repository.FindItemInput{UserID: user.ID, Sort: domain.ItemSortOrder{{Key: domain.ItemSortKeyPrice, Desc: true}}}},
+		{name: "정렬 withTotal", input: repository.FindItemInput{UserID: user.ID, Sort: domain.ItemSortOrder{{Key: domain.ItemSortKeyPrice, Desc: true}}, WithTotal: true}},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := itemRepo.Find(ctx, &bm.input); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func newTestItem(t *testing.T, userID int) *domain.Item {
+	item, err := domain.NewItem(
+		userID,
+		gofakeit.UUID(),
+		gofakeit.SentenceSimple(),
+		gofakeit.Number(5000, 10000),
+		gofakeit.Number(3000, 5000),
+		gofakeit.RandomString([]string{"coffee", "tea", "desert"}),
+		gofakeit.Numerify("##################"),
+		time.Unix(gofakeit.FutureDate().Unix(), 0).UTC(),
+		domain.ItemSize(gofakeit.RandomString([]string{string(domain.ItemSizeSmall), string(domain.ItemSizeLarge)})),
+	)
+	assert.NoError(t, err)
+	item.CreatedAt = time.Unix(time.Now().Unix(), 0).UTC()
+
+	return item
+}
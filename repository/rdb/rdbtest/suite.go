@@ -0,0 +1,19 @@
+// Package rdbtest rdb 저장소를 각 DB에서 같은 방식으로 검증하는 공용 테스트입니다.
+package rdbtest
+
+import (
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"github.com/psi59/payhere-assignment/repository/rdb"
+)
+
+// Suite 마이그레이션을 마친 DB 커넥션 Conn과 해당 DB의 Dialect로 rdb 저장소를 테스트합니다.
+//
+//	suite.Run(t, &rdbtest.Suite{Conn: conn, Dialect: Dialect})
+type Suite struct {
+	suite.Suite
+
+	Conn    *gorm.DB
+	Dialect rdb.Dialect
+}
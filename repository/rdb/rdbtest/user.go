@@ -0,0 +1,228 @@
+package rdbtest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/jinzhu/copier"
+	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/internal/db"
+	"github.com/psi59/payhere-assignment/repository"
+	"github.com/psi59/payhere-assignment/repository/rdb"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *Suite) TestUserRepository_Create() {
+	t := s.T()
+	repo := rdb.NewUserRepository(s.Dialect)
+	ctx := db.ContextWithConn(context.TODO(), s.Conn)
+
+	t.Run("OK", func(t *testing.T) {
+		user := newTestUser(t)
+		err := repo.Create(ctx, user)
+		require.NoError(t, err)
+		require.True(t, user.ID > 0)
+	})
+
+	t.Run("nil Context", func(t *testing.T) {
+		user := newTestUser(t)
+		err := repo.Create(nil, user)
+		require.Error(t, err)
+	})
+
+	t.Run("nil user", func(t *testing.T) {
+		err := repo.Create(ctx, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("context without conn", func(t *testing.T) {
+		user := newTestUser(t)
+		err := repo.Create(context.TODO(), user)
+		require.Error(t, err)
+	})
+
+	t.Run("Duplicated PhoneNumber", func(t *testing.T) {
+		user := newTestUser(t)
+		err := repo.Create(ctx, user)
+		require.NoError(t, err)
+		require.True(t, user.ID > 0)
+
+		var dupl domain.User
+		err = copier.Copy(&dupl, &user)
+		dupl.ID = 0
+		require.NoError(t, err)
+		err = repo.Create(ctx, &dupl)
+		require.ErrorIs(t, err, domain.ErrUserAlreadyExists)
+	})
+}
+
+func newTestUser(t *testing.T) *domain.User {
+	user, err := domain.NewUser(
+		gofakeit.Regex(`^01\d{8,9}$`),
+		gofakeit.Password(true, true, true, true, true, 72),
+		time.Unix(time.Now().Unix(), 0).UTC(),
+	)
+	require.NoError(t, err)
+	return user
+}
+
+func (s *Suite) TestUserRepository_Get() {
+	t := s.T()
+	repo := rdb.NewUserRepository(s.Dialect)
+	ctx := db.ContextWithConn(context.TODO(), s.Conn)
+
+	user := newTestUser(t)
+	err := repo.Create(ctx, user)
+	require.NoError(t, err)
+
+	t.Run("OK", func(t *testing.T) {
+		got, err := repo.Get(ctx, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, user, got)
+	})
+
+	t.Run("nil Context", func(t *testing.T) {
+		got, err := repo.Get(nil, user.ID)
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+
+	t.Run("invalid userID", func(t *testing.T) {
+		got, err := repo.Get(ctx, gofakeit.IntRange(-10, 0))
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+
+	t.Run("context without conn", func(t *testing.T) {
+		got, err := repo.Get(context.TODO(), user.ID)
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+
+	t.Run("UserNotFound", func(t *testing.T) {
+		got, err := repo.Get(ctx, gofakeit.IntRange(10000, 20000))
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+}
+
+func (s *Suite) TestUserRepository_GetByPhoneNumber() {
+	t := s.T()
+	repo := rdb.NewUserRepository(s.Dialect)
+	ctx := db.ContextWithConn(context.TODO(), s.Conn)
+
+	user := newTestUser(t)
+	err := repo.Create(ctx, user)
+	require.NoError(t, err)
+
+	t.Run("OK", func(t *testing.T) {
+		got, err := repo.GetByPhoneNumber(ctx, user.PhoneNumber)
+		require.NoError(t, err)
+		require.Equal(t, user, got)
+	})
+
+	t.Run("nil Context", func(t *testing.T) {
+		got, err := repo.GetByPhoneNumber(nil, user.PhoneNumber)
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+
+	t.Run("empty phoneNumber", func(t *testing.T) {
+		got, err := repo.GetByPhoneNumber(ctx, "")
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+
+	t.Run("context without conn", func(t *testing.T) {
+		got, err := repo.GetByPhoneNumber(context.TODO(), user.PhoneNumber)
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+
+	t.Run("UserNotFound", func(t *testing.T) {
+		got, err := repo.GetByPhoneNumber(ctx, gofakeit.Phone())
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+}
+
+func (s *Suite) TestUserRepository_Update() {
+	t := s.T()
+	repo := rdb.NewUserRepository(s.Dialect)
+	ctx := db.ContextWithConn(context.TODO(), s.Conn)
+
+	user := newTestUser(t)
+	err := repo.Create(ctx, user)
+	require.NoError(t, err)
+	require.Equal(t, domain.DuplicateItemPolicyWarn, user.DuplicateItemPolicy)
+
+	policy := domain.DuplicateItemPolicyBlock
+	input := &repository.UpdateUserInput{DuplicateItemPolicy: &policy}
+
+	t.Run("OK", func(t *testing.T) {
+		err := repo.Update(ctx, user.ID, input)
+		require.NoError(t, err)
+
+		got, err := repo.Get(ctx, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, domain.DuplicateItemPolicyBlock, got.DuplicateItemPolicy)
+
+		// 값이 같아도 에러가 발생하지 않습니다.
+		err = repo.Update(ctx, user.ID, input)
+		require.NoError(t, err)
+	})
+
+	t.Run("비밀번호 및 토큰 무효화 시각", func(t *testing.T) {
+		password := gofakeit.LetterN(60)
+		tokensValidAfter := time.Unix(time.Now().Unix(), 0).UTC()
+		err := repo.Update(ctx, user.ID, &repository.UpdateUserInput{
+			Password:         &password,
+			TokensValidAfter: &tokensValidAfter,
+		})
+		require.NoError(t, err)
+
+		got, err := repo.Get(ctx, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, password, got.Password)
+		require.Equal(t, &tokensValidAfter, got.TokensValidAfter)
+		require.Equal(t, domain.DuplicateItemPolicyBlock, got.DuplicateItemPolicy)
+	})
+
+	t.Run("nil Context", func(t *testing.T) {
+		err := repo.Update(nil, user.ID, input)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid userID", func(t *testing.T) {
+		err := repo.Update(ctx, gofakeit.IntRange(-10, 0), input)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		invalid := domain.DuplicateItemPolicy("ignore")
+		err := repo.Update(ctx, user.ID, &repository.UpdateUserInput{DuplicateItemPolicy: &invalid})
+		require.Error(t, err)
+
+		err = repo.Update(ctx, user.ID, &repository.UpdateUserInput{})
+		require.Error(t, err)
+
+		empty := ""
+		err = repo.Update(ctx, user.ID, &repository.UpdateUserInput{Password: &empty})
+		require.Error(t, err)
+
+		err = repo.Update(ctx, user.ID, &repository.UpdateUserInput{TokensValidAfter: &time.Time{}})
+		require.Error(t, err)
+	})
+
+	t.Run("context without conn", func(t *testing.T) {
+		err := repo.Update(context.TODO(), user.ID, input)
+		require.Error(t, err)
+	})
+
+	t.Run("UserNotFound", func(t *testing.T) {
+		err := repo.Update(ctx, gofakeit.IntRange(10000, 20000), input)
+		require.ErrorIs(t, err, domain.ErrUserNotFound)
+	})
+}
@@ -0,0 +1,212 @@
+package rdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/internal/db"
+	"github.com/psi59/payhere-assignment/internal/valid"
+	"gorm.io/gorm"
+)
+
+// refreshTokenDeleteBatchSize DeleteExpired에서 한 번에 삭제하는 최대 행 수입니다.
+const refreshTokenDeleteBatchSize = 1000
+
+type RefreshTokenRepository struct {
+	dialect Dialect
+}
+
+func NewRefreshTokenRepository(dialect Dialect) *RefreshTokenRepository {
+	return &RefreshTokenRepository{dialect: dialect}
+}
+
+func (r *RefreshTokenRepository) Create(c context.Context, token *domain.RefreshToken) error {
+	if valid.IsNil(c) {
+		return domain.ErrNilContext
+	}
+	if valid.IsNil(token) {
+		return domain.ErrNilRefreshToken
+	}
+	if err := token.Validate(); err != nil {
+		return errors.WithStack(err)
+	}
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	record := &RefreshToken{
+		TokenHash:  token.TokenHash,
+		FamilyID:   token.FamilyID,
+		Identifier: token.Identifier,
+		ExpiresAt:  token.ExpiresAt,
+		CreatedAt:  token.CreatedAt,
+		RotatedAt:  token.RotatedAt,
+		RevokedAt:  token.RevokedAt,
+	}
+	if err := conn.Create(record).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenRepository) Get(c context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	if valid.IsNil(c) {
+		return nil, domain.ErrNilContext
+	}
+	if len(tokenHash) == 0 {
+		return nil, fmt.Errorf("empty tokenHash")
+	}
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var record RefreshToken
+	if err := conn.Where("token_hash = ?", tokenHash).Take(&record).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.Wrap(domain.ErrRefreshTokenNotFound, err.Error())
+		}
+
+		return nil, errors.WithStack(err)
+	}
+
+	return record.Domain(), nil
+}
+
+func (r *RefreshTokenRepository) Rotate(c context.Context, tokenHash string, rotatedAt time.Time) error {
+	switch {
+	case valid.IsNil(c):
+		return domain.ErrNilContext
+	case len(tokenHash) == 0:
+		return fmt.Errorf("empty tokenHash")
+	case rotatedAt.IsZero():
+		return fmt.Errorf("zero rotatedAt")
+	}
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	result := conn.Model(&RefreshToken{}).
+		Where("token_hash = ? AND rotated_at IS NULL AND revoked_at IS NULL", tokenHash).
+		Update("rotated_at", rotatedAt)
+	if err := result.Error; err != nil {
+		return errors.WithStack(err)
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	var cnt int64
+	if err := conn.Model(&RefreshToken{}).Where("token_hash = ?", tokenHash).Count(&cnt).Error; err != nil {
+		return errors.WithStack(err)
+	}
+	if cnt == 0 {
+		return errors.WithStack(domain.ErrRefreshTokenNotFound)
+	}
+
+	return errors.WithStack(domain.ErrRefreshTokenAlreadyRotated)
+}
+
+func (r *RefreshTokenRepository) RevokeFamily(c context.Context, familyID string, revokedAt time.Time) error {
+	switch {
+	case valid.IsNil(c):
+		return domain.ErrNilContext
+	case len(familyID) == 0:
+		return fmt.Errorf("empty familyID")
+	case revokedAt.IsZero():
+		return fmt.Errorf("zero revokedAt")
+	}
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := conn.Model(&RefreshToken{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", revokedAt).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenRepository) RevokeAll(c context.Context, identifier string, revokedAt time.Time) error {
+	switch {
+	case valid.IsNil(c):
+		return domain.ErrNilContext
+	case len(identifier) == 0:
+		return fmt.Errorf("empty identifier")
+	case revokedAt.IsZero():
+		return fmt.Errorf("zero revokedAt")
+	}
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := conn.Model(&RefreshToken{}).
+		Where("identifier = ? AND revoked_at IS NULL", identifier).
+		Update("revoked_at", revokedAt).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenRepository) DeleteExpired(c context.Context, before time.Time) (int, error) {
+	switch {
+	case valid.IsNil(c):
+		return 0, domain.ErrNilContext
+	case before.IsZero():
+		return 0, fmt.Errorf("zero before")
+	}
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	// 한 번에 많은 행을 삭제하면 락을 오래 잡으므로 refreshTokenDeleteBatchSize 단위로 나누어 삭제합니다.
+	var deletedCount int
+	for {
+		result := conn.Exec(r.dialect.DeleteLimit("refresh_tokens", "token_hash", "expires_at < ?"), before, refreshTokenDeleteBatchSize)
+		if err := result.Error; err != nil {
+			return deletedCount, errors.WithStack(err)
+		}
+		deletedCount += int(result.RowsAffected)
+		if result.RowsAffected < refreshTokenDeleteBatchSize {
+			return deletedCount, nil
+		}
+	}
+}
+
+type RefreshToken struct {
+	TokenHash  string     `gorm:"token_hash;primaryKey"`
+	FamilyID   string     `gorm:"family_id"`
+	Identifier string     `gorm:"identifier"`
+	ExpiresAt  time.Time  `gorm:"expires_at"`
+	CreatedAt  time.Time  `gorm:"created_at"`
+	RotatedAt  *time.Time `gorm:"rotated_at"`
+	RevokedAt  *time.Time `gorm:"revoked_at"`
+}
+
+func (t *RefreshToken) TableName() string {
+	return "refresh_tokens"
+}
+
+func (t *RefreshToken) Domain() *domain.RefreshToken {
+	return &domain.RefreshToken{
+		TokenHash:  t.TokenHash,
+		FamilyID:   t.FamilyID,
+		Identifier: t.Identifier,
+		ExpiresAt:  t.ExpiresAt,
+		CreatedAt:  t.CreatedAt,
+		RotatedAt:  t.RotatedAt,
+		RevokedAt:  t.RevokedAt,
+	}
+}
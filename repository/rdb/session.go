@@ -0,0 +1,210 @@
+package rdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/internal/db"
+	"github.com/psi59/payhere-assignment/internal/valid"
+	"gorm.io/gorm"
+)
+
+// sessionDeleteBatchSize DeleteExpired에서 한 번에 삭제하는 최대 행 수입니다.
+const sessionDeleteBatchSize = 1000
+
+type SessionRepository struct {
+	dialect Dialect
+}
+
+func NewSessionRepository(dialect Dialect) *SessionRepository {
+	return &SessionRepository{dialect: dialect}
+}
+
+func (r *SessionRepository) Create(c context.Context, session *domain.Session) error {
+	if valid.IsNil(c) {
+		return domain.ErrNilContext
+	}
+	if valid.IsNil(session) {
+		return domain.ErrNilSession
+	}
+	if err := session.Validate(); err != nil {
+		return errors.WithStack(err)
+	}
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	record := &Session{
+		ID:         session.ID,
+		FamilyID:   session.FamilyID,
+		Identifier: session.Identifier,
+		IP:         session.IP,
+		UserAgent:  session.UserAgent,
+		CreatedAt:  session.CreatedAt,
+		ExpiresAt:  session.ExpiresAt,
+		RevokedAt:  session.RevokedAt,
+	}
+	if err := conn.Create(record).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (r *SessionRepository) Get(c context.Context, sessionID string) (*domain.Session, error) {
+	if valid.IsNil(c) {
+		return nil, domain.ErrNilContext
+	}
+	if len(sessionID) == 0 {
+		return nil, fmt.Errorf("empty sessionID")
+	}
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var record Session
+	if err := conn.Where("id = ?", sessionID).Take(&record).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.Wrap(domain.ErrSessionNotFound, err.Error())
+		}
+
+		return nil, errors.WithStack(err)
+	}
+
+	return record.Domain(), nil
+}
+
+func (r *SessionRepository) FindActive(c context.Context, identifier string, now time.Time) ([]domain.Session, error) {
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case len(identifier) == 0:
+		return nil, fmt.Errorf("empty identifier")
+	case now.IsZero():
+		return nil, fmt.Errorf("zero now")
+	}
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var records []Session
+	if err := conn.Where("identifier = ? AND revoked_at IS NULL AND expires_at > ?", identifier, now).
+		Order("created_at DESC, id DESC").
+		Find(&records).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	sessions := make([]domain.Session, 0, len(records))
+	for _, record := range records {
+		sessions = append(sessions, *record.Domain())
+	}
+
+	return sessions, nil
+}
+
+func (r *SessionRepository) RevokeFamily(c context.Context, familyID string, revokedAt time.Time) error {
+	switch {
+	case valid.IsNil(c):
+		return domain.ErrNilContext
+	case len(familyID) == 0:
+		return fmt.Errorf("empty familyID")
+	case revokedAt.IsZero():
+		return fmt.Errorf("zero revokedAt")
+	}
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := conn.Model(&Session{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", revokedAt).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (r *SessionRepository) RevokeAll(c context.Context, identifier string, revokedAt time.Time) (int, error) {
+	switch {
+	case valid.IsNil(c):
+		return 0, domain.ErrNilContext
+	case len(identifier) == 0:
+		return 0, fmt.Errorf("empty identifier")
+	case revokedAt.IsZero():
+		return 0, fmt.Errorf("zero revokedAt")
+	}
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	result := conn.Model(&Session{}).
+		Where("identifier = ? AND revoked_at IS NULL AND expires_at > ?", identifier, revokedAt).
+		Update("revoked_at", revokedAt)
+	if err := result.Error; err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return int(result.RowsAffected), nil
+}
+
+func (r *SessionRepository) DeleteExpired(c context.Context, before time.Time) (int, error) {
+	switch {
+	case valid.IsNil(c):
+		return 0, domain.ErrNilContext
+	case before.IsZero():
+		return 0, fmt.Errorf("zero before")
+	}
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	// 한 번에 많은 행을 삭제하면 락을 오래 잡으므로 sessionDeleteBatchSize 단위로 나누어 삭제합니다.
+	var deletedCount int
+	for {
+		result := conn.Exec(r.dialect.DeleteLimit("sessions", "id", "expires_at < ?"), before, sessionDeleteBatchSize)
+		if err := result.Error; err != nil {
+			return deletedCount, errors.WithStack(err)
+		}
+		deletedCount += int(result.RowsAffected)
+		if result.RowsAffected < sessionDeleteBatchSize {
+			return deletedCount, nil
+		}
+	}
+}
+
+type Session struct {
+	ID         string     `gorm:"id;primaryKey"`
+	FamilyID   string     `gorm:"family_id"`
+	Identifier string     `gorm:"identifier"`
+	IP         string     `gorm:"ip"`
+	UserAgent  string     `gorm:"user_agent"`
+	CreatedAt  time.Time  `gorm:"created_at"`
+	ExpiresAt  time.Time  `gorm:"expires_at"`
+	RevokedAt  *time.Time `gorm:"revoked_at"`
+}
+
+func (s *Session) TableName() string {
+	return "sessions"
+}
+
+func (s *Session) Domain() *domain.Session {
+	return &domain.Session{
+		ID:         s.ID,
+		FamilyID:   s.FamilyID,
+		Identifier: s.Identifier,
+		IP:         s.IP,
+		UserAgent:  s.UserAgent,
+		CreatedAt:  s.CreatedAt,
+		ExpiresAt:  s.ExpiresAt,
+		RevokedAt:  s.RevokedAt,
+	}
+}
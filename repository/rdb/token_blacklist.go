@@ -0,0 +1,140 @@
+package rdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/internal/db"
+	"github.com/psi59/payhere-assignment/internal/valid"
+	"gorm.io/gorm"
+)
+
+// tokenBlacklistDeleteBatchSize DeleteExpired에서 한 번에 삭제하는 최대 행 수입니다.
+const tokenBlacklistDeleteBatchSize = 1000
+
+type TokenBlacklistRepository struct {
+	dialect Dialect
+}
+
+func NewTokenBlacklistRepository(dialect Dialect) *TokenBlacklistRepository {
+	return &TokenBlacklistRepository{dialect: dialect}
+}
+
+func (r *TokenBlacklistRepository) Create(c context.Context, token *domain.AuthToken) error {
+	if valid.IsNil(c) {
+		return domain.ErrNilContext
+	}
+	if valid.IsNil(token) {
+		return domain.ErrNilAuthToken
+	}
+	if err := token.Validate(); err != nil {
+		return errors.WithStack(err)
+	}
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := conn.Create(&AuthToken{JTI: token.ID, ExpiresAt: token.ExpiresAt}).Error; err != nil {
+		if r.dialect.IsDuplicateEntry(err) {
+			return errors.Wrap(domain.ErrTokenBlacklistAlreadyExists, err.Error())
+		}
+
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (r *TokenBlacklistRepository) Get(c context.Context, tokenID string) (*domain.AuthToken, error) {
+	if valid.IsNil(c) {
+		return nil, domain.ErrNilContext
+	}
+	if len(tokenID) == 0 {
+		return nil, fmt.Errorf("empty tokenID")
+	}
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var record AuthToken
+	if err := conn.Where("jti = ?", tokenID).Take(&record).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.Wrap(domain.ErrTokenBlacklistNotFound, err.Error())
+		}
+
+		return nil, errors.WithStack(err)
+	}
+
+	return record.Domain(), nil
+}
+
+func (r *TokenBlacklistRepository) FindActive(c context.Context, now time.Time) ([]domain.AuthToken, error) {
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case now.IsZero():
+		return nil, fmt.Errorf("zero now")
+	}
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var records []AuthToken
+	if err := conn.Where("expires_at > ?", now).Find(&records).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	tokens := make([]domain.AuthToken, 0, len(records))
+	for _, record := range records {
+		tokens = append(tokens, *record.Domain())
+	}
+
+	return tokens, nil
+}
+
+func (r *TokenBlacklistRepository) DeleteExpired(c context.Context, before time.Time) (int, error) {
+	switch {
+	case valid.IsNil(c):
+		return 0, domain.ErrNilContext
+	case before.IsZero():
+		return 0, fmt.Errorf("zero before")
+	}
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	// 한 번에 많은 행을 삭제하면 락을 오래 잡으므로 tokenBlacklistDeleteBatchSize 단위로 나누어 삭제합니다.
+	var deletedCount int
+	for {
+		result := conn.Exec(r.dialect.DeleteLimit("token_blacklist", "jti", "expires_at < ?"), before, tokenBlacklistDeleteBatchSize)
+		if err := result.Error; err != nil {
+			return deletedCount, errors.WithStack(err)
+		}
+		deletedCount += int(result.RowsAffected)
+		if result.RowsAffected < tokenBlacklistDeleteBatchSize {
+			return deletedCount, nil
+		}
+	}
+}
+
+type AuthToken struct {
+	JTI       string    `gorm:"jti;primaryKey"`
+	ExpiresAt time.Time `gorm:"expires_at"`
+}
+
+func (t *AuthToken) TableName() string {
+	return "token_blacklist"
+}
+
+func (t *AuthToken) Domain() *domain.AuthToken {
+	return &domain.AuthToken{
+		ID:        t.JTI,
+		ExpiresAt: t.ExpiresAt,
+	}
+}
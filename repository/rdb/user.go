@@ -0,0 +1,181 @@
+package rdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/internal/db"
+	"github.com/psi59/payhere-assignment/internal/valid"
+	"github.com/psi59/payhere-assignment/repository"
+	"gorm.io/gorm"
+)
+
+type UserRepository struct {
+	dialect Dialect
+}
+
+func NewUserRepository(dialect Dialect) *UserRepository {
+	return &UserRepository{dialect: dialect}
+}
+
+func (r *UserRepository) Create(c context.Context, user *domain.User) error {
+	switch {
+	case valid.IsNil(c):
+		return domain.ErrNilContext
+	case valid.IsNil(user):
+		return domain.ErrNilUser
+	}
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	userModel := &User{
+		UserID:              user.ID,
+		PhoneNumber:         user.PhoneNumber,
+		Password:            user.Password,
+		CreatedAt:           user.CreatedAt,
+		DuplicateItemPolicy: user.DuplicateItemPolicy,
+		TokensValidAfter:    user.TokensValidAfter,
+	}
+	if len(userModel.DuplicateItemPolicy) == 0 {
+		userModel.DuplicateItemPolicy = domain.DuplicateItemPolicyWarn
+	}
+	if err := conn.Create(userModel).Error; err != nil {
+		if r.dialect.IsDuplicateEntry(err) {
+			return errors.Wrap(domain.ErrUserAlreadyExists, err.Error())
+		}
+
+		return errors.WithStack(err)
+	}
+	user.ID = userModel.UserID
+	user.DuplicateItemPolicy = userModel.DuplicateItemPolicy
+
+	return nil
+}
+
+func (r *UserRepository) Get(c context.Context, userID int) (*domain.User, error) {
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilUser
+	case userID < 1:
+		return nil, fmt.Errorf("invalid userID")
+	}
+
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	userModel := User{UserID: userID}
+	if err := conn.Take(&userModel).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrUserNotFound
+		}
+
+		return nil, errors.WithStack(err)
+	}
+
+	return userModel.Domain(), nil
+}
+
+func (r *UserRepository) GetByPhoneNumber(c context.Context, phoneNumber string) (*domain.User, error) {
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilUser
+	case len(phoneNumber) == 0:
+		return nil, fmt.Errorf("empty phoneNumber")
+	}
+
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var userModel User
+	if err := conn.Where("phone_number = ?", phoneNumber).Take(&userModel).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrUserNotFound
+		}
+
+		return nil, errors.WithStack(err)
+	}
+
+	return userModel.Domain(), nil
+}
+
+func (r *UserRepository) Update(c context.Context, userID int, input *repository.UpdateUserInput) error {
+	switch {
+	case valid.IsNil(c):
+		return domain.ErrNilContext
+	case userID < 1:
+		return fmt.Errorf("invalid userID: %d", userID)
+	case valid.IsNil(input):
+		return domain.ErrNilInput
+	}
+	if err := input.Validate(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	conn, err := db.ConnFromContext(c)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	updateColumns := make(map[string]any)
+	if input.DuplicateItemPolicy != nil {
+		updateColumns["duplicate_item_policy"] = *input.DuplicateItemPolicy
+	}
+	if input.Password != nil {
+		updateColumns["password"] = *input.Password
+	}
+	if input.TokensValidAfter != nil {
+		updateColumns["tokens_valid_after"] = *input.TokensValidAfter
+	}
+	query := func() *gorm.DB {
+		return conn.Model(&User{}).Where("user_id = ?", userID)
+	}
+	result := query().Updates(updateColumns)
+	if err := result.Error; err != nil {
+		return errors.WithStack(err)
+	}
+	// 값이 같아 변경된 행이 없는 경우도 있으므로 유저가 존재하는지 다시 확인합니다.
+	if result.RowsAffected == 0 {
+		var cnt int64
+		if err := query().Count(&cnt).Error; err != nil {
+			return errors.WithStack(err)
+		}
+		if cnt == 0 {
+			return errors.WithStack(domain.ErrUserNotFound)
+		}
+	}
+
+	return nil
+}
+
+type User struct {
+	UserID              int                        `gorm:"user_id;primaryKey"`
+	PhoneNumber         string                     `gorm:"phone_number"`
+	Password            string                     `gorm:"password"`
+	CreatedAt           time.Time                  `gorm:"created_at"`
+	DuplicateItemPolicy domain.DuplicateItemPolicy `gorm:"duplicate_item_policy"`
+	TokensValidAfter    *time.Time                 `gorm:"tokens_valid_after"`
+}
+
+func (u *User) TableName() string {
+	return "users"
+}
+
+func (u *User) Domain() *domain.User {
+	return &domain.User{
+		ID:                  u.UserID,
+		PhoneNumber:         u.PhoneNumber,
+		Password:            u.Password,
+		CreatedAt:           u.CreatedAt,
+		DuplicateItemPolicy: u.DuplicateItemPolicy,
+		TokensValidAfter:    u.TokensValidAfter,
+	}
+}
@@ -0,0 +1,75 @@
+package sqlite
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+
+	"github.com/psi59/payhere-assignment/repository/rdb"
+)
+
+const trigramLength = 3
+
+// Dialect SQLite 쿼리입니다.
+var Dialect rdb.Dialect = dialect{}
+
+type dialect struct{}
+
+func (dialect) IsDuplicateEntry(err error) bool {
+	return IsDuplicateEntry(err)
+}
+
+// WhereKeyword FTS5 trigram 색인(items_fts)으로 키워드를 구문 검색합니다.
+func (dialect) WhereKeyword(queryBuilder *gorm.DB, keyword string) *gorm.DB {
+	// trigram 토크나이저는 3글자 미만의 키워드를 검색할 수 없으므로 LIKE 검색을 사용합니다.
+	if utf8.RuneCountInString(keyword) < trigramLength {
+		pattern := "%" + escapeLike(keyword) + "%"
+		return queryBuilder.Where(`(item_name LIKE ? ESCAPE '\' OR item_name_chosung LIKE ? ESCAPE '\')`, pattern, pattern)
+	}
+
+	return queryBuilder.Where("item_id IN (SELECT rowid FROM items_fts WHERE items_fts MATCH ?)", quotePhrase(keyword))
+}
+
+// Time SQLite는 시각을 입력된 시간대의 문자열로 저장하므로 julianday로 변환해 비교합니다.
+func (dialect) Time(expression string) string {
+	return "julianday(" + expression + ")"
+}
+
+// DeleteLimit SQLite는 기본적으로 DELETE 문의 LIMIT을 지원하지 않으므로 서브쿼리로 삭제할 행을 고릅니다.
+func (dialect) DeleteLimit(table, key, condition string) string {
+	return "DELETE FROM " + table + " WHERE " + key + " IN (SELECT " + key + " FROM " + table + " WHERE " + condition + " LIMIT ?)"
+}
+
+// quotePhrase 키워드를 FTS5 구문 검색 문자열로 변환합니다.
+func quotePhrase(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+func NewUserRepository() *rdb.UserRepository {
+	return rdb.NewUserRepository(Dialect)
+}
+
+func NewItemRepository() *rdb.ItemRepository {
+	return rdb.NewItemRepository(Dialect)
+}
+
+func NewItemHistoryRepository() *rdb.ItemHistoryRepository {
+	return rdb.NewItemHistoryRepository()
+}
+
+func NewTokenBlacklistRepository() *rdb.TokenBlacklistRepository {
+	return rdb.NewTokenBlacklistRepository(Dialect)
+}
+
+func NewRefreshTokenRepository() *rdb.RefreshTokenRepository {
+	return rdb.NewRefreshTokenRepository(Dialect)
+}
+
+func NewSessionRepository() *rdb.SessionRepository {
+	return rdb.NewSessionRepository(Dialect)
+}
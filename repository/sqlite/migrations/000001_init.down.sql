@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS token_blacklist;
+DROP TRIGGER IF EXISTS items_fts_au;
+DROP TRIGGER IF EXISTS items_fts_ad;
+DROP TRIGGER IF EXISTS items_fts_ai;
+DROP TABLE IF EXISTS items_fts;
+DROP TABLE IF EXISTS items;
+DROP TABLE IF EXISTS users;
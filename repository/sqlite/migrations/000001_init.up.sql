@@ -0,0 +1,72 @@
+CREATE TABLE IF NOT EXISTS users
+(
+    user_id      INTEGER PRIMARY KEY AUTOINCREMENT,
+    phone_number VARCHAR(13)                        NOT NULL,
+    password     VARCHAR(72)                        NOT NULL,
+    created_at   DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CONSTRAINT phone_number
+        UNIQUE (phone_number)
+);
+
+CREATE TABLE IF NOT EXISTS items
+(
+    item_id           INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id           INTEGER                            NOT NULL,
+    category          VARCHAR(100)                       NOT NULL,
+    item_name         VARCHAR(100) COLLATE NOCASE        NOT NULL,
+    item_name_chosung VARCHAR(100)                       NOT NULL,
+    price             INTEGER                            NOT NULL CHECK (price >= 0),
+    cost              INTEGER                            NOT NULL CHECK (cost >= 0),
+    description       TEXT                               NOT NULL,
+    barcode           VARCHAR(100)                       NOT NULL,
+    item_size         TEXT                               NOT NULL CHECK (item_size IN ('small', 'large')),
+    created_at        DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    expiry_at         DATETIME                           NOT NULL,
+    CONSTRAINT uidx_user_id_item_name
+        UNIQUE (user_id, item_name),
+    CONSTRAINT items_ibfk_1
+        FOREIGN KEY (user_id) REFERENCES users (user_id)
+            ON DELETE CASCADE
+);
+
+-- MySQL의 ngram FULLTEXT 인덱스를 대신하는 trigram FTS5 인덱스
+CREATE VIRTUAL TABLE IF NOT EXISTS items_fts USING fts5
+(
+    item_name,
+    item_name_chosung,
+    content = 'items',
+    content_rowid = 'item_id',
+    tokenize = 'trigram'
+);
+
+CREATE TRIGGER IF NOT EXISTS items_fts_ai
+    AFTER INSERT
+    ON items
+BEGIN
+    INSERT INTO items_fts (rowid, item_name, item_name_chosung)
+    VALUES (new.item_id, new.item_name, new.item_name_chosung);
+END;
+
+CREATE TRIGGER IF NOT EXISTS items_fts_ad
+    AFTER DELETE
+    ON items
+BEGIN
+    INSERT INTO items_fts (items_fts, rowid, item_name, item_name_chosung)
+    VALUES ('delete', old.item_id, old.item_name, old.item_name_chosung);
+END;
+
+CREATE TRIGGER IF NOT EXISTS items_fts_au
+    AFTER UPDATE OF item_name, item_name_chosung
+    ON items
+BEGIN
+    INSERT INTO items_fts (items_fts, rowid, item_name, item_name_chosung)
+    VALUES ('delete', old.item_id, old.item_name, old.item_name_chosung);
+    INSERT INTO items_fts (rowid, item_name, item_name_chosung)
+    VALUES (new.item_id, new.item_name, new.item_name_chosung);
+END;
+
+CREATE TABLE IF NOT EXISTS token_blacklist
+(
+    token      VARCHAR(500) NOT NULL PRIMARY KEY,
+    expires_at DATETIME     NOT NULL
+);
@@ -0,0 +1,76 @@
+-- 휴지통의 아이템은 이름이 중복될 수 있으므로 영구 삭제합니다.
+DELETE FROM items WHERE deleted_at IS NOT NULL;
+
+DROP TRIGGER IF EXISTS items_fts_ai;
+DROP TRIGGER IF EXISTS items_fts_ad;
+DROP TRIGGER IF EXISTS items_fts_au;
+
+CREATE TABLE items_new
+(
+    item_id           INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id           INTEGER                            NOT NULL,
+    category          VARCHAR(100)                       NOT NULL,
+    item_name         VARCHAR(100) COLLATE NOCASE        NOT NULL,
+    item_name_chosung VARCHAR(100)                       NOT NULL,
+    price             INTEGER                            NOT NULL CHECK (price >= 0),
+    cost              INTEGER                            NOT NULL CHECK (cost >= 0),
+    description       TEXT                               NOT NULL,
+    barcode           VARCHAR(100)                       NOT NULL,
+    item_size         TEXT                               NOT NULL CHECK (item_size IN ('small', 'large')),
+    created_at        DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    expiry_at         DATETIME                           NOT NULL,
+    CONSTRAINT uidx_user_id_item_name
+        UNIQUE (user_id, item_name),
+    CONSTRAINT items_ibfk_1
+        FOREIGN KEY (user_id) REFERENCES users (user_id)
+            ON DELETE CASCADE
+);
+
+INSERT INTO items_new (item_id, user_id, category, item_name, item_name_chosung, price, cost, description, barcode,
+                       item_size, created_at, expiry_at)
+SELECT item_id,
+       user_id,
+       category,
+       item_name,
+       item_name_chosung,
+       price,
+       cost,
+       description,
+       barcode,
+       item_size,
+       created_at,
+       expiry_at
+FROM items;
+
+DROP TABLE items;
+
+ALTER TABLE items_new RENAME TO items;
+
+CREATE TRIGGER items_fts_ai
+    AFTER INSERT
+    ON items
+BEGIN
+    INSERT INTO items_fts (rowid, item_name, item_name_chosung)
+    VALUES (new.item_id, new.item_name, new.item_name_chosung);
+END;
+
+CREATE TRIGGER items_fts_ad
+    AFTER DELETE
+    ON items
+BEGIN
+    INSERT INTO items_fts (items_fts, rowid, item_name, item_name_chosung)
+    VALUES ('delete', old.item_id, old.item_name, old.item_name_chosung);
+END;
+
+CREATE TRIGGER items_fts_au
+    AFTER UPDATE OF item_name, item_name_chosung
+    ON items
+BEGIN
+    INSERT INTO items_fts (items_fts, rowid, item_name, item_name_chosung)
+    VALUES ('delete', old.item_id, old.item_name, old.item_name_chosung);
+    INSERT INTO items_fts (rowid, item_name, item_name_chosung)
+    VALUES (new.item_id, new.item_name, new.item_name_chosung);
+END;
+
+INSERT INTO items_fts (items_fts)
+VALUES ('rebuild');
@@ -0,0 +1,80 @@
+-- 휴지통으로 이동된 아이템은 deleted_at이 설정되며, 삭제되지 않은 아이템 사이에서만 이름 중복을 검사합니다.
+-- SQLite는 테이블 제약 조건을 삭제할 수 없으므로 테이블을 다시 생성합니다.
+DROP TRIGGER IF EXISTS items_fts_ai;
+DROP TRIGGER IF EXISTS items_fts_ad;
+DROP TRIGGER IF EXISTS items_fts_au;
+
+CREATE TABLE items_new
+(
+    item_id           INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id           INTEGER                            NOT NULL,
+    category          VARCHAR(100)                       NOT NULL,
+    item_name         VARCHAR(100) COLLATE NOCASE        NOT NULL,
+    item_name_chosung VARCHAR(100)                       NOT NULL,
+    price             INTEGER                            NOT NULL CHECK (price >= 0),
+    cost              INTEGER                            NOT NULL CHECK (cost >= 0),
+    description       TEXT                               NOT NULL,
+    barcode           VARCHAR(100)                       NOT NULL,
+    item_size         TEXT                               NOT NULL CHECK (item_size IN ('small', 'large')),
+    created_at        DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    expiry_at         DATETIME                           NOT NULL,
+    deleted_at        DATETIME                           NULL,
+    CONSTRAINT items_ibfk_1
+        FOREIGN KEY (user_id) REFERENCES users (user_id)
+            ON DELETE CASCADE
+);
+
+INSERT INTO items_new (item_id, user_id, category, item_name, item_name_chosung, price, cost, description, barcode,
+                       item_size, created_at, expiry_at)
+SELECT item_id,
+       user_id,
+       category,
+       item_name,
+       item_name_chosung,
+       price,
+       cost,
+       description,
+       barcode,
+       item_size,
+       created_at,
+       expiry_at
+FROM items;
+
+DROP TABLE items;
+
+ALTER TABLE items_new RENAME TO items;
+
+CREATE UNIQUE INDEX uidx_user_id_item_name ON items (user_id, item_name) WHERE deleted_at IS NULL;
+
+CREATE INDEX idx_user_id_deleted_at ON items (user_id, deleted_at);
+
+CREATE INDEX idx_deleted_at ON items (deleted_at) WHERE deleted_at IS NOT NULL;
+
+CREATE TRIGGER items_fts_ai
+    AFTER INSERT
+    ON items
+BEGIN
+    INSERT INTO items_fts (rowid, item_name, item_name_chosung)
+    VALUES (new.item_id, new.item_name, new.item_name_chosung);
+END;
+
+CREATE TRIGGER items_fts_ad
+    AFTER DELETE
+    ON items
+BEGIN
+    INSERT INTO items_fts (items_fts, rowid, item_name, item_name_chosung)
+    VALUES ('delete', old.item_id, old.item_name, old.item_name_chosung);
+END;
+
+CREATE TRIGGER items_fts_au
+    AFTER UPDATE OF item_name, item_name_chosung
+    ON items
+BEGIN
+    INSERT INTO items_fts (items_fts, rowid, item_name, item_name_chosung)
+    VALUES ('delete', old.item_id, old.item_name, old.item_name_chosung);
+    INSERT INTO items_fts (rowid, item_name, item_name_chosung)
+    VALUES (new.item_id, new.item_name, new.item_name_chosung);
+END;
+
+INSERT INTO items_fts (items_fts)
+VALUES ('rebuild');
@@ -0,0 +1,17 @@
+-- 영구 삭제된 아이템의 이력도 보관하기 위해 items 테이블을 참조하지 않습니다.
+CREATE TABLE IF NOT EXISTS item_histories
+(
+    history_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    item_id    INTEGER                            NOT NULL,
+    user_id    INTEGER                            NOT NULL,
+    actor_id   INTEGER                            NOT NULL,
+    request_id VARCHAR(64)                        NOT NULL,
+    action     TEXT                               NOT NULL CHECK (action IN ('create', 'update', 'delete', 'restore', 'purge')),
+    changes    TEXT                               NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CONSTRAINT item_histories_ibfk_1
+        FOREIGN KEY (user_id) REFERENCES users (user_id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_id_item_id_history_id ON item_histories (user_id, item_id, history_id);
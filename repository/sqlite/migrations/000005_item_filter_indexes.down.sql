@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_user_id_barcode;
+
+DROP INDEX IF EXISTS idx_user_id_created_at;
+
+DROP INDEX IF EXISTS idx_user_id_expiry_at;
+
+DROP INDEX IF EXISTS idx_user_id_cost;
+
+DROP INDEX IF EXISTS idx_user_id_price;
+
+DROP INDEX IF EXISTS idx_user_id_category;
@@ -0,0 +1,3 @@
+-- 비슷한 이름의 아이템이 이미 있을 때 아이템 생성, 수정을 처리하는 방식입니다. (warn: 저장 후 경고, block: 저장하지 않음)
+ALTER TABLE users
+    ADD COLUMN duplicate_item_policy VARCHAR(10) DEFAULT 'warn' NOT NULL CHECK (duplicate_item_policy IN ('warn', 'block'));
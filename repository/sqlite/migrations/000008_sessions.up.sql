@@ -0,0 +1,18 @@
+-- 액세스 토큰의 jti를 아이디로 사용합니다.
+CREATE TABLE IF NOT EXISTS sessions
+(
+    id         VARCHAR(20)                        NOT NULL PRIMARY KEY,
+    family_id  VARCHAR(20)                        NOT NULL,
+    identifier VARCHAR(100)                       NOT NULL,
+    ip         VARCHAR(45)                        NOT NULL,
+    user_agent VARCHAR(512)                       NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    expires_at DATETIME                           NOT NULL,
+    revoked_at DATETIME
+);
+
+CREATE INDEX IF NOT EXISTS idx_sessions_identifier ON sessions (identifier);
+
+CREATE INDEX IF NOT EXISTS idx_sessions_family_id ON sessions (family_id);
+
+CREATE INDEX IF NOT EXISTS idx_sessions_expires_at ON sessions (expires_at);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_user_id_item_name_length;
+
+ALTER TABLE items
+    DROP COLUMN item_name_length;
@@ -0,0 +1,6 @@
+-- 비슷한 이름의 아이템을 찾을 때 자모 수가 가까운 아이템만 비교하도록 정규화한 이름의 자모 수를 저장합니다.
+-- 자모 수는 SQL로 계산할 수 없어 기존 아이템은 NULL이며, 이름을 수정하기 전까지 항상 비교합니다.
+ALTER TABLE items
+    ADD COLUMN item_name_length INTEGER;
+
+CREATE INDEX IF NOT EXISTS idx_user_id_item_name_length ON items (user_id, item_name_length) WHERE deleted_at IS NULL;
@@ -0,0 +1,36 @@
+package sqlite
+
+import (
+	"embed"
+	"io/fs"
+
+	"github.com/glebarez/go-sqlite"
+	"github.com/pkg/errors"
+)
+
+const (
+	ErrCodeConstraintPrimaryKey = 1555
+	ErrCodeConstraintUnique     = 2067
+)
+
+func IsDuplicateEntry(err error) bool {
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.Code() == ErrCodeConstraintPrimaryKey || sqliteErr.Code() == ErrCodeConstraintUnique
+	}
+
+	return false
+}
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// Migrations 스키마 마이그레이션 파일을 반환합니다.
+func Migrations() fs.FS {
+	sub, err := fs.Sub(migrations, "migrations")
+	if err != nil {
+		panic(err)
+	}
+
+	return sub
+}
@@ -0,0 +1,60 @@
+package sqlite
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/psi59/payhere-assignment/internal/db"
+	"github.com/psi59/payhere-assignment/internal/migrate"
+	"github.com/psi59/payhere-assignment/repository/rdb/rdbtest"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+var conn *gorm.DB
+
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "payhere_sqlite")
+	if err != nil {
+		log.Fatal().Err(err).Send()
+	}
+	if err := createTestDatabase(filepath.Join(dir, "payhere.db")); err != nil {
+		log.Fatal().Err(err).Send()
+	}
+	code := m.Run()
+	_ = os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+func createTestDatabase(path string) error {
+	var err error
+	conn, err = db.Connect(db.Config{
+		Driver:   db.DriverSQLite,
+		Database: path,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to connect database")
+	}
+	migrator, err := migrate.New(Migrations())
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if _, err := migrator.Up(db.ContextWithConn(context.Background(), conn)); err != nil {
+		return errors.WithStack(err)
+	}
+	conn = conn.Debug()
+
+	return nil
+}
+
+func TestRepository(t *testing.T) {
+	suite.Run(t, &rdbtest.Suite{Conn: conn, Dialect: Dialect})
+}
+
+func BenchmarkItemRepository_Find(b *testing.B) {
+	rdbtest.BenchmarkItemRepositoryFind(b, conn, Dialect)
+}
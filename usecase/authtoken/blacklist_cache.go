@@ -0,0 +1,114 @@
+package authtoken
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/internal/bloom"
+	"github.com/psi59/payhere-assignment/internal/lru"
+)
+
+const (
+	// blacklistFalsePositiveRate 블랙리스트 블룸 필터의 오탐률입니다.
+	blacklistFalsePositiveRate = 0.01
+	// minBlacklistFilterItems 블랙리스트가 비어 있거나 작을 때도 오탐률을 유지하도록 블룸 필터에 잡는 최소 항목 수입니다.
+	minBlacklistFilterItems = 1024
+)
+
+// blacklistCache 토큰 블랙리스트 조회 앞에 두는 인스턴스 로컬 캐시입니다.
+//
+// 만료되지 않은 블랙리스트 전체로 블룸 필터를 주기적으로 다시 만들고, 블룸 필터에 없는 jti는 DB를 조회하지 않고 블랙리스트에 없다고 판단합니다.
+// 블룸 필터에 있는 jti의 조회 결과는 LRU에 저장하며, 블랙리스트에 있는 토큰은 토큰이 만료될 때까지, 없는 토큰(오탐)은 다음 갱신 전까지만 사용합니다.
+// 다른 인스턴스에서 등록한 토큰은 다음 갱신에 반영되며, maxStaleness 동안 갱신하지 못하면 캐시 대신 DB를 조회하므로
+// 다른 인스턴스에서 등록한 토큰도 maxStaleness 이내에 거부됩니다.
+type blacklistCache struct {
+	mu           sync.RWMutex
+	filter       *bloom.Filter
+	refreshedAt  time.Time
+	maxStaleness time.Duration
+	entries      *lru.Cache[string, blacklistEntry]
+}
+
+// blacklistEntry 블랙리스트 조회 결과이며, token이 nil이면 블랙리스트에 없는 토큰입니다.
+type blacklistEntry struct {
+	token *domain.AuthToken
+	// refreshedAt 조회할 때 블룸 필터의 갱신 시각이며, 블랙리스트에 없다는 결과는 같은 블룸 필터에서만 사용합니다.
+	refreshedAt time.Time
+}
+
+func newBlacklistCache(size int, maxStaleness time.Duration) *blacklistCache {
+	return &blacklistCache{
+		maxStaleness: maxStaleness,
+		entries:      lru.New[string, blacklistEntry](size),
+	}
+}
+
+// get 캐시에서 블랙리스트를 조회하고, 캐시로 판단할 수 없으면 load로 조회한 결과를 expiresAt까지 캐시에 저장합니다.
+// 블랙리스트에 없는 토큰이면 domain.ErrTokenBlacklistNotFound를 반환합니다.
+func (c *blacklistCache) get(tokenID string, expiresAt, now time.Time, load func() (*domain.AuthToken, error)) (*domain.AuthToken, error) {
+	// 블룸 필터는 add에서 수정되므로 잠금을 유지한 채 확인합니다.
+	c.mu.RLock()
+	refreshedAt := c.refreshedAt
+	fresh := c.filter != nil && now.Sub(refreshedAt) <= c.maxStaleness
+	mayBeBlacklisted := !fresh || c.filter.Test(tokenID)
+	c.mu.RUnlock()
+
+	// 1. LRU 확인
+	if entry, exists := c.entries.Get(tokenID, now); exists {
+		if entry.token != nil {
+			return entry.token, nil
+		}
+		if fresh && entry.refreshedAt.Equal(refreshedAt) {
+			return nil, errors.WithStack(domain.ErrTokenBlacklistNotFound)
+		}
+	}
+
+	// 2. 블룸 필터 확인
+	if !mayBeBlacklisted {
+		return nil, errors.WithStack(domain.ErrTokenBlacklistNotFound)
+	}
+
+	// 3. 조회 결과 저장
+	token, err := load()
+	if err != nil {
+		if errors.Is(err, domain.ErrTokenBlacklistNotFound) && fresh {
+			c.entries.Add(tokenID, blacklistEntry{refreshedAt: refreshedAt}, expiresAt)
+		}
+
+		return nil, errors.WithStack(err)
+	}
+	c.entries.Add(tokenID, blacklistEntry{token: token}, token.ExpiresAt)
+
+	return token, nil
+}
+
+// add 이 인스턴스에서 블랙리스트에 등록한 토큰을 다음 갱신을 기다리지 않고 캐시에 반영합니다.
+func (c *blacklistCache) add(token *domain.AuthToken) {
+	c.mu.Lock()
+	if c.filter != nil {
+		c.filter.Add(token.ID)
+	}
+	c.mu.Unlock()
+
+	c.entries.Add(token.ID, blacklistEntry{token: token}, token.ExpiresAt)
+}
+
+// refresh refreshedAt에 조회한 블랙리스트 전체로 블룸 필터를 다시 만듭니다.
+func (c *blacklistCache) refresh(tokens []domain.AuthToken, refreshedAt time.Time) {
+	filter := bloom.New(max(len(tokens)*2, minBlacklistFilterItems), blacklistFalsePositiveRate)
+	for _, token := range tokens {
+		filter.Add(token.ID)
+	}
+
+	c.mu.Lock()
+	c.filter = filter
+	c.refreshedAt = refreshedAt
+	c.mu.Unlock()
+
+	// 이전 블룸 필터에서 블랙리스트에 없다고 확인한 결과는 더 이상 사용하지 않습니다.
+	c.entries.RemoveFunc(func(_ string, entry blacklistEntry) bool {
+		return entry.token == nil
+	})
+}
@@ -0,0 +1,132 @@
+package authtoken
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/psi59/payhere-assignment/domain"
+)
+
+func TestBlacklistCache_get(t *testing.T) {
+	now := time.Now()
+	expiresAt := now.Add(time.Hour)
+	notFound := func() (*domain.AuthToken, error) {
+		return nil, domain.ErrTokenBlacklistNotFound
+	}
+	mustNotLoad := func() (*domain.AuthToken, error) {
+		t.Fatal("unexpected load")
+		return nil, nil
+	}
+
+	t.Run("블룸 필터 오탐은 다음 갱신 전까지 캐시", func(t *testing.T) {
+		cache := newBlacklistCache(10, time.Minute)
+		tokenID := xid.New().String()
+		// 블룸 필터에 있지만 DB에서 삭제된 토큰은 오탐과 같습니다.
+		cache.refresh([]domain.AuthToken{{ID: tokenID, ExpiresAt: expiresAt}}, now)
+
+		_, err := cache.get(tokenID, expiresAt, now, notFound)
+		require.ErrorIs(t, err, domain.ErrTokenBlacklistNotFound)
+		_, err = cache.get(tokenID, expiresAt, now, mustNotLoad)
+		require.ErrorIs(t, err, domain.ErrTokenBlacklistNotFound)
+
+		// 갱신 뒤에는 다시 조회합니다.
+		token := &domain.AuthToken{ID: tokenID, ExpiresAt: expiresAt}
+		cache.refresh([]domain.AuthToken{*token}, now.Add(time.Second))
+		got, err := cache.get(tokenID, expiresAt, now.Add(time.Second), func() (*domain.AuthToken, error) {
+			return token, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, token, got)
+		got, err = cache.get(tokenID, expiresAt, now.Add(time.Second), mustNotLoad)
+		require.NoError(t, err)
+		require.Equal(t, token, got)
+	})
+
+	t.Run("다른 인스턴스에서 등록한 토큰은 갱신 후 거부", func(t *testing.T) {
+		cache := newBlacklistCache(10, time.Minute)
+		token := &domain.AuthToken{ID: xid.New().String(), ExpiresAt: expiresAt}
+		cache.refresh(nil, now)
+
+		_, err := cache.get(token.ID, expiresAt, now, mustNotLoad)
+		require.ErrorIs(t, err, domain.ErrTokenBlacklistNotFound)
+
+		cache.refresh([]domain.AuthToken{*token}, now.Add(time.Second))
+		got, err := cache.get(token.ID, expiresAt, now.Add(time.Second), func() (*domain.AuthToken, error) {
+			return token, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, token, got)
+	})
+
+	t.Run("갱신하지 못한 기간이 maxStaleness를 넘으면 DB 조회", func(t *testing.T) {
+		cache := newBlacklistCache(10, time.Minute)
+		tokenID := xid.New().String()
+		cache.refresh(nil, now)
+
+		var loaded int
+		for i := 0; i < 2; i++ {
+			_, err := cache.get(tokenID, expiresAt, now.Add(2*time.Minute), func() (*domain.AuthToken, error) {
+				loaded++
+				return notFound()
+			})
+			require.ErrorIs(t, err, domain.ErrTokenBlacklistNotFound)
+		}
+		require.Equal(t, 2, loaded)
+	})
+
+	t.Run("add", func(t *testing.T) {
+		cache := newBlacklistCache(10, time.Minute)
+		token := &domain.AuthToken{ID: xid.New().String(), ExpiresAt: expiresAt}
+		cache.refresh(nil, now)
+		cache.add(token)
+
+		got, err := cache.get(token.ID, expiresAt, now, mustNotLoad)
+		require.NoError(t, err)
+		require.Equal(t, token, got)
+
+		// 토큰이 만료되면 캐시하지 않습니다.
+		_, err = cache.get(token.ID, expiresAt, expiresAt, notFound)
+		require.ErrorIs(t, err, domain.ErrTokenBlacklistNotFound)
+	})
+}
+
+func TestBlacklistCache_concurrentGetAndAdd(t *testing.T) {
+	now := time.Now()
+	expiresAt := now.Add(time.Hour)
+	cache := newBlacklistCache(100, time.Minute)
+	cache.refresh(nil, now)
+
+	// go test -race로 실행하면 블룸 필터를 조회하는 중 수정하는 경우를 확인할 수 있습니다.
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				cache.add(&domain.AuthToken{ID: xid.New().String(), ExpiresAt: expiresAt})
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				_, _ = cache.get(xid.New().String(), expiresAt, now, func() (*domain.AuthToken, error) {
+					return nil, domain.ErrTokenBlacklistNotFound
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	token := &domain.AuthToken{ID: xid.New().String(), ExpiresAt: expiresAt}
+	cache.add(token)
+	got, err := cache.get(token.ID, expiresAt, now, func() (*domain.AuthToken, error) {
+		t.Fatal("unexpected load")
+		return nil, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, token, got)
+}
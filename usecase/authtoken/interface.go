@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/internal/keyring"
 )
 
 type Usecase interface {
@@ -12,17 +13,32 @@ type Usecase interface {
 	Verify(c context.Context, input *VerifyInput) (*VerifyOutput, error)
 	RegisterBlacklist(c context.Context, input *RegisterBlacklistInput) error
 	GetBlacklist(c context.Context, input *GetBlacklistInput) (*GetBlacklistOutput, error)
+	PurgeBlacklist(c context.Context, input *PurgeBlacklistInput) (*PurgeBlacklistOutput, error)
+	RefreshBlacklist(c context.Context) (*RefreshBlacklistOutput, error)
+	Refresh(c context.Context, input *RefreshInput) (*CreateOutput, error)
+	RevokeRefreshToken(c context.Context, input *RevokeRefreshTokenInput) error
+	PurgeRefreshTokens(c context.Context, input *PurgeRefreshTokensInput) (*PurgeRefreshTokensOutput, error)
+	GetKeySet(c context.Context) (*GetKeySetOutput, error)
+	GetSession(c context.Context, input *GetSessionInput) (*GetSessionOutput, error)
+	FindSessions(c context.Context, input *FindSessionsInput) (*FindSessionsOutput, error)
+	RevokeSession(c context.Context, input *RevokeSessionInput) error
+	PurgeSessions(c context.Context, input *PurgeSessionsInput) (*PurgeSessionsOutput, error)
 }
 
 const ErrNilUsecase domain.ConstantError = "nil AuthTokenUsecase"
 
 type CreateInput struct {
 	Identifier string `validate:"required"`
+	// ClientIP, UserAgent 세션 목록에서 기기를 구분할 수 있도록 세션에 저장합니다.
+	ClientIP  string
+	UserAgent string
 }
 
 type CreateOutput struct {
-	Token     string
-	ExpiresAt time.Time
+	Token                 string
+	ExpiresAt             time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
 }
 
 type VerifyInput struct {
@@ -32,6 +48,11 @@ type VerifyInput struct {
 type VerifyOutput struct {
 	Identifier string
 	ExpiresAt  time.Time
+	IssuedAt   time.Time
+	// FamilyID 함께 발급한 리프레시 토큰의 family 아이디이며, 리프레시 토큰 없이 발급한 토큰이면 비어 있습니다.
+	FamilyID string
+	// SessionID 토큰의 jti이며, 세션의 아이디로 사용합니다.
+	SessionID string
 }
 
 type RegisterBlacklistInput struct {
@@ -39,9 +60,81 @@ type RegisterBlacklistInput struct {
 }
 
 type GetBlacklistInput struct {
-	Token string `validate:"required"`
+	// TokenID 토큰의 jti입니다.
+	TokenID string `validate:"required"`
+	// ExpiresAt 토큰의 만료 시각이며, 블랙리스트에 없다는 조회 결과를 이 시각까지만 캐시합니다.
+	ExpiresAt time.Time `validate:"required"`
 }
 
 type GetBlacklistOutput struct {
 	Token *domain.AuthToken
 }
+
+type PurgeBlacklistInput struct {
+	// ExpiredBefore 이 시각 이전에 만료된 토큰을 블랙리스트에서 삭제합니다.
+	ExpiredBefore time.Time `validate:"required"`
+}
+
+type PurgeBlacklistOutput struct {
+	PurgedCount int
+}
+
+type RefreshBlacklistOutput struct {
+	// TokenCount 블룸 필터에 담긴 만료되지 않은 토큰 수입니다.
+	TokenCount int
+}
+
+type RefreshInput struct {
+	RefreshToken string `validate:"required"`
+	ClientIP     string
+	UserAgent    string
+}
+
+type RevokeRefreshTokenInput struct {
+	// Token 리프레시 토큰과 함께 발급한 액세스 토큰입니다.
+	Token string `validate:"required"`
+}
+
+type PurgeRefreshTokensInput struct {
+	// ExpiredBefore 이 시각 이전에 만료된 리프레시 토큰을 삭제합니다.
+	ExpiredBefore time.Time `validate:"required"`
+}
+
+type PurgeRefreshTokensOutput struct {
+	PurgedCount int
+}
+
+type GetKeySetOutput struct {
+	KeySet keyring.JWKSet
+}
+
+type GetSessionInput struct {
+	SessionID string `validate:"required"`
+}
+
+type GetSessionOutput struct {
+	Session *domain.Session
+}
+
+type FindSessionsInput struct {
+	Identifier string `validate:"required"`
+}
+
+type FindSessionsOutput struct {
+	Sessions []domain.Session
+}
+
+type RevokeSessionInput struct {
+	// Identifier 세션 소유자이며, 다른 유저의 세션은 찾을 수 없는 것으로 처리합니다.
+	Identifier string `validate:"required"`
+	SessionID  string `validate:"required"`
+}
+
+type PurgeSessionsInput struct {
+	// ExpiredBefore 이 시각 이전에 만료된 세션을 삭제합니다.
+	ExpiredBefore time.Time `validate:"required"`
+}
+
+type PurgeSessionsOutput struct {
+	PurgedCount int
+}
@@ -2,8 +2,14 @@ package authtoken
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/psi59/payhere-assignment/repository"
@@ -11,26 +17,100 @@ import (
 	jwt "github.com/golang-jwt/jwt/v5"
 	"github.com/pkg/errors"
 	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/internal/db"
+	"github.com/psi59/payhere-assignment/internal/keyring"
 	"github.com/psi59/payhere-assignment/internal/valid"
 	"github.com/rs/xid"
 )
 
+const (
+	// refreshTokenSize 리프레시 토큰의 바이트 수입니다.
+	refreshTokenSize = 32
+	// maxUserAgentLength 세션에 저장하는 User-Agent의 최대 길이입니다.
+	maxUserAgentLength = 512
+)
+
+func init() {
+	// iat, exp 등의 시각을 초 단위로 내림하지 않고 domain.TokenTimePrecision 단위로 직렬화합니다.
+	jwt.TimePrecision = domain.TokenTimePrecision
+}
+
 type Service struct {
-	secret                   []byte
+	keyring                  *keyring.Keyring
+	parser                   *jwt.Parser
+	config                   Config
 	tokenBlacklistRepository repository.TokenBlacklistRepository
+	refreshTokenRepository   repository.RefreshTokenRepository
+	sessionRepository        repository.SessionRepository
+	userRepository           repository.UserRepository
+	blacklistCache           *blacklistCache
 }
 
-func NewService(secret string, tokenBlacklistRepository repository.TokenBlacklistRepository) (*Service, error) {
-	if len(secret) == 0 {
-		return nil, fmt.Errorf("empty secret")
+// Config 토큰 발급 설정입니다.
+type Config struct {
+	// AccessTokenTTL 액세스 토큰의 유효 기간입니다.
+	AccessTokenTTL time.Duration `validate:"gt=0"`
+	// RefreshTokenTTL 리프레시 토큰의 유효 기간이며, 재발급할 때마다 새로 시작됩니다.
+	RefreshTokenTTL time.Duration `validate:"gtfield=AccessTokenTTL"`
+	// Issuer 액세스 토큰의 iss이며, 검증할 때 일치하지 않으면 거부합니다.
+	Issuer string `validate:"required"`
+	// Audience 액세스 토큰의 aud이며, 검증할 때 포함되지 않으면 거부합니다.
+	Audience string `validate:"required"`
+	// Leeway 서버 간 시각 차이를 고려해 exp, nbf, iat 검증에 허용하는 오차입니다.
+	Leeway time.Duration `validate:"gte=0"`
+	// BlacklistCacheSize 블랙리스트 조회 결과를 캐시하는 최대 토큰 수입니다.
+	BlacklistCacheSize int `validate:"gt=0"`
+	// BlacklistMaxStaleness 블랙리스트 캐시를 갱신하지 못했을 때 캐시를 사용하는 최대 기간이며,
+	// 다른 인스턴스에서 블랙리스트에 등록한 토큰은 이 기간 이내에 거부됩니다.
+	BlacklistMaxStaleness time.Duration `validate:"gt=0"`
+}
+
+func NewService(
+	tokenKeyring *keyring.Keyring,
+	config Config,
+	tokenBlacklistRepository repository.TokenBlacklistRepository,
+	refreshTokenRepository repository.RefreshTokenRepository,
+	sessionRepository repository.SessionRepository,
+	userRepository repository.UserRepository,
+) (*Service, error) {
+	if valid.IsNil(tokenKeyring) {
+		return nil, keyring.ErrNilKeyring
+	}
+	if err := valid.ValidateStruct(config); err != nil {
+		return nil, errors.WithStack(err)
 	}
 	if valid.IsNil(tokenBlacklistRepository) {
 		return nil, repository.ErrNilTokenBlacklistRepository
 	}
+	if valid.IsNil(refreshTokenRepository) {
+		return nil, repository.ErrNilRefreshTokenRepository
+	}
+	if valid.IsNil(sessionRepository) {
+		return nil, repository.ErrNilSessionRepository
+	}
+	if valid.IsNil(userRepository) {
+		return nil, repository.ErrNilUserRepository
+	}
+
+	// 키링에 없는 알고리즘(none, 다른 키의 HS256 등)으로 서명된 토큰은 키를 찾기 전에 거부합니다.
+	parser := jwt.NewParser(
+		jwt.WithValidMethods(tokenKeyring.Algorithms()),
+		jwt.WithIssuer(config.Issuer),
+		jwt.WithAudience(config.Audience),
+		jwt.WithLeeway(config.Leeway),
+		jwt.WithIssuedAt(),
+		jwt.WithExpirationRequired(),
+	)
 
 	return &Service{
-		secret:                   []byte(secret),
+		keyring:                  tokenKeyring,
+		parser:                   parser,
+		config:                   config,
 		tokenBlacklistRepository: tokenBlacklistRepository,
+		refreshTokenRepository:   refreshTokenRepository,
+		sessionRepository:        sessionRepository,
+		userRepository:           userRepository,
+		blacklistCache:           newBlacklistCache(config.BlacklistCacheSize, config.BlacklistMaxStaleness),
 	}, nil
 }
 
@@ -45,28 +125,109 @@ func (s *Service) Create(c context.Context, input *CreateInput) (*CreateOutput,
 		return nil, errors.WithStack(err)
 	}
 
-	issuedAt := time.Now()
-	expiresAt := issuedAt.AddDate(0, 0, 7)
-	claims := &jwt.RegisteredClaims{
-		ID:        xid.New().String(),
-		Issuer:    "payhere-assignment",
-		Subject:   input.Identifier,
-		IssuedAt:  jwt.NewNumericDate(issuedAt),
-		ExpiresAt: jwt.NewNumericDate(expiresAt),
+	// 로그인할 때마다 새 family의 리프레시 토큰을 발급합니다.
+	var output *CreateOutput
+	if err := db.Transaction(c, func(c context.Context) error {
+		var err error
+		output, err = s.issue(c, input.Identifier, xid.New().String(), newClient(input.ClientIP, input.UserAgent), time.Now())
+
+		return errors.WithStack(err)
+	}); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return output, nil
+}
+
+func (s *Service) Refresh(c context.Context, input *RefreshInput) (*CreateOutput, error) {
+	// 1. 파라메터 체크
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case valid.IsNil(input):
+		return nil, domain.ErrNilInput
+	}
+	if err := valid.ValidateStruct(input); err != nil {
+		return nil, errors.WithStack(err)
 	}
 
-	token, err := s.createJWT(claims, s.secret)
+	// 2. 리프레시 토큰 조회, 재사용을 놓치지 않도록 복제본이 아닌 primary에서 조회합니다.
+	tokenHash := hashRefreshToken(input.RefreshToken)
+	token, err := s.refreshTokenRepository.Get(db.ContextWithPrimary(c), tokenHash)
 	if err != nil {
+		if errors.Is(err, domain.ErrRefreshTokenNotFound) {
+			return nil, errors.Wrap(domain.ErrInvalidRefreshToken, err.Error())
+		}
+
 		return nil, errors.WithStack(err)
 	}
+	now := time.Now()
+	switch {
+	case token.RevokedAt != nil:
+		return nil, errors.Wrapf(domain.ErrInvalidRefreshToken, "revoked at %s", token.RevokedAt.UTC())
+	case token.RotatedAt != nil:
+		return nil, s.revokeReusedFamily(c, token, now)
+	case !now.Before(token.ExpiresAt):
+		return nil, errors.Wrapf(domain.ErrInvalidRefreshToken, "expiresAt(%s) < now(%s)", token.ExpiresAt.UTC(), now.UTC())
+	}
 
-	return &CreateOutput{
-		Token:     token,
-		ExpiresAt: expiresAt,
-	}, nil
+	// 3. 사용한 리프레시 토큰을 교체하고 같은 family로 토큰 발급
+	// 기기마다 세션이 하나만 남도록 이전 토큰의 세션은 폐기합니다.
+	var output *CreateOutput
+	if err := db.Transaction(c, func(c context.Context) error {
+		if err := s.refreshTokenRepository.Rotate(c, tokenHash, now); err != nil {
+			return errors.WithStack(err)
+		}
+		if err := s.sessionRepository.RevokeFamily(c, token.FamilyID, now); err != nil {
+			return errors.WithStack(err)
+		}
+		// 비밀번호 변경 등으로 무효화되기 전에 발급된 토큰인지 교체한 뒤 확인해, 무효화와 동시에 재발급되어도 새 토큰이 남지 않도록 합니다.
+		if err := s.checkTokensValidAfter(c, token); err != nil {
+			return errors.WithStack(err)
+		}
+		output, err = s.issue(c, token.Identifier, token.FamilyID, newClient(input.ClientIP, input.UserAgent), now)
+
+		return errors.WithStack(err)
+	}); err != nil {
+		// 같은 토큰으로 동시에 재발급한 경우에도 재사용으로 판단합니다.
+		if errors.Is(err, domain.ErrRefreshTokenAlreadyRotated) {
+			return nil, s.revokeReusedFamily(c, token, now)
+		}
+
+		return nil, errors.WithStack(err)
+	}
+
+	return output, nil
 }
 
-func (s *Service) Verify(c context.Context, input *VerifyInput) (*VerifyOutput, error) {
+// RevokeRefreshToken 액세스 토큰과 함께 발급한 리프레시 토큰과 세션을 폐기합니다.
+func (s *Service) RevokeRefreshToken(c context.Context, input *RevokeRefreshTokenInput) error {
+	switch {
+	case valid.IsNil(c):
+		return domain.ErrNilContext
+	case valid.IsNil(input):
+		return domain.ErrNilInput
+	}
+	if err := valid.ValidateStruct(input); err != nil {
+		return errors.WithStack(err)
+	}
+
+	verifyOutput, err := s.Verify(c, &VerifyInput{Token: input.Token})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	// 리프레시 토큰 없이 발급한 토큰
+	if len(verifyOutput.FamilyID) == 0 {
+		return nil
+	}
+	if err := s.revokeFamily(c, verifyOutput.FamilyID, time.Now()); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (s *Service) PurgeRefreshTokens(c context.Context, input *PurgeRefreshTokensInput) (*PurgeRefreshTokensOutput, error) {
 	switch {
 	case valid.IsNil(c):
 		return nil, domain.ErrNilContext
@@ -77,29 +238,131 @@ func (s *Service) Verify(c context.Context, input *VerifyInput) (*VerifyOutput,
 		return nil, errors.WithStack(err)
 	}
 
-	tokenByte, err := base64.StdEncoding.DecodeString(input.Token)
+	purgedCount, err := s.refreshTokenRepository.DeleteExpired(c, input.ExpiredBefore)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
-	var claims jwt.RegisteredClaims
-	t, err := jwt.ParseWithClaims(string(tokenByte), &claims, func(token *jwt.Token) (any, error) {
-		return s.secret, nil
-	})
+	return &PurgeRefreshTokensOutput{PurgedCount: purgedCount}, nil
+}
+
+// issue familyID의 리프레시 토큰과 액세스 토큰을 발급하고, 액세스 토큰의 jti로 세션을 생성합니다.
+func (s *Service) issue(c context.Context, identifier, familyID string, client client, issuedAt time.Time) (*CreateOutput, error) {
+	// 액세스 토큰의 iat와 리프레시 토큰의 생성 시각이 같은 값으로 토큰 무효화 시각과 비교되도록 정밀도를 맞춥니다.
+	issuedAt = issuedAt.Truncate(domain.TokenTimePrecision)
+	expiresAt := issuedAt.Add(s.config.AccessTokenTTL)
+	claims := &tokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        xid.New().String(),
+			Issuer:    s.config.Issuer,
+			Audience:  jwt.ClaimStrings{s.config.Audience},
+			Subject:   identifier,
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+		FamilyID: familyID,
+	}
+	signingKey, err := s.keyring.SigningKey(issuedAt)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	token, err := s.createJWT(claims, signingKey)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	refreshToken, err := newRefreshToken()
 	if err != nil {
-		if errors.Is(err, jwt.ErrTokenExpired) {
-			return nil, fmt.Errorf("%w: expiresAt(%s) < now(%s)", domain.ErrExpiredToken, claims.ExpiresAt.UTC(), time.Now().UTC())
+		return nil, errors.WithStack(err)
+	}
+	refreshTokenExpiresAt := issuedAt.Add(s.config.RefreshTokenTTL)
+	if err := s.refreshTokenRepository.Create(c, &domain.RefreshToken{
+		TokenHash:  hashRefreshToken(refreshToken),
+		FamilyID:   familyID,
+		Identifier: identifier,
+		ExpiresAt:  refreshTokenExpiresAt,
+		CreatedAt:  issuedAt,
+	}); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err := s.sessionRepository.Create(c, &domain.Session{
+		ID:         claims.ID,
+		FamilyID:   familyID,
+		Identifier: identifier,
+		IP:         client.IP,
+		UserAgent:  client.UserAgent,
+		CreatedAt:  issuedAt,
+		ExpiresAt:  refreshTokenExpiresAt,
+	}); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &CreateOutput{
+		Token:                 token,
+		ExpiresAt:             expiresAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: refreshTokenExpiresAt,
+	}, nil
+}
+
+// revokeReusedFamily 재발급에 사용한 리프레시 토큰을 다시 사용한 경우 탈취된 것으로 보고 family의 토큰과 세션을 모두 폐기합니다.
+func (s *Service) revokeReusedFamily(c context.Context, token *domain.RefreshToken, now time.Time) error {
+	if err := s.revokeFamily(c, token.FamilyID, now); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(fmt.Errorf("%w: %w: familyID(%s)", domain.ErrInvalidRefreshToken, domain.ErrRefreshTokenReused, token.FamilyID))
+}
+
+// revokeFamily family의 리프레시 토큰과 세션을 모두 폐기합니다.
+func (s *Service) revokeFamily(c context.Context, familyID string, revokedAt time.Time) error {
+	return db.Transaction(c, func(c context.Context) error {
+		if err := s.refreshTokenRepository.RevokeFamily(c, familyID, revokedAt); err != nil {
+			return errors.WithStack(err)
+		}
+		if err := s.sessionRepository.RevokeFamily(c, familyID, revokedAt); err != nil {
+			return errors.WithStack(err)
 		}
 
+		return nil
+	})
+}
+
+func (s *Service) Verify(c context.Context, input *VerifyInput) (*VerifyOutput, error) {
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case valid.IsNil(input):
+		return nil, domain.ErrNilInput
+	}
+	if err := valid.ValidateStruct(input); err != nil {
 		return nil, errors.WithStack(err)
 	}
+
+	tokenByte, err := base64.StdEncoding.DecodeString(input.Token)
+	if err != nil {
+		return nil, errors.Wrap(domain.ErrMalformedToken, err.Error())
+	}
+
+	var claims tokenClaims
+	t, err := s.parser.ParseWithClaims(string(tokenByte), &claims, s.keyFunc(time.Now()))
+	if err != nil {
+		return nil, errors.WithStack(s.verifyError(err, t, &claims))
+	}
 	if !t.Valid {
 		return nil, errors.New("invalid token")
 	}
+	// 유저별 토큰 무효화 시각과 비교하기 위해 iat가 반드시 필요합니다.
+	if claims.IssuedAt == nil {
+		return nil, errors.Wrap(domain.ErrTokenClaimMissing, "iat")
+	}
 
 	return &VerifyOutput{
 		Identifier: claims.Subject,
 		ExpiresAt:  claims.ExpiresAt.Time,
+		IssuedAt:   claims.IssuedAt.Time,
+		FamilyID:   claims.FamilyID,
+		SessionID:  claims.ID,
 	}, nil
 }
 
@@ -124,12 +387,13 @@ func (s *Service) RegisterBlacklist(c context.Context, input *RegisterBlacklistI
 	}
 
 	token := &domain.AuthToken{
-		Token:     input.Token,
+		ID:        verifyOutput.SessionID,
 		ExpiresAt: verifyOutput.ExpiresAt,
 	}
 	if err := s.tokenBlacklistRepository.Create(c, token); err != nil {
 		return errors.WithStack(err)
 	}
+	s.blacklistCache.add(token)
 
 	return nil
 }
@@ -145,7 +409,10 @@ func (s *Service) GetBlacklist(c context.Context, input *GetBlacklistInput) (*Ge
 		return nil, errors.WithStack(err)
 	}
 
-	token, err := s.tokenBlacklistRepository.Get(c, input.Token)
+	token, err := s.blacklistCache.get(input.TokenID, input.ExpiresAt, time.Now(), func() (*domain.AuthToken, error) {
+		// 로그아웃 직후의 요청도 거부할 수 있도록 복제본이 아닌 primary에서 조회합니다.
+		return s.tokenBlacklistRepository.Get(db.ContextWithPrimary(c), input.TokenID)
+	})
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -153,9 +420,163 @@ func (s *Service) GetBlacklist(c context.Context, input *GetBlacklistInput) (*Ge
 	return &GetBlacklistOutput{Token: token}, nil
 }
 
-func (s *Service) createJWT(claims jwt.Claims, secret []byte) (string, error) {
-	t := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	token, err := t.SignedString(secret)
+func (s *Service) PurgeBlacklist(c context.Context, input *PurgeBlacklistInput) (*PurgeBlacklistOutput, error) {
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case valid.IsNil(input):
+		return nil, domain.ErrNilInput
+	}
+	if err := valid.ValidateStruct(input); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	purgedCount, err := s.tokenBlacklistRepository.DeleteExpired(c, input.ExpiredBefore)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &PurgeBlacklistOutput{PurgedCount: purgedCount}, nil
+}
+
+// RefreshBlacklist 만료되지 않은 블랙리스트 전체로 블랙리스트 캐시를 갱신합니다.
+// 다른 인스턴스에서 등록한 토큰을 반영하도록 BlacklistMaxStaleness보다 짧은 주기로 호출해야 합니다.
+func (s *Service) RefreshBlacklist(c context.Context) (*RefreshBlacklistOutput, error) {
+	if valid.IsNil(c) {
+		return nil, domain.ErrNilContext
+	}
+
+	// 조회하는 동안 등록된 토큰이 누락될 수 있으므로 조회를 시작한 시각을 갱신 시각으로 사용합니다.
+	refreshedAt := time.Now()
+	tokens, err := s.tokenBlacklistRepository.FindActive(db.ContextWithPrimary(c), refreshedAt)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	s.blacklistCache.refresh(tokens, refreshedAt)
+
+	return &RefreshBlacklistOutput{TokenCount: len(tokens)}, nil
+}
+
+// GetKeySet 토큰 검증에 사용할 수 있는 공개키 목록을 반환합니다.
+func (s *Service) GetKeySet(c context.Context) (*GetKeySetOutput, error) {
+	if valid.IsNil(c) {
+		return nil, domain.ErrNilContext
+	}
+
+	return &GetKeySetOutput{KeySet: s.keyring.JWKS(time.Now())}, nil
+}
+
+func (s *Service) GetSession(c context.Context, input *GetSessionInput) (*GetSessionOutput, error) {
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case valid.IsNil(input):
+		return nil, domain.ErrNilInput
+	}
+	if err := valid.ValidateStruct(input); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// 세션을 폐기한 직후의 요청도 거부할 수 있도록 복제본이 아닌 primary에서 조회합니다.
+	session, err := s.sessionRepository.Get(db.ContextWithPrimary(c), input.SessionID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &GetSessionOutput{Session: session}, nil
+}
+
+// FindSessions 폐기되거나 만료되지 않은 세션 목록을 반환합니다.
+func (s *Service) FindSessions(c context.Context, input *FindSessionsInput) (*FindSessionsOutput, error) {
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case valid.IsNil(input):
+		return nil, domain.ErrNilInput
+	}
+	if err := valid.ValidateStruct(input); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	sessions, err := s.sessionRepository.FindActive(c, input.Identifier, time.Now())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &FindSessionsOutput{Sessions: sessions}, nil
+}
+
+// RevokeSession 세션과 같은 기기에서 발급한 리프레시 토큰을 함께 폐기해 더 이상 재발급할 수 없도록 합니다.
+func (s *Service) RevokeSession(c context.Context, input *RevokeSessionInput) error {
+	// 1. 파라메터 체크
+	switch {
+	case valid.IsNil(c):
+		return domain.ErrNilContext
+	case valid.IsNil(input):
+		return domain.ErrNilInput
+	}
+	if err := valid.ValidateStruct(input); err != nil {
+		return errors.WithStack(err)
+	}
+
+	// 2. 세션 조회, 다른 유저의 세션이나 이미 폐기된 세션은 찾을 수 없는 것으로 처리합니다.
+	session, err := s.sessionRepository.Get(db.ContextWithPrimary(c), input.SessionID)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	now := time.Now()
+	if session.Identifier != input.Identifier || !session.IsActive(now) {
+		return errors.Wrapf(domain.ErrSessionNotFound, "sessionID(%s)", input.SessionID)
+	}
+
+	// 3. 세션 폐기
+	if err := s.revokeFamily(c, session.FamilyID, now); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// checkTokensValidAfter 리프레시 토큰이 유저의 토큰 무효화 시각 이전에 발급된 경우 domain.ErrInvalidRefreshToken을 반환합니다.
+func (s *Service) checkTokensValidAfter(c context.Context, token *domain.RefreshToken) error {
+	userID, err := strconv.Atoi(token.Identifier)
+	if err != nil {
+		return errors.Wrapf(err, "invalid identifier(%s)", token.Identifier)
+	}
+	user, err := s.userRepository.Get(c, userID)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if user.IsTokenInvalidated(token.CreatedAt) {
+		return errors.Wrapf(domain.ErrInvalidRefreshToken, "createdAt(%s) < tokensValidAfter(%s)", token.CreatedAt.UTC(), user.TokensValidAfter.UTC())
+	}
+
+	return nil
+}
+
+func (s *Service) PurgeSessions(c context.Context, input *PurgeSessionsInput) (*PurgeSessionsOutput, error) {
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case valid.IsNil(input):
+		return nil, domain.ErrNilInput
+	}
+	if err := valid.ValidateStruct(input); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	purgedCount, err := s.sessionRepository.DeleteExpired(c, input.ExpiredBefore)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &PurgeSessionsOutput{PurgedCount: purgedCount}, nil
+}
+
+func (s *Service) createJWT(claims jwt.Claims, key *keyring.Key) (string, error) {
+	t := jwt.NewWithClaims(key.Method, claims)
+	t.Header["kid"] = key.ID
+	token, err := t.SignedString(key.SignKey())
 	if err != nil {
 		return "", errors.WithStack(err)
 	}
@@ -163,3 +584,94 @@ func (s *Service) createJWT(claims jwt.Claims, secret []byte) (string, error) {
 
 	return encoded, nil
 }
+
+// keyFunc 토큰 헤더의 kid로 검증 키를 찾으며, 키와 다른 알고리즘으로 서명된 토큰은 거부합니다.
+func (s *Service) keyFunc(now time.Time) jwt.Keyfunc {
+	return func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, err := s.keyring.VerificationKey(kid, now)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain.ErrUnknownTokenKey, err)
+		}
+		if token.Method.Alg() != key.Method.Alg() {
+			return nil, fmt.Errorf("%w: alg(%s), kid(%s)", domain.ErrTokenAlgorithmNotAllowed, token.Method.Alg(), kid)
+		}
+
+		return key.VerifyKey(), nil
+	}
+}
+
+// verifyError jwt 검증 에러를 거부 사유별 도메인 에러로 변환합니다.
+// 클레임 검증 에러는 여러 개가 함께 반환될 수 있으므로 토큰 위조 가능성이 큰 사유를 먼저 확인합니다.
+func (s *Service) verifyError(err error, token *jwt.Token, claims *tokenClaims) error {
+	now := time.Now().UTC()
+	switch {
+	case errors.Is(err, jwt.ErrTokenMalformed):
+		return fmt.Errorf("%w: %w", domain.ErrMalformedToken, err)
+	case errors.Is(err, domain.ErrUnknownTokenKey), errors.Is(err, domain.ErrTokenAlgorithmNotAllowed):
+		return err
+	case errors.Is(err, jwt.ErrTokenUnverifiable):
+		// 지원하지 않는 alg 헤더
+		return fmt.Errorf("%w: %w", domain.ErrTokenAlgorithmNotAllowed, err)
+	case errors.Is(err, jwt.ErrTokenSignatureInvalid):
+		// WithValidMethods에 없는 알고리즘도 ErrTokenSignatureInvalid로 반환됩니다.
+		if token != nil && !slices.Contains(s.keyring.Algorithms(), token.Method.Alg()) {
+			return fmt.Errorf("%w: alg(%s)", domain.ErrTokenAlgorithmNotAllowed, token.Method.Alg())
+		}
+
+		return fmt.Errorf("%w: %w", domain.ErrInvalidTokenSignature, err)
+	case errors.Is(err, jwt.ErrTokenInvalidIssuer):
+		return fmt.Errorf("%w: iss(%s)", domain.ErrInvalidTokenIssuer, claims.Issuer)
+	case errors.Is(err, jwt.ErrTokenInvalidAudience):
+		return fmt.Errorf("%w: aud(%v)", domain.ErrInvalidTokenAudience, claims.Audience)
+	case errors.Is(err, jwt.ErrTokenRequiredClaimMissing):
+		return fmt.Errorf("%w: %w", domain.ErrTokenClaimMissing, err)
+	case errors.Is(err, jwt.ErrTokenUsedBeforeIssued):
+		return fmt.Errorf("%w: issuedAt(%s) > now(%s)", domain.ErrTokenIssuedInFuture, claims.IssuedAt.UTC(), now)
+	case errors.Is(err, jwt.ErrTokenNotValidYet):
+		return fmt.Errorf("%w: notBefore(%s) > now(%s)", domain.ErrTokenNotYetValid, claims.NotBefore.UTC(), now)
+	case errors.Is(err, jwt.ErrTokenExpired):
+		return fmt.Errorf("%w: expiresAt(%s) < now(%s)", domain.ErrExpiredToken, claims.ExpiresAt.UTC(), now)
+	}
+
+	return err
+}
+
+// tokenClaims 액세스 토큰의 클레임입니다.
+type tokenClaims struct {
+	jwt.RegisteredClaims
+	// FamilyID 함께 발급한 리프레시 토큰의 family 아이디입니다.
+	FamilyID string `json:"fid,omitempty"`
+}
+
+// client 토큰을 발급받은 기기 정보입니다.
+type client struct {
+	IP        string
+	UserAgent string
+}
+
+func newClient(ip, userAgent string) client {
+	if len(userAgent) > maxUserAgentLength {
+		userAgent = strings.ToValidUTF8(userAgent[:maxUserAgentLength], "")
+	}
+
+	return client{IP: ip, UserAgent: userAgent}
+}
+
+// newRefreshToken 추측할 수 없는 리프레시 토큰을 생성합니다.
+func newRefreshToken() (string, error) {
+	b := make([]byte, refreshTokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashRefreshToken 저장소에 저장할 리프레시 토큰의 해시를 반환합니다.
+// 리프레시 토큰은 충분히 긴 임의의 값이므로 솔트 없이 SHA-256으로 해시합니다.
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
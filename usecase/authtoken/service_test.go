@@ -2,47 +2,134 @@ package authtoken
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
 	"encoding/base64"
+	"encoding/pem"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/psi59/payhere-assignment/repository/mysql"
 
 	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/repository/memory"
 
 	"github.com/brianvoe/gofakeit/v6"
 	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/psi59/payhere-assignment/internal/db"
+	"github.com/psi59/payhere-assignment/internal/keyring"
 	"github.com/psi59/payhere-assignment/internal/mocks/repomocks"
 	"github.com/rs/xid"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
+var testConfig = Config{
+	AccessTokenTTL:  time.Hour,
+	RefreshTokenTTL: 24 * time.Hour,
+	Issuer:          "payhere-assignment",
+	Audience:        "payhere-assignment-api",
+	Leeway:          30 * time.Second,
+
+	BlacklistCacheSize:    100,
+	BlacklistMaxStaleness: time.Minute,
+}
+
+const testKeyID = "test"
+
+// newTestKey 테스트용 Ed25519 서명 키를 생성합니다.
+func newTestKey(t *testing.T, id string) *keyring.Key {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+	key, err := keyring.ParseKey(id, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	require.NoError(t, err)
+
+	return key
+}
+
+func newTestKeyring(t *testing.T, keys ...*keyring.Key) *keyring.Keyring {
+	if len(keys) == 0 {
+		keys = append(keys, newTestKey(t, testKeyID))
+	}
+	tokenKeyring, err := keyring.New(keys, testConfig.AccessTokenTTL)
+	require.NoError(t, err)
+
+	return tokenKeyring
+}
+
+func testSigningKey(t *testing.T, srv *Service) *keyring.Key {
+	key, err := srv.keyring.SigningKey(time.Now())
+	require.NoError(t, err)
+
+	return key
+}
+
 func TestNewService(t *testing.T) {
 	t.Run("OK", func(t *testing.T) {
 		repo := mysql.NewTokenBlacklistRepository()
-		got, err := NewService(gofakeit.LetterN(10), repo)
+		got, err := NewService(newTestKeyring(t), testConfig, repo, mysql.NewRefreshTokenRepository(), mysql.NewSessionRepository(), mysql.NewUserRepository())
 		require.NoError(t, err)
 		require.NotNil(t, got)
 	})
 
-	t.Run("empty secret", func(t *testing.T) {
+	t.Run("nil keyring", func(t *testing.T) {
+		repo := mysql.NewTokenBlacklistRepository()
+		got, err := NewService(nil, testConfig, repo, mysql.NewRefreshTokenRepository(), mysql.NewSessionRepository(), mysql.NewUserRepository())
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
 		repo := mysql.NewTokenBlacklistRepository()
-		got, err := NewService("", repo)
+		got, err := NewService(newTestKeyring(t), Config{}, repo, mysql.NewRefreshTokenRepository(), mysql.NewSessionRepository(), mysql.NewUserRepository())
+		require.Error(t, err)
+		require.Nil(t, got)
+
+		config := testConfig
+		config.RefreshTokenTTL = time.Minute
+		got, err = NewService(newTestKeyring(t), config, repo, mysql.NewRefreshTokenRepository(), mysql.NewSessionRepository(), mysql.NewUserRepository())
+		require.Error(t, err)
+		require.Nil(t, got)
+
+		config = testConfig
+		config.Audience = ""
+		got, err = NewService(newTestKeyring(t), config, repo, mysql.NewRefreshTokenRepository(), mysql.NewSessionRepository(), mysql.NewUserRepository())
 		require.Error(t, err)
 		require.Nil(t, got)
 	})
 
 	t.Run("nil tokenBlacklistRepository", func(t *testing.T) {
-		got, err := NewService(gofakeit.LetterN(10), nil)
+		got, err := NewService(newTestKeyring(t), testConfig, nil, mysql.NewRefreshTokenRepository(), mysql.NewSessionRepository(), mysql.NewUserRepository())
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+
+	t.Run("nil refreshTokenRepository", func(t *testing.T) {
+		got, err := NewService(newTestKeyring(t), testConfig, mysql.NewTokenBlacklistRepository(), nil, mysql.NewSessionRepository(), mysql.NewUserRepository())
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+
+	t.Run("nil sessionRepository", func(t *testing.T) {
+		got, err := NewService(newTestKeyring(t), testConfig, mysql.NewTokenBlacklistRepository(), mysql.NewRefreshTokenRepository(), nil, mysql.NewUserRepository())
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+
+	t.Run("nil userRepository", func(t *testing.T) {
+		got, err := NewService(newTestKeyring(t), testConfig, mysql.NewTokenBlacklistRepository(), mysql.NewRefreshTokenRepository(), mysql.NewSessionRepository(), nil)
 		require.Error(t, err)
 		require.Nil(t, got)
 	})
 }
 
 func TestService_Create(t *testing.T) {
-	ctx := context.TODO()
-	secret := gofakeit.LetterN(100)
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
+	tokenKeyring := newTestKeyring(t)
 	id := gofakeit.UUID()
 
 	t.Run("OK", func(t *testing.T) {
@@ -50,12 +137,55 @@ func TestService_Create(t *testing.T) {
 		defer ctrl.Finish()
 
 		tokenBlacklistRepo := repomocks.NewMockTokenBlacklistRepository(ctrl)
-		srv, err := NewService(secret, tokenBlacklistRepo)
+		refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+		sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+		srv, err := NewService(tokenKeyring, testConfig, tokenBlacklistRepo, refreshTokenRepo, sessionRepo, repomocks.NewMockUserRepository(ctrl))
 		require.NoError(t, err)
 
-		got, err := srv.Create(ctx, &CreateInput{Identifier: id})
+		input := &CreateInput{Identifier: id, ClientIP: gofakeit.IPv4Address(), UserAgent: gofakeit.UserAgent()}
+		var familyID string
+		refreshTokenRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, refreshToken *domain.RefreshToken) error {
+			familyID = refreshToken.FamilyID
+			return nil
+		})
+		var session *domain.Session
+		sessionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, s *domain.Session) error {
+			session = s
+			return nil
+		})
+		got, err := srv.Create(ctx, input)
 		require.NoError(t, err)
 		require.NotEmpty(t, got)
+
+		// 세션은 액세스 토큰의 jti로 저장합니다.
+		verifyOutput, err := srv.Verify(ctx, &VerifyInput{Token: got.Token})
+		require.NoError(t, err)
+		require.Equal(t, &domain.Session{
+			ID:         verifyOutput.SessionID,
+			FamilyID:   familyID,
+			Identifier: id,
+			IP:         input.ClientIP,
+			UserAgent:  input.UserAgent,
+			CreatedAt:  session.CreatedAt,
+			ExpiresAt:  got.RefreshTokenExpiresAt,
+		}, session)
+	})
+
+	t.Run("세션 저장 실패", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tokenBlacklistRepo := repomocks.NewMockTokenBlacklistRepository(ctrl)
+		refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+		sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+		srv, err := NewService(tokenKeyring, testConfig, tokenBlacklistRepo, refreshTokenRepo, sessionRepo, repomocks.NewMockUserRepository(ctrl))
+		require.NoError(t, err)
+
+		refreshTokenRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+		sessionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(gofakeit.Error())
+		got, err := srv.Create(ctx, &CreateInput{Identifier: id})
+		require.Error(t, err)
+		require.Nil(t, got)
 	})
 
 	t.Run("nil context", func(t *testing.T) {
@@ -63,7 +193,9 @@ func TestService_Create(t *testing.T) {
 		defer ctrl.Finish()
 
 		tokenBlacklistRepo := repomocks.NewMockTokenBlacklistRepository(ctrl)
-		srv, err := NewService(secret, tokenBlacklistRepo)
+		refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+		sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+		srv, err := NewService(tokenKeyring, testConfig, tokenBlacklistRepo, refreshTokenRepo, sessionRepo, repomocks.NewMockUserRepository(ctrl))
 		require.NoError(t, err)
 
 		got, err := srv.Create(nil, &CreateInput{Identifier: id})
@@ -76,7 +208,9 @@ func TestService_Create(t *testing.T) {
 		defer ctrl.Finish()
 
 		tokenBlacklistRepo := repomocks.NewMockTokenBlacklistRepository(ctrl)
-		srv, err := NewService(secret, tokenBlacklistRepo)
+		refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+		sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+		srv, err := NewService(tokenKeyring, testConfig, tokenBlacklistRepo, refreshTokenRepo, sessionRepo, repomocks.NewMockUserRepository(ctrl))
 		require.NoError(t, err)
 
 		got, err := srv.Create(ctx, nil)
@@ -90,8 +224,8 @@ func TestService_Create(t *testing.T) {
 }
 
 func TestService_Verify(t *testing.T) {
-	ctx := context.TODO()
-	secret := gofakeit.LetterN(100)
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
+	tokenKeyring := newTestKeyring(t)
 	id := gofakeit.UUID()
 
 	t.Run("OK", func(t *testing.T) {
@@ -99,9 +233,17 @@ func TestService_Verify(t *testing.T) {
 		defer ctrl.Finish()
 
 		tokenBlacklistRepo := repomocks.NewMockTokenBlacklistRepository(ctrl)
-		srv, err := NewService(secret, tokenBlacklistRepo)
+		refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+		sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+		srv, err := NewService(tokenKeyring, testConfig, tokenBlacklistRepo, refreshTokenRepo, sessionRepo, repomocks.NewMockUserRepository(ctrl))
 		require.NoError(t, err)
 
+		var createdAt time.Time
+		refreshTokenRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, token *domain.RefreshToken) error {
+			createdAt = token.CreatedAt
+			return nil
+		})
+		sessionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
 		createOutput, err := srv.Create(ctx, &CreateInput{Identifier: id})
 		require.NoError(t, err)
 		require.NotEmpty(t, createOutput)
@@ -109,6 +251,9 @@ func TestService_Verify(t *testing.T) {
 		got, err := srv.Verify(ctx, &VerifyInput{Token: createOutput.Token})
 		require.NoError(t, err)
 		require.Equal(t, id, got.Identifier)
+		// iat는 초 단위로 내림하지 않으며, 실수로 변환하는 과정의 오차를 제외하면 리프레시 토큰의 생성 시각과 같습니다.
+		require.Equal(t, createdAt.Truncate(domain.TokenTimePrecision), createdAt)
+		require.WithinDuration(t, createdAt, got.IssuedAt, domain.TokenTimePrecision)
 	})
 
 	t.Run("nil context", func(t *testing.T) {
@@ -116,7 +261,9 @@ func TestService_Verify(t *testing.T) {
 		defer ctrl.Finish()
 
 		tokenBlacklistRepo := repomocks.NewMockTokenBlacklistRepository(ctrl)
-		srv, err := NewService(secret, tokenBlacklistRepo)
+		refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+		sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+		srv, err := NewService(tokenKeyring, testConfig, tokenBlacklistRepo, refreshTokenRepo, sessionRepo, repomocks.NewMockUserRepository(ctrl))
 		require.NoError(t, err)
 
 		got, err := srv.Verify(nil, &VerifyInput{Token: gofakeit.LetterN(500)})
@@ -129,7 +276,9 @@ func TestService_Verify(t *testing.T) {
 		defer ctrl.Finish()
 
 		tokenBlacklistRepo := repomocks.NewMockTokenBlacklistRepository(ctrl)
-		srv, err := NewService(secret, tokenBlacklistRepo)
+		refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+		sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+		srv, err := NewService(tokenKeyring, testConfig, tokenBlacklistRepo, refreshTokenRepo, sessionRepo, repomocks.NewMockUserRepository(ctrl))
 		require.NoError(t, err)
 
 		got, err := srv.Verify(ctx, nil)
@@ -146,7 +295,9 @@ func TestService_Verify(t *testing.T) {
 		defer ctrl.Finish()
 
 		tokenBlacklistRepo := repomocks.NewMockTokenBlacklistRepository(ctrl)
-		srv, err := NewService(secret, tokenBlacklistRepo)
+		refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+		sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+		srv, err := NewService(tokenKeyring, testConfig, tokenBlacklistRepo, refreshTokenRepo, sessionRepo, repomocks.NewMockUserRepository(ctrl))
 		require.NoError(t, err)
 
 		got, err := srv.Verify(ctx, &VerifyInput{Token: gofakeit.Sentence(10)})
@@ -159,23 +310,26 @@ func TestService_Verify(t *testing.T) {
 		require.Nil(t, got)
 	})
 
-	t.Run("invalid secret", func(t *testing.T) {
+	t.Run("invalid signature", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		tokenBlacklistRepo := repomocks.NewMockTokenBlacklistRepository(ctrl)
-		srv, err := NewService(secret, tokenBlacklistRepo)
+		refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+		sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+		srv, err := NewService(tokenKeyring, testConfig, tokenBlacklistRepo, refreshTokenRepo, sessionRepo, repomocks.NewMockUserRepository(ctrl))
 		require.NoError(t, err)
 
 		now := time.Unix(time.Now().Unix(), 0).UTC()
 		claims := &jwt.RegisteredClaims{
 			ID:        xid.New().String(),
-			Issuer:    "payhere-assignment",
+			Issuer:    testConfig.Issuer,
+			Audience:  jwt.ClaimStrings{testConfig.Audience},
 			Subject:   gofakeit.UUID(),
 			IssuedAt:  jwt.NewNumericDate(now),
 			ExpiresAt: jwt.NewNumericDate(now.AddDate(0, 0, 1)),
 		}
-		token, err := srv.createJWT(claims, []byte(gofakeit.UUID()))
+		token, err := srv.createJWT(claims, newTestKey(t, testKeyID))
 		require.NoError(t, err)
 		got, err := srv.Verify(ctx, &VerifyInput{Token: token})
 		require.Error(t, err)
@@ -187,18 +341,21 @@ func TestService_Verify(t *testing.T) {
 		defer ctrl.Finish()
 
 		tokenBlacklistRepo := repomocks.NewMockTokenBlacklistRepository(ctrl)
-		srv, err := NewService(secret, tokenBlacklistRepo)
+		refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+		sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+		srv, err := NewService(tokenKeyring, testConfig, tokenBlacklistRepo, refreshTokenRepo, sessionRepo, repomocks.NewMockUserRepository(ctrl))
 		require.NoError(t, err)
 
 		now := time.Unix(time.Now().Unix(), 0).UTC()
 		claims := &jwt.RegisteredClaims{
 			ID:        xid.New().String(),
-			Issuer:    "payhere-assignment",
+			Issuer:    testConfig.Issuer,
+			Audience:  jwt.ClaimStrings{testConfig.Audience},
 			Subject:   gofakeit.UUID(),
 			IssuedAt:  jwt.NewNumericDate(now.AddDate(0, 0, -2)),
 			ExpiresAt: jwt.NewNumericDate(now.AddDate(0, 0, -1)),
 		}
-		token, err := srv.createJWT(claims, srv.secret)
+		token, err := srv.createJWT(claims, testSigningKey(t, srv))
 		require.NoError(t, err)
 		got, err := srv.Verify(ctx, &VerifyInput{Token: token})
 		require.ErrorIs(t, err, domain.ErrExpiredToken)
@@ -207,8 +364,8 @@ func TestService_Verify(t *testing.T) {
 }
 
 func TestService_RegisterBlacklist(t *testing.T) {
-	ctx := context.TODO()
-	secret := gofakeit.LetterN(100)
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
+	tokenKeyring := newTestKeyring(t)
 	id := gofakeit.UUID()
 
 	t.Run("OK", func(t *testing.T) {
@@ -216,16 +373,28 @@ func TestService_RegisterBlacklist(t *testing.T) {
 		defer ctrl.Finish()
 
 		tokenBlacklistRepo := repomocks.NewMockTokenBlacklistRepository(ctrl)
-		srv, err := NewService(secret, tokenBlacklistRepo)
+		refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+		sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+		srv, err := NewService(tokenKeyring, testConfig, tokenBlacklistRepo, refreshTokenRepo, sessionRepo, repomocks.NewMockUserRepository(ctrl))
 		require.NoError(t, err)
 
+		refreshTokenRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+		sessionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
 		createOutput, err := srv.Create(ctx, &CreateInput{Identifier: id})
 		require.NoError(t, err)
 		require.NotEmpty(t, createOutput)
 
-		tokenBlacklistRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		verifyOutput, err := srv.Verify(ctx, &VerifyInput{Token: createOutput.Token})
+		require.NoError(t, err)
+		wantToken := &domain.AuthToken{ID: verifyOutput.SessionID, ExpiresAt: verifyOutput.ExpiresAt}
+		tokenBlacklistRepo.EXPECT().Create(ctx, wantToken).Return(nil)
 		err = srv.RegisterBlacklist(ctx, &RegisterBlacklistInput{Token: createOutput.Token})
 		require.NoError(t, err)
+
+		// 등록한 인스턴스에서는 DB를 조회하지 않고 바로 거부합니다.
+		got, err := srv.GetBlacklist(ctx, &GetBlacklistInput{TokenID: verifyOutput.SessionID, ExpiresAt: verifyOutput.ExpiresAt})
+		require.NoError(t, err)
+		require.Equal(t, wantToken, got.Token)
 	})
 
 	t.Run("nil context", func(t *testing.T) {
@@ -233,7 +402,9 @@ func TestService_RegisterBlacklist(t *testing.T) {
 		defer ctrl.Finish()
 
 		tokenBlacklistRepo := repomocks.NewMockTokenBlacklistRepository(ctrl)
-		srv, err := NewService(secret, tokenBlacklistRepo)
+		refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+		sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+		srv, err := NewService(tokenKeyring, testConfig, tokenBlacklistRepo, refreshTokenRepo, sessionRepo, repomocks.NewMockUserRepository(ctrl))
 		require.NoError(t, err)
 
 		err = srv.RegisterBlacklist(nil, &RegisterBlacklistInput{Token: gofakeit.UUID()})
@@ -245,7 +416,9 @@ func TestService_RegisterBlacklist(t *testing.T) {
 		defer ctrl.Finish()
 
 		tokenBlacklistRepo := repomocks.NewMockTokenBlacklistRepository(ctrl)
-		srv, err := NewService(secret, tokenBlacklistRepo)
+		refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+		sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+		srv, err := NewService(tokenKeyring, testConfig, tokenBlacklistRepo, refreshTokenRepo, sessionRepo, repomocks.NewMockUserRepository(ctrl))
 		require.NoError(t, err)
 
 		err = srv.RegisterBlacklist(ctx, nil)
@@ -257,7 +430,9 @@ func TestService_RegisterBlacklist(t *testing.T) {
 		defer ctrl.Finish()
 
 		tokenBlacklistRepo := repomocks.NewMockTokenBlacklistRepository(ctrl)
-		srv, err := NewService(secret, tokenBlacklistRepo)
+		refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+		sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+		srv, err := NewService(tokenKeyring, testConfig, tokenBlacklistRepo, refreshTokenRepo, sessionRepo, repomocks.NewMockUserRepository(ctrl))
 		require.NoError(t, err)
 
 		err = srv.RegisterBlacklist(ctx, &RegisterBlacklistInput{Token: ""})
@@ -269,18 +444,21 @@ func TestService_RegisterBlacklist(t *testing.T) {
 		defer ctrl.Finish()
 
 		tokenBlacklistRepo := repomocks.NewMockTokenBlacklistRepository(ctrl)
-		srv, err := NewService(secret, tokenBlacklistRepo)
+		refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+		sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+		srv, err := NewService(tokenKeyring, testConfig, tokenBlacklistRepo, refreshTokenRepo, sessionRepo, repomocks.NewMockUserRepository(ctrl))
 		require.NoError(t, err)
 
 		now := time.Unix(time.Now().Unix(), 0).UTC()
 		claims := &jwt.RegisteredClaims{
 			ID:        xid.New().String(),
-			Issuer:    "payhere-assignment",
+			Issuer:    testConfig.Issuer,
+			Audience:  jwt.ClaimStrings{testConfig.Audience},
 			Subject:   gofakeit.UUID(),
 			IssuedAt:  jwt.NewNumericDate(now.AddDate(0, 0, -2)),
 			ExpiresAt: jwt.NewNumericDate(now.AddDate(0, 0, -1)),
 		}
-		token, err := srv.createJWT(claims, srv.secret)
+		token, err := srv.createJWT(claims, testSigningKey(t, srv))
 		require.NoError(t, err)
 		err = srv.RegisterBlacklist(ctx, &RegisterBlacklistInput{Token: token})
 		require.NoError(t, err)
@@ -291,7 +469,9 @@ func TestService_RegisterBlacklist(t *testing.T) {
 		defer ctrl.Finish()
 
 		tokenBlacklistRepo := repomocks.NewMockTokenBlacklistRepository(ctrl)
-		srv, err := NewService(secret, tokenBlacklistRepo)
+		refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+		sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+		srv, err := NewService(tokenKeyring, testConfig, tokenBlacklistRepo, refreshTokenRepo, sessionRepo, repomocks.NewMockUserRepository(ctrl))
 		require.NoError(t, err)
 
 		err = srv.RegisterBlacklist(ctx, &RegisterBlacklistInput{Token: gofakeit.UUID()})
@@ -303,9 +483,13 @@ func TestService_RegisterBlacklist(t *testing.T) {
 		defer ctrl.Finish()
 
 		tokenBlacklistRepo := repomocks.NewMockTokenBlacklistRepository(ctrl)
-		srv, err := NewService(secret, tokenBlacklistRepo)
+		refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+		sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+		srv, err := NewService(tokenKeyring, testConfig, tokenBlacklistRepo, refreshTokenRepo, sessionRepo, repomocks.NewMockUserRepository(ctrl))
 		require.NoError(t, err)
 
+		refreshTokenRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+		sessionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
 		createOutput, err := srv.Create(ctx, &CreateInput{Identifier: id})
 		require.NoError(t, err)
 		require.NotEmpty(t, createOutput)
@@ -317,63 +501,963 @@ func TestService_RegisterBlacklist(t *testing.T) {
 }
 
 func TestService_GetBlacklist(t *testing.T) {
-	ctx := context.TODO()
-	secret := gofakeit.LetterN(100)
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
+	tokenKeyring := newTestKeyring(t)
 	token := &domain.AuthToken{
-		Token:     gofakeit.UUID(),
-		ExpiresAt: gofakeit.FutureDate(),
+		ID:        xid.New().String(),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	primaryCtx := gomock.Cond(func(x any) bool {
+		return db.UsePrimary(x.(context.Context))
+	})
+	newService := func(t *testing.T) (*Service, *repomocks.MockTokenBlacklistRepository) {
+		ctrl := gomock.NewController(t)
+		tokenBlacklistRepo := repomocks.NewMockTokenBlacklistRepository(ctrl)
+		refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+		sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+		srv, err := NewService(tokenKeyring, testConfig, tokenBlacklistRepo, refreshTokenRepo, sessionRepo, repomocks.NewMockUserRepository(ctrl))
+		require.NoError(t, err)
+
+		return srv, tokenBlacklistRepo
 	}
 
+	t.Run("OK", func(t *testing.T) {
+		srv, tokenBlacklistRepo := newService(t)
+
+		// 조회 결과는 토큰이 만료될 때까지 캐시합니다.
+		tokenBlacklistRepo.EXPECT().Get(primaryCtx, token.ID).Return(token, nil).Times(1)
+		for i := 0; i < 2; i++ {
+			got, err := srv.GetBlacklist(ctx, &GetBlacklistInput{TokenID: token.ID, ExpiresAt: token.ExpiresAt})
+			require.NoError(t, err)
+			require.NotNil(t, got)
+			require.Equal(t, token, got.Token)
+		}
+	})
+
+	t.Run("블랙리스트 캐시를 갱신하기 전에는 DB 조회", func(t *testing.T) {
+		srv, tokenBlacklistRepo := newService(t)
+
+		tokenBlacklistRepo.EXPECT().Get(primaryCtx, token.ID).Return(nil, domain.ErrTokenBlacklistNotFound).Times(2)
+		for i := 0; i < 2; i++ {
+			got, err := srv.GetBlacklist(ctx, &GetBlacklistInput{TokenID: token.ID, ExpiresAt: token.ExpiresAt})
+			require.ErrorIs(t, err, domain.ErrTokenBlacklistNotFound)
+			require.Nil(t, got)
+		}
+	})
+
+	t.Run("블룸 필터에 없는 토큰은 DB를 조회하지 않음", func(t *testing.T) {
+		srv, tokenBlacklistRepo := newService(t)
+
+		tokenBlacklistRepo.EXPECT().FindActive(primaryCtx, gomock.Any()).Return([]domain.AuthToken{*token}, nil)
+		_, err := srv.RefreshBlacklist(ctx)
+		require.NoError(t, err)
+
+		got, err := srv.GetBlacklist(ctx, &GetBlacklistInput{TokenID: xid.New().String(), ExpiresAt: token.ExpiresAt})
+		require.ErrorIs(t, err, domain.ErrTokenBlacklistNotFound)
+		require.Nil(t, got)
+
+		tokenBlacklistRepo.EXPECT().Get(primaryCtx, token.ID).Return(token, nil)
+		got, err = srv.GetBlacklist(ctx, &GetBlacklistInput{TokenID: token.ID, ExpiresAt: token.ExpiresAt})
+		require.NoError(t, err)
+		require.Equal(t, token, got.Token)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		srv, tokenBlacklistRepo := newService(t)
+
+		tokenBlacklistRepo.EXPECT().Get(primaryCtx, token.ID).Return(nil, gofakeit.Error())
+		got, err := srv.GetBlacklist(ctx, &GetBlacklistInput{TokenID: token.ID, ExpiresAt: token.ExpiresAt})
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		srv, _ := newService(t)
+
+		got, err := srv.GetBlacklist(nil, &GetBlacklistInput{TokenID: token.ID, ExpiresAt: token.ExpiresAt})
+		require.Error(t, err)
+		require.Nil(t, got)
+		got, err = srv.GetBlacklist(ctx, nil)
+		require.Error(t, err)
+		require.Nil(t, got)
+		for _, input := range []*GetBlacklistInput{
+			{ExpiresAt: token.ExpiresAt},
+			{TokenID: token.ID},
+		} {
+			got, err := srv.GetBlacklist(ctx, input)
+			require.Error(t, err)
+			require.Nil(t, got)
+		}
+	})
+}
+
+func TestService_RefreshBlacklist(t *testing.T) {
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
+	tokenKeyring := newTestKeyring(t)
+	primaryCtx := gomock.Cond(func(x any) bool {
+		return db.UsePrimary(x.(context.Context))
+	})
+
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	tokenBlacklistRepo := repomocks.NewMockTokenBlacklistRepository(ctrl)
-	srv, err := NewService(secret, tokenBlacklistRepo)
+	refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+	sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+	srv, err := NewService(tokenKeyring, testConfig, tokenBlacklistRepo, refreshTokenRepo, sessionRepo, repomocks.NewMockUserRepository(ctrl))
 	require.NoError(t, err)
 
 	t.Run("OK", func(t *testing.T) {
-		tokenBlacklistRepo.EXPECT().Get(ctx, token.Token).DoAndReturn(func(ctx context.Context, s string) (*domain.AuthToken, error) {
-			return token, nil
-		})
+		tokens := []domain.AuthToken{
+			{ID: xid.New().String(), ExpiresAt: time.Now().Add(time.Hour)},
+			{ID: xid.New().String(), ExpiresAt: time.Now().Add(time.Hour)},
+		}
+		tokenBlacklistRepo.EXPECT().FindActive(primaryCtx, gomock.Any()).Return(tokens, nil)
+
+		got, err := srv.RefreshBlacklist(ctx)
+		require.NoError(t, err)
+		require.Equal(t, &RefreshBlacklistOutput{TokenCount: 2}, got)
+	})
+
+	t.Run("nil context", func(t *testing.T) {
+		got, err := srv.RefreshBlacklist(nil)
+		require.ErrorIs(t, err, domain.ErrNilContext)
+		require.Nil(t, got)
+	})
 
-		got, err := srv.GetBlacklist(ctx, &GetBlacklistInput{
-			Token: token.Token,
+	t.Run("repository error", func(t *testing.T) {
+		tokenBlacklistRepo.EXPECT().FindActive(primaryCtx, gomock.Any()).Return(nil, gofakeit.Error())
+
+		got, err := srv.RefreshBlacklist(ctx)
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+}
+
+func TestService_PurgeBlacklist(t *testing.T) {
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
+	tokenKeyring := newTestKeyring(t)
+	expiredBefore := time.Now()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tokenBlacklistRepo := repomocks.NewMockTokenBlacklistRepository(ctrl)
+	refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+	sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+	srv, err := NewService(tokenKeyring, testConfig, tokenBlacklistRepo, refreshTokenRepo, sessionRepo, repomocks.NewMockUserRepository(ctrl))
+	require.NoError(t, err)
+
+	t.Run("OK", func(t *testing.T) {
+		tokenBlacklistRepo.EXPECT().DeleteExpired(ctx, expiredBefore).Return(3, nil)
+
+		got, err := srv.PurgeBlacklist(ctx, &PurgeBlacklistInput{
+			ExpiredBefore: expiredBefore,
 		})
 		require.NoError(t, err)
-		require.NotNil(t, got)
-		require.Equal(t, token, got.Token)
+		require.Equal(t, &PurgeBlacklistOutput{PurgedCount: 3}, got)
 	})
 
-	t.Run("token not found", func(t *testing.T) {
-		tokenBlacklistRepo.EXPECT().Get(ctx, token.Token).DoAndReturn(func(ctx context.Context, s string) (*domain.AuthToken, error) {
-			return nil, domain.ErrTokenBlacklistNotFound
+	t.Run("failed to delete expired tokens", func(t *testing.T) {
+		tokenBlacklistRepo.EXPECT().DeleteExpired(ctx, expiredBefore).Return(0, gofakeit.Error())
+
+		got, err := srv.PurgeBlacklist(ctx, &PurgeBlacklistInput{
+			ExpiredBefore: expiredBefore,
 		})
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
 
-		got, err := srv.GetBlacklist(ctx, &GetBlacklistInput{
-			Token: token.Token,
+	t.Run("nil context", func(t *testing.T) {
+		got, err := srv.PurgeBlacklist(nil, &PurgeBlacklistInput{
+			ExpiredBefore: expiredBefore,
 		})
 		require.Error(t, err)
 		require.Nil(t, got)
 	})
 
+	t.Run("nil input", func(t *testing.T) {
+		got, err := srv.PurgeBlacklist(ctx, nil)
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		got, err := srv.PurgeBlacklist(ctx, &PurgeBlacklistInput{})
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+}
+
+func TestService_Refresh(t *testing.T) {
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
+	tokenKeyring := newTestKeyring(t)
+	refreshToken := gofakeit.LetterN(43)
+	userID := gofakeit.Number(1, 100)
+	user := &domain.User{ID: userID}
+	tokenHash := hashRefreshToken(refreshToken)
+	primaryCtx := gomock.Cond(func(x any) bool {
+		return db.UsePrimary(x.(context.Context))
+	})
+	newToken := func() *domain.RefreshToken {
+		return &domain.RefreshToken{
+			TokenHash:  tokenHash,
+			FamilyID:   xid.New().String(),
+			Identifier: strconv.Itoa(userID),
+			ExpiresAt:  time.Now().Add(time.Hour),
+			CreatedAt:  time.Now(),
+		}
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tokenBlacklistRepo := repomocks.NewMockTokenBlacklistRepository(ctrl)
+	refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+	sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+	userRepo := repomocks.NewMockUserRepository(ctrl)
+	srv, err := NewService(tokenKeyring, testConfig, tokenBlacklistRepo, refreshTokenRepo, sessionRepo, userRepo)
+	require.NoError(t, err)
+
+	t.Run("OK", func(t *testing.T) {
+		token := newToken()
+		var created *domain.RefreshToken
+		refreshTokenRepo.EXPECT().Get(primaryCtx, tokenHash).Return(token, nil)
+		refreshTokenRepo.EXPECT().Rotate(gomock.Any(), tokenHash, gomock.Any()).Return(nil)
+		// 이전 토큰의 세션은 폐기하고 새 세션을 생성합니다.
+		sessionRepo.EXPECT().RevokeFamily(gomock.Any(), token.FamilyID, gomock.Any()).Return(nil)
+		userRepo.EXPECT().Get(gomock.Any(), userID).Return(user, nil)
+		refreshTokenRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, refreshToken *domain.RefreshToken) error {
+			created = refreshToken
+			return nil
+		})
+		var session *domain.Session
+		sessionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, s *domain.Session) error {
+			session = s
+			return nil
+		})
+
+		input := &RefreshInput{RefreshToken: refreshToken, ClientIP: gofakeit.IPv4Address(), UserAgent: gofakeit.LetterN(maxUserAgentLength + 1)}
+		got, err := srv.Refresh(ctx, input)
+		require.NoError(t, err)
+		require.NotEqual(t, refreshToken, got.RefreshToken)
+		require.Equal(t, hashRefreshToken(got.RefreshToken), created.TokenHash)
+		require.Equal(t, token.FamilyID, created.FamilyID)
+		require.Equal(t, token.Identifier, created.Identifier)
+
+		verifyOutput, err := srv.Verify(ctx, &VerifyInput{Token: got.Token})
+		require.NoError(t, err)
+		require.Equal(t, token.Identifier, verifyOutput.Identifier)
+		require.Equal(t, token.FamilyID, verifyOutput.FamilyID)
+		require.Equal(t, verifyOutput.SessionID, session.ID)
+		require.Equal(t, token.FamilyID, session.FamilyID)
+		require.Equal(t, input.ClientIP, session.IP)
+		require.Equal(t, input.UserAgent[:maxUserAgentLength], session.UserAgent)
+	})
+
+	t.Run("reused token", func(t *testing.T) {
+		token := newToken()
+		rotatedAt := time.Now().Add(-time.Minute)
+		token.RotatedAt = &rotatedAt
+		refreshTokenRepo.EXPECT().Get(primaryCtx, tokenHash).Return(token, nil)
+		refreshTokenRepo.EXPECT().RevokeFamily(gomock.Any(), token.FamilyID, gomock.Any()).Return(nil)
+		sessionRepo.EXPECT().RevokeFamily(gomock.Any(), token.FamilyID, gomock.Any()).Return(nil)
+
+		got, err := srv.Refresh(ctx, &RefreshInput{RefreshToken: refreshToken})
+		require.ErrorIs(t, err, domain.ErrInvalidRefreshToken)
+		require.ErrorIs(t, err, domain.ErrRefreshTokenReused)
+		require.Nil(t, got)
+	})
+
+	t.Run("concurrently rotated token", func(t *testing.T) {
+		token := newToken()
+		refreshTokenRepo.EXPECT().Get(primaryCtx, tokenHash).Return(token, nil)
+		refreshTokenRepo.EXPECT().Rotate(gomock.Any(), tokenHash, gomock.Any()).Return(domain.ErrRefreshTokenAlreadyRotated)
+		refreshTokenRepo.EXPECT().RevokeFamily(gomock.Any(), token.FamilyID, gomock.Any()).Return(nil)
+		sessionRepo.EXPECT().RevokeFamily(gomock.Any(), token.FamilyID, gomock.Any()).Return(nil)
+
+		got, err := srv.Refresh(ctx, &RefreshInput{RefreshToken: refreshToken})
+		require.ErrorIs(t, err, domain.ErrRefreshTokenReused)
+		require.Nil(t, got)
+	})
+
+	t.Run("비밀번호 변경 전에 발급된 토큰", func(t *testing.T) {
+		token := newToken()
+		tokensValidAfter := token.CreatedAt.Add(time.Second)
+		refreshTokenRepo.EXPECT().Get(primaryCtx, tokenHash).Return(token, nil)
+		refreshTokenRepo.EXPECT().Rotate(gomock.Any(), tokenHash, gomock.Any()).Return(nil)
+		sessionRepo.EXPECT().RevokeFamily(gomock.Any(), token.FamilyID, gomock.Any()).Return(nil)
+		userRepo.EXPECT().Get(gomock.Any(), userID).Return(&domain.User{ID: userID, TokensValidAfter: &tokensValidAfter}, nil)
+
+		got, err := srv.Refresh(ctx, &RefreshInput{RefreshToken: refreshToken})
+		require.ErrorIs(t, err, domain.ErrInvalidRefreshToken)
+		require.NotErrorIs(t, err, domain.ErrRefreshTokenReused)
+		require.Nil(t, got)
+	})
+
+	t.Run("revoked token", func(t *testing.T) {
+		token := newToken()
+		revokedAt := time.Now().Add(-time.Minute)
+		token.RevokedAt = &revokedAt
+		refreshTokenRepo.EXPECT().Get(primaryCtx, tokenHash).Return(token, nil)
+
+		got, err := srv.Refresh(ctx, &RefreshInput{RefreshToken: refreshToken})
+		require.ErrorIs(t, err, domain.ErrInvalidRefreshToken)
+		require.Nil(t, got)
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		token := newToken()
+		token.ExpiresAt = time.Now().Add(-time.Minute)
+		refreshTokenRepo.EXPECT().Get(primaryCtx, tokenHash).Return(token, nil)
+
+		got, err := srv.Refresh(ctx, &RefreshInput{RefreshToken: refreshToken})
+		require.ErrorIs(t, err, domain.ErrInvalidRefreshToken)
+		require.Nil(t, got)
+	})
+
 	t.Run("token not found", func(t *testing.T) {
-		got, err := srv.GetBlacklist(nil, &GetBlacklistInput{
-			Token: token.Token,
+		refreshTokenRepo.EXPECT().Get(primaryCtx, tokenHash).Return(nil, domain.ErrRefreshTokenNotFound)
+
+		got, err := srv.Refresh(ctx, &RefreshInput{RefreshToken: refreshToken})
+		require.ErrorIs(t, err, domain.ErrInvalidRefreshToken)
+		require.Nil(t, got)
+	})
+
+	t.Run("failed to rotate", func(t *testing.T) {
+		token := newToken()
+		refreshTokenRepo.EXPECT().Get(primaryCtx, tokenHash).Return(token, nil)
+		refreshTokenRepo.EXPECT().Rotate(gomock.Any(), tokenHash, gomock.Any()).Return(gofakeit.Error())
+
+		got, err := srv.Refresh(ctx, &RefreshInput{RefreshToken: refreshToken})
+		require.Error(t, err)
+		require.NotErrorIs(t, err, domain.ErrInvalidRefreshToken)
+		require.Nil(t, got)
+	})
+
+	t.Run("nil context", func(t *testing.T) {
+		got, err := srv.Refresh(nil, &RefreshInput{RefreshToken: refreshToken})
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		got, err := srv.Refresh(ctx, nil)
+		require.Error(t, err)
+		require.Nil(t, got)
+
+		got, err = srv.Refresh(ctx, &RefreshInput{})
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+}
+
+func TestService_RevokeRefreshToken(t *testing.T) {
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
+	tokenKeyring := newTestKeyring(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tokenBlacklistRepo := repomocks.NewMockTokenBlacklistRepository(ctrl)
+	refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+	sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+	srv, err := NewService(tokenKeyring, testConfig, tokenBlacklistRepo, refreshTokenRepo, sessionRepo, repomocks.NewMockUserRepository(ctrl))
+	require.NoError(t, err)
+
+	t.Run("OK", func(t *testing.T) {
+		var familyID string
+		refreshTokenRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, refreshToken *domain.RefreshToken) error {
+			familyID = refreshToken.FamilyID
+			return nil
+		})
+		sessionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+		createOutput, err := srv.Create(ctx, &CreateInput{Identifier: gofakeit.UUID()})
+		require.NoError(t, err)
+
+		refreshTokenRepo.EXPECT().RevokeFamily(gomock.Any(), familyID, gomock.Any()).Return(nil)
+		sessionRepo.EXPECT().RevokeFamily(gomock.Any(), familyID, gomock.Any()).Return(nil)
+		err = srv.RevokeRefreshToken(ctx, &RevokeRefreshTokenInput{Token: createOutput.Token})
+		require.NoError(t, err)
+	})
+
+	t.Run("token without family", func(t *testing.T) {
+		now := time.Now()
+		token, err := srv.createJWT(&jwt.RegisteredClaims{
+			ID:        xid.New().String(),
+			Issuer:    testConfig.Issuer,
+			Audience:  jwt.ClaimStrings{testConfig.Audience},
+			Subject:   gofakeit.UUID(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+		}, testSigningKey(t, srv))
+		require.NoError(t, err)
+
+		err = srv.RevokeRefreshToken(ctx, &RevokeRefreshTokenInput{Token: token})
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		err := srv.RevokeRefreshToken(ctx, &RevokeRefreshTokenInput{Token: gofakeit.UUID()})
+		require.Error(t, err)
+	})
+
+	t.Run("nil context", func(t *testing.T) {
+		err := srv.RevokeRefreshToken(nil, &RevokeRefreshTokenInput{Token: gofakeit.UUID()})
+		require.Error(t, err)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		err := srv.RevokeRefreshToken(ctx, nil)
+		require.Error(t, err)
+
+		err = srv.RevokeRefreshToken(ctx, &RevokeRefreshTokenInput{})
+		require.Error(t, err)
+	})
+}
+
+func TestService_PurgeRefreshTokens(t *testing.T) {
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
+	tokenKeyring := newTestKeyring(t)
+	expiredBefore := time.Now()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tokenBlacklistRepo := repomocks.NewMockTokenBlacklistRepository(ctrl)
+	refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+	sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+	srv, err := NewService(tokenKeyring, testConfig, tokenBlacklistRepo, refreshTokenRepo, sessionRepo, repomocks.NewMockUserRepository(ctrl))
+	require.NoError(t, err)
+
+	t.Run("OK", func(t *testing.T) {
+		refreshTokenRepo.EXPECT().DeleteExpired(ctx, expiredBefore).Return(3, nil)
+
+		got, err := srv.PurgeRefreshTokens(ctx, &PurgeRefreshTokensInput{
+			ExpiredBefore: expiredBefore,
+		})
+		require.NoError(t, err)
+		require.Equal(t, &PurgeRefreshTokensOutput{PurgedCount: 3}, got)
+	})
+
+	t.Run("failed to delete expired tokens", func(t *testing.T) {
+		refreshTokenRepo.EXPECT().DeleteExpired(ctx, expiredBefore).Return(0, gofakeit.Error())
+
+		got, err := srv.PurgeRefreshTokens(ctx, &PurgeRefreshTokensInput{
+			ExpiredBefore: expiredBefore,
 		})
 		require.Error(t, err)
 		require.Nil(t, got)
 	})
 
-	t.Run("nil input", func(t *testing.T) {
-		got, err := srv.GetBlacklist(ctx, nil)
+	t.Run("nil context", func(t *testing.T) {
+		got, err := srv.PurgeRefreshTokens(nil, &PurgeRefreshTokensInput{
+			ExpiredBefore: expiredBefore,
+		})
 		require.Error(t, err)
 		require.Nil(t, got)
 	})
 
 	t.Run("invalid input", func(t *testing.T) {
-		got, err := srv.GetBlacklist(ctx, &GetBlacklistInput{
-			Token: "",
+		got, err := srv.PurgeRefreshTokens(ctx, nil)
+		require.Error(t, err)
+		require.Nil(t, got)
+
+		got, err = srv.PurgeRefreshTokens(ctx, &PurgeRefreshTokensInput{})
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+}
+
+func TestService_Verify_KeyRotation(t *testing.T) {
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
+	id := gofakeit.UUID()
+	now := time.Now()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tokenBlacklistRepo := repomocks.NewMockTokenBlacklistRepository(ctrl)
+	refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+	sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+	refreshTokenRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	sessionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	// 이전 키로 발급한 토큰
+	oldKey := newTestKey(t, "old")
+	oldSrv, err := NewService(newTestKeyring(t, oldKey), testConfig, tokenBlacklistRepo, refreshTokenRepo, sessionRepo, repomocks.NewMockUserRepository(ctrl))
+	require.NoError(t, err)
+	oldOutput, err := oldSrv.Create(ctx, &CreateInput{Identifier: id})
+	require.NoError(t, err)
+
+	t.Run("폐기한 키는 rotation window 동안 검증에 사용", func(t *testing.T) {
+		retiredKey := *oldKey
+		retiredKey.RetiredAt = now.Add(-time.Minute)
+		newKey := newTestKey(t, "new")
+		srv, err := NewService(newTestKeyring(t, &retiredKey, newKey), testConfig, tokenBlacklistRepo, refreshTokenRepo, sessionRepo, repomocks.NewMockUserRepository(ctrl))
+		require.NoError(t, err)
+
+		got, err := srv.Verify(ctx, &VerifyInput{Token: oldOutput.Token})
+		require.NoError(t, err)
+		require.Equal(t, id, got.Identifier)
+
+		// 새 토큰은 새 키로 서명합니다.
+		createOutput, err := srv.Create(ctx, &CreateInput{Identifier: id})
+		require.NoError(t, err)
+		_, err = oldSrv.Verify(ctx, &VerifyInput{Token: createOutput.Token})
+		require.ErrorIs(t, err, keyring.ErrKeyNotFound)
+	})
+
+	t.Run("rotation window가 지난 키", func(t *testing.T) {
+		retiredKey := *oldKey
+		retiredKey.RetiredAt = now.Add(-testConfig.AccessTokenTTL - time.Minute)
+		srv, err := NewService(newTestKeyring(t, &retiredKey, newTestKey(t, "new")), testConfig, tokenBlacklistRepo, refreshTokenRepo, sessionRepo, repomocks.NewMockUserRepository(ctrl))
+		require.NoError(t, err)
+
+		got, err := srv.Verify(ctx, &VerifyInput{Token: oldOutput.Token})
+		require.ErrorIs(t, err, keyring.ErrKeyExpired)
+		require.Nil(t, got)
+	})
+
+	t.Run("키와 다른 알고리즘으로 서명한 토큰", func(t *testing.T) {
+		hmacKey, err := keyring.NewHMACKey(oldKey.ID, []byte(gofakeit.LetterN(32)))
+		require.NoError(t, err)
+		token, err := oldSrv.createJWT(&jwt.RegisteredClaims{
+			Subject:   id,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+		}, hmacKey)
+		require.NoError(t, err)
+
+		got, err := oldSrv.Verify(ctx, &VerifyInput{Token: token})
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+}
+
+func TestService_GetKeySet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tokenBlacklistRepo := repomocks.NewMockTokenBlacklistRepository(ctrl)
+	refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+	sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+	srv, err := NewService(newTestKeyring(t), testConfig, tokenBlacklistRepo, refreshTokenRepo, sessionRepo, repomocks.NewMockUserRepository(ctrl))
+	require.NoError(t, err)
+
+	t.Run("OK", func(t *testing.T) {
+		got, err := srv.GetKeySet(context.TODO())
+		require.NoError(t, err)
+		require.Len(t, got.KeySet.Keys, 1)
+		require.Equal(t, testKeyID, got.KeySet.Keys[0].KeyID)
+		require.Equal(t, "EdDSA", got.KeySet.Keys[0].Algorithm)
+	})
+
+	t.Run("nil context", func(t *testing.T) {
+		got, err := srv.GetKeySet(nil)
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+}
+
+func TestService_Verify_Rejections(t *testing.T) {
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
+	now := time.Now()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tokenBlacklistRepo := repomocks.NewMockTokenBlacklistRepository(ctrl)
+	refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+	sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+	srv, err := NewService(newTestKeyring(t), testConfig, tokenBlacklistRepo, refreshTokenRepo, sessionRepo, repomocks.NewMockUserRepository(ctrl))
+	require.NoError(t, err)
+	signingKey := testSigningKey(t, srv)
+
+	newClaims := func() *jwt.RegisteredClaims {
+		return &jwt.RegisteredClaims{
+			ID:        xid.New().String(),
+			Issuer:    testConfig.Issuer,
+			Audience:  jwt.ClaimStrings{testConfig.Audience},
+			Subject:   gofakeit.UUID(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+		}
+	}
+	sign := func(t *testing.T, claims *jwt.RegisteredClaims) string {
+		token, err := srv.createJWT(claims, signingKey)
+		require.NoError(t, err)
+
+		return token
+	}
+	encode := func(token string) string {
+		return base64.StdEncoding.EncodeToString([]byte(token))
+	}
+
+	t.Run("허용 오차 이내", func(t *testing.T) {
+		claims := newClaims()
+		claims.IssuedAt = jwt.NewNumericDate(now.Add(testConfig.Leeway / 2))
+		claims.ExpiresAt = jwt.NewNumericDate(now.Add(-testConfig.Leeway / 2))
+
+		got, err := srv.Verify(ctx, &VerifyInput{Token: sign(t, claims)})
+		require.NoError(t, err)
+		require.Equal(t, claims.Subject, got.Identifier)
+	})
+
+	tests := []struct {
+		name  string
+		token func(t *testing.T) string
+		want  error
+	}{
+		{
+			name:  "base64가 아닌 토큰",
+			token: func(t *testing.T) string { return "not base64!" },
+			want:  domain.ErrMalformedToken,
+		},
+		{
+			name:  "JWT가 아닌 토큰",
+			token: func(t *testing.T) string { return encode(gofakeit.LetterN(100)) },
+			want:  domain.ErrMalformedToken,
+		},
+		{
+			name: "none 알고리즘",
+			token: func(t *testing.T) string {
+				token, err := jwt.NewWithClaims(jwt.SigningMethodNone, newClaims()).SignedString(jwt.UnsafeAllowNoneSignatureType)
+				require.NoError(t, err)
+
+				return encode(token)
+			},
+			want: domain.ErrTokenAlgorithmNotAllowed,
+		},
+		{
+			name: "키링에 없는 알고리즘",
+			token: func(t *testing.T) string {
+				hmacKey, err := keyring.NewHMACKey(testKeyID, []byte(gofakeit.LetterN(32)))
+				require.NoError(t, err)
+				token, err := srv.createJWT(newClaims(), hmacKey)
+				require.NoError(t, err)
+
+				return token
+			},
+			want: domain.ErrTokenAlgorithmNotAllowed,
+		},
+		{
+			name: "지원하지 않는 알고리즘",
+			token: func(t *testing.T) string {
+				header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"XX256","kid":"test","typ":"JWT"}`))
+				payload := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"1"}`))
+
+				return encode(header + "." + payload + ".c2ln")
+			},
+			want: domain.ErrTokenAlgorithmNotAllowed,
+		},
+		{
+			name: "알 수 없는 kid",
+			token: func(t *testing.T) string {
+				token, err := srv.createJWT(newClaims(), newTestKey(t, "unknown"))
+				require.NoError(t, err)
+
+				return token
+			},
+			want: domain.ErrUnknownTokenKey,
+		},
+		{
+			name: "잘못된 서명",
+			token: func(t *testing.T) string {
+				token, err := srv.createJWT(newClaims(), newTestKey(t, testKeyID))
+				require.NoError(t, err)
+
+				return token
+			},
+			want: domain.ErrInvalidTokenSignature,
+		},
+		{
+			name: "다른 issuer",
+			token: func(t *testing.T) string {
+				claims := newClaims()
+				claims.Issuer = gofakeit.DomainName()
+
+				return sign(t, claims)
+			},
+			want: domain.ErrInvalidTokenIssuer,
+		},
+		{
+			name: "다른 audience",
+			token: func(t *testing.T) string {
+				claims := newClaims()
+				claims.Audience = jwt.ClaimStrings{gofakeit.DomainName()}
+
+				return sign(t, claims)
+			},
+			want: domain.ErrInvalidTokenAudience,
+		},
+		{
+			name: "audience 없음",
+			token: func(t *testing.T) string {
+				claims := newClaims()
+				claims.Audience = nil
+
+				return sign(t, claims)
+			},
+			want: domain.ErrTokenClaimMissing,
+		},
+		{
+			name: "exp 없음",
+			token: func(t *testing.T) string {
+				claims := newClaims()
+				claims.ExpiresAt = nil
+
+				return sign(t, claims)
+			},
+			want: domain.ErrTokenClaimMissing,
+		},
+		{
+			name: "iat 없음",
+			token: func(t *testing.T) string {
+				claims := newClaims()
+				claims.IssuedAt = nil
+
+				return sign(t, claims)
+			},
+			want: domain.ErrTokenClaimMissing,
+		},
+		{
+			name: "미래에 발급된 토큰",
+			token: func(t *testing.T) string {
+				claims := newClaims()
+				claims.IssuedAt = jwt.NewNumericDate(now.Add(testConfig.Leeway + time.Minute))
+
+				return sign(t, claims)
+			},
+			want: domain.ErrTokenIssuedInFuture,
+		},
+		{
+			name: "아직 유효하지 않은 토큰",
+			token: func(t *testing.T) string {
+				claims := newClaims()
+				claims.NotBefore = jwt.NewNumericDate(now.Add(testConfig.Leeway + time.Minute))
+
+				return sign(t, claims)
+			},
+			want: domain.ErrTokenNotYetValid,
+		},
+		{
+			name: "허용 오차를 넘겨 만료된 토큰",
+			token: func(t *testing.T) string {
+				claims := newClaims()
+				claims.IssuedAt = jwt.NewNumericDate(now.Add(-time.Hour))
+				claims.ExpiresAt = jwt.NewNumericDate(now.Add(-testConfig.Leeway - time.Second))
+
+				return sign(t, claims)
+			},
+			want: domain.ErrExpiredToken,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := srv.Verify(ctx, &VerifyInput{Token: tt.token(t)})
+			require.ErrorIs(t, err, tt.want)
+			require.Nil(t, got)
 		})
+	}
+}
+
+func TestService_GetSession(t *testing.T) {
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
+	primaryCtx := gomock.Cond(func(x any) bool {
+		return db.UsePrimary(x.(context.Context))
+	})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+	srv, err := NewService(newTestKeyring(t), testConfig, repomocks.NewMockTokenBlacklistRepository(ctrl), repomocks.NewMockRefreshTokenRepository(ctrl), sessionRepo, repomocks.NewMockUserRepository(ctrl))
+	require.NoError(t, err)
+
+	t.Run("OK", func(t *testing.T) {
+		session := &domain.Session{ID: xid.New().String()}
+		sessionRepo.EXPECT().Get(primaryCtx, session.ID).Return(session, nil)
+
+		got, err := srv.GetSession(ctx, &GetSessionInput{SessionID: session.ID})
+		require.NoError(t, err)
+		require.Equal(t, &GetSessionOutput{Session: session}, got)
+	})
+
+	t.Run("session not found", func(t *testing.T) {
+		sessionID := xid.New().String()
+		sessionRepo.EXPECT().Get(primaryCtx, sessionID).Return(nil, domain.ErrSessionNotFound)
+
+		got, err := srv.GetSession(ctx, &GetSessionInput{SessionID: sessionID})
+		require.ErrorIs(t, err, domain.ErrSessionNotFound)
+		require.Nil(t, got)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		got, err := srv.GetSession(nil, &GetSessionInput{SessionID: xid.New().String()})
+		require.Error(t, err)
+		require.Nil(t, got)
+
+		got, err = srv.GetSession(ctx, &GetSessionInput{})
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+}
+
+func TestService_FindSessions(t *testing.T) {
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
+	identifier := gofakeit.Numerify("###")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+	srv, err := NewService(newTestKeyring(t), testConfig, repomocks.NewMockTokenBlacklistRepository(ctrl), repomocks.NewMockRefreshTokenRepository(ctrl), sessionRepo, repomocks.NewMockUserRepository(ctrl))
+	require.NoError(t, err)
+
+	t.Run("OK", func(t *testing.T) {
+		sessions := []domain.Session{{ID: xid.New().String(), Identifier: identifier}}
+		sessionRepo.EXPECT().FindActive(ctx, identifier, gomock.Any()).Return(sessions, nil)
+
+		got, err := srv.FindSessions(ctx, &FindSessionsInput{Identifier: identifier})
+		require.NoError(t, err)
+		require.Equal(t, &FindSessionsOutput{Sessions: sessions}, got)
+	})
+
+	t.Run("failed to find sessions", func(t *testing.T) {
+		sessionRepo.EXPECT().FindActive(ctx, identifier, gomock.Any()).Return(nil, gofakeit.Error())
+
+		got, err := srv.FindSessions(ctx, &FindSessionsInput{Identifier: identifier})
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		got, err := srv.FindSessions(nil, &FindSessionsInput{Identifier: identifier})
+		require.Error(t, err)
+		require.Nil(t, got)
+
+		got, err = srv.FindSessions(ctx, &FindSessionsInput{})
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+}
+
+func TestService_RevokeSession(t *testing.T) {
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
+	identifier := gofakeit.Numerify("###")
+	primaryCtx := gomock.Cond(func(x any) bool {
+		return db.UsePrimary(x.(context.Context))
+	})
+	newSession := func() *domain.Session {
+		return &domain.Session{
+			ID:         xid.New().String(),
+			FamilyID:   xid.New().String(),
+			Identifier: identifier,
+			CreatedAt:  time.Now(),
+			ExpiresAt:  time.Now().Add(time.Hour),
+		}
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+	sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+	srv, err := NewService(newTestKeyring(t), testConfig, repomocks.NewMockTokenBlacklistRepository(ctrl), refreshTokenRepo, sessionRepo, repomocks.NewMockUserRepository(ctrl))
+	require.NoError(t, err)
+
+	t.Run("OK", func(t *testing.T) {
+		session := newSession()
+		sessionRepo.EXPECT().Get(primaryCtx, session.ID).Return(session, nil)
+		// 같은 기기에서 재발급할 수 없도록 리프레시 토큰도 함께 폐기합니다.
+		refreshTokenRepo.EXPECT().RevokeFamily(gomock.Any(), session.FamilyID, gomock.Any()).Return(nil)
+		sessionRepo.EXPECT().RevokeFamily(gomock.Any(), session.FamilyID, gomock.Any()).Return(nil)
+
+		err := srv.RevokeSession(ctx, &RevokeSessionInput{Identifier: identifier, SessionID: session.ID})
+		require.NoError(t, err)
+	})
+
+	t.Run("다른 유저의 세션", func(t *testing.T) {
+		session := newSession()
+		session.Identifier = gofakeit.UUID()
+		sessionRepo.EXPECT().Get(primaryCtx, session.ID).Return(session, nil)
+
+		err := srv.RevokeSession(ctx, &RevokeSessionInput{Identifier: identifier, SessionID: session.ID})
+		require.ErrorIs(t, err, domain.ErrSessionNotFound)
+	})
+
+	t.Run("이미 폐기된 세션", func(t *testing.T) {
+		session := newSession()
+		revokedAt := time.Now().Add(-time.Minute)
+		session.RevokedAt = &revokedAt
+		sessionRepo.EXPECT().Get(primaryCtx, session.ID).Return(session, nil)
+
+		err := srv.RevokeSession(ctx, &RevokeSessionInput{Identifier: identifier, SessionID: session.ID})
+		require.ErrorIs(t, err, domain.ErrSessionNotFound)
+	})
+
+	t.Run("session not found", func(t *testing.T) {
+		sessionID := xid.New().String()
+		sessionRepo.EXPECT().Get(primaryCtx, sessionID).Return(nil, domain.ErrSessionNotFound)
+
+		err := srv.RevokeSession(ctx, &RevokeSessionInput{Identifier: identifier, SessionID: sessionID})
+		require.ErrorIs(t, err, domain.ErrSessionNotFound)
+	})
+
+	t.Run("failed to revoke", func(t *testing.T) {
+		session := newSession()
+		sessionRepo.EXPECT().Get(primaryCtx, session.ID).Return(session, nil)
+		refreshTokenRepo.EXPECT().RevokeFamily(gomock.Any(), session.FamilyID, gomock.Any()).Return(gofakeit.Error())
+
+		err := srv.RevokeSession(ctx, &RevokeSessionInput{Identifier: identifier, SessionID: session.ID})
+		require.Error(t, err)
+		require.NotErrorIs(t, err, domain.ErrSessionNotFound)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		err := srv.RevokeSession(nil, &RevokeSessionInput{Identifier: identifier, SessionID: xid.New().String()})
+		require.Error(t, err)
+		err = srv.RevokeSession(ctx, nil)
+		require.Error(t, err)
+		err = srv.RevokeSession(ctx, &RevokeSessionInput{Identifier: identifier})
+		require.Error(t, err)
+	})
+}
+
+func TestService_PurgeSessions(t *testing.T) {
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
+	expiredBefore := time.Now()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+	srv, err := NewService(newTestKeyring(t), testConfig, repomocks.NewMockTokenBlacklistRepository(ctrl), repomocks.NewMockRefreshTokenRepository(ctrl), sessionRepo, repomocks.NewMockUserRepository(ctrl))
+	require.NoError(t, err)
+
+	t.Run("OK", func(t *testing.T) {
+		sessionRepo.EXPECT().DeleteExpired(ctx, expiredBefore).Return(3, nil)
+
+		got, err := srv.PurgeSessions(ctx, &PurgeSessionsInput{ExpiredBefore: expiredBefore})
+		require.NoError(t, err)
+		require.Equal(t, &PurgeSessionsOutput{PurgedCount: 3}, got)
+	})
+
+	t.Run("failed to delete expired sessions", func(t *testing.T) {
+		sessionRepo.EXPECT().DeleteExpired(ctx, expiredBefore).Return(0, gofakeit.Error())
+
+		got, err := srv.PurgeSessions(ctx, &PurgeSessionsInput{ExpiredBefore: expiredBefore})
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		got, err := srv.PurgeSessions(nil, &PurgeSessionsInput{ExpiredBefore: expiredBefore})
+		require.Error(t, err)
+		require.Nil(t, got)
+
+		got, err = srv.PurgeSessions(ctx, &PurgeSessionsInput{})
 		require.Error(t, err)
 		require.Nil(t, got)
 	})
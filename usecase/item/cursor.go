@@ -0,0 +1,139 @@
+package item
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/psi59/payhere-assignment/domain"
+)
+
+// cursorPayload 커서를 직렬화한 값이며, 커서 문자열의 길이를 줄이기 위해 짧은 키를 사용합니다.
+type cursorPayload struct {
+	Sort      string     `json:"s,omitempty"`
+	ID        int        `json:"i"`
+	Name      string     `json:"n,omitempty"`
+	Price     int        `json:"p,omitempty"`
+	Cost      int        `json:"c,omitempty"`
+	ExpiryAt  *time.Time `json:"e,omitempty"`
+	CreatedAt *time.Time `json:"t,omitempty"`
+	Score     int        `json:"r,omitempty"`
+	Backward  bool       `json:"b,omitempty"`
+	// Scope 커서를 생성한 목록의 cursorScope 값입니다.
+	Scope string `json:"q"`
+}
+
+// encodeCursor 커서를 "payload.signature" 형태의 문자열로 변환합니다.
+// signature는 payload의 HMAC-SHA256 값이므로 클라이언트가 커서를 변조하면 decodeCursor에서 거부됩니다.
+func (s *Service) encodeCursor(cursor *domain.ItemCursor, scope string) (string, error) {
+	payload := cursorPayload{
+		Scope:    scope,
+		Sort:     cursor.Sort,
+		ID:       cursor.ID,
+		Name:     cursor.Name,
+		Price:    cursor.Price,
+		Cost:     cursor.Cost,
+		Score:    cursor.Score,
+		Backward: cursor.Backward,
+	}
+	if !cursor.ExpiryAt.IsZero() {
+		payload.ExpiryAt = &cursor.ExpiryAt
+	}
+	if !cursor.CreatedAt.IsZero() {
+		payload.CreatedAt = &cursor.CreatedAt
+	}
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(b)
+
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(s.signCursor(encoded)), nil
+}
+
+// decodeCursor 커서 문자열을 커서와 커서를 생성한 목록의 scope로 변환합니다.
+func (s *Service) decodeCursor(v string) (*domain.ItemCursor, string, error) {
+	encoded, encodedSignature, ok := bytes.Cut([]byte(v), []byte("."))
+	if !ok {
+		return nil, "", errors.Wrap(domain.ErrInvalidItemCursor, "malformed cursor")
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(string(encodedSignature))
+	if err != nil {
+		return nil, "", errors.Wrap(domain.ErrInvalidItemCursor, err.Error())
+	}
+	if !hmac.Equal(signature, s.signCursor(string(encoded))) {
+		return nil, "", errors.Wrap(domain.ErrInvalidItemCursor, "signature mismatch")
+	}
+	b, err := base64.RawURLEncoding.DecodeString(string(encoded))
+	if err != nil {
+		return nil, "", errors.Wrap(domain.ErrInvalidItemCursor, err.Error())
+	}
+	var payload cursorPayload
+	if err := json.Unmarshal(b, &payload); err != nil {
+		return nil, "", errors.Wrap(domain.ErrInvalidItemCursor, err.Error())
+	}
+
+	cursor := &domain.ItemCursor{
+		Sort:     payload.Sort,
+		ID:       payload.ID,
+		Name:     payload.Name,
+		Price:    payload.Price,
+		Cost:     payload.Cost,
+		Score:    payload.Score,
+		Backward: payload.Backward,
+	}
+	if payload.ExpiryAt != nil {
+		cursor.ExpiryAt = *payload.ExpiryAt
+	}
+	if payload.CreatedAt != nil {
+		cursor.CreatedAt = *payload.CreatedAt
+	}
+
+	return cursor, payload.Scope, nil
+}
+
+// decodePageCursor 비어 있지 않은 커서를 변환하며, order와 다른 정렬 순서나 scope와 다른 목록에서 생성한 커서는 거부합니다.
+func (s *Service) decodePageCursor(v string, order domain.ItemSortOrder, scope string) (*domain.ItemCursor, error) {
+	if len(v) == 0 {
+		return nil, nil
+	}
+	cursor, cursorScope, err := s.decodeCursor(v)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if cursor.Sort != order.String() {
+		return nil, errors.Wrapf(domain.ErrInvalidItemCursor, "sort mismatch: %q != %q", cursor.Sort, order.String())
+	}
+	if cursorScope != scope {
+		return nil, errors.Wrap(domain.ErrInvalidItemCursor, "scope mismatch")
+	}
+
+	return cursor, nil
+}
+
+// cursorScope 커서를 생성한 목록의 해시입니다.
+// 아이템 목록과 휴지통 목록, 검색어와 필터가 다른 목록은 정렬 순서가 같아도 커서의 위치가 의미가 없으므로 커서에 함께 저장해 비교합니다.
+func cursorScope(trash bool, keyword string, filter domain.ItemFilter) (string, error) {
+	b, err := json.Marshal(struct {
+		Trash   bool              `json:"t"`
+		Keyword string            `json:"k"`
+		Filter  domain.ItemFilter `json:"f"`
+	}{Trash: trash, Keyword: keyword, Filter: filter})
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	sum := sha256.Sum256(b)
+
+	return base64.RawURLEncoding.EncodeToString(sum[:12]), nil
+}
+
+func (s *Service) signCursor(encoded string) []byte {
+	mac := hmac.New(sha256.New, s.cursorSecret)
+	mac.Write([]byte(encoded))
+
+	return mac.Sum(nil)
+}
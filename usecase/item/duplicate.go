@@ -0,0 +1,249 @@
+package item
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+
+	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/internal/hangul"
+	"github.com/psi59/payhere-assignment/internal/valid"
+	"github.com/psi59/payhere-assignment/repository"
+)
+
+// SimilarItemsError 유저의 DuplicateItemPolicy가 block이고 이름이 비슷한 아이템이 있어 아이템을 저장하지 않은 경우의 에러입니다.
+type SimilarItemsError struct {
+	Items []domain.SimilarItem
+}
+
+func (e *SimilarItemsError) Error() string {
+	return fmt.Sprintf("%s: %d similar items", domain.ErrSimilarItemExists, len(e.Items))
+}
+
+func (e *SimilarItemsError) Unwrap() error {
+	return domain.ErrSimilarItemExists
+}
+
+func (s *Service) FindDuplicates(c context.Context, input *FindDuplicatesInput) (*FindDuplicatesOutput, error) {
+	// 1. 파라메터 체크
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case valid.IsNil(input):
+		return nil, domain.ErrNilInput
+	}
+	if err := input.Validate(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// 2. 유저의 아이템 조회
+	items, err := s.findAll(c, repository.FindItemInput{UserID: input.User.ID})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	names := make([]itemName, len(items))
+	for i := range items {
+		if names[i], err = newItemName(items[i].Name); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+
+	// 3. 편집 거리가 가까울 수 있는 자모 수의 아이템끼리만 비교해 묶습니다.
+	order := make([]int, len(items))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortFunc(order, func(a, b int) int {
+		return cmp.Compare(len(names[a].jamo), len(names[b].jamo))
+	})
+	parents := make([]int, len(items))
+	for i := range parents {
+		parents[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if parents[i] != i {
+			parents[i] = find(parents[i])
+		}
+		return parents[i]
+	}
+	reasons := make(map[int]domain.ItemDuplicateReason)
+	for x, i := range order {
+		for _, j := range order[x+1:] {
+			if !withinDistance(len(names[j].jamo)-len(names[i].jamo), len(names[j].jamo), chosungDistanceRatio) {
+				break
+			}
+			reason, ok := names[i].similarTo(names[j])
+			if !ok {
+				continue
+			}
+			ri, rj := find(i), find(j)
+			if ri != rj {
+				parents[rj] = ri
+				if reasons[rj].Rank() > reasons[ri].Rank() {
+					reasons[ri] = reasons[rj]
+				}
+				delete(reasons, rj)
+			}
+			if reason.Rank() > reasons[ri].Rank() {
+				reasons[ri] = reason
+			}
+		}
+	}
+
+	// 4. 결과 반환, 같은 아이템일 가능성이 높은 묶음부터 정렬합니다.
+	members := make(map[int][]domain.Item)
+	for i := range items {
+		if root := find(i); len(reasons[root]) > 0 {
+			members[root] = append(members[root], items[i])
+		}
+	}
+	clusters := make([]domain.ItemDuplicateCluster, 0, len(members))
+	for root, clusterItems := range members {
+		slices.SortFunc(clusterItems, func(a, b domain.Item) int {
+			return cmp.Compare(a.ID, b.ID)
+		})
+		clusters = append(clusters, domain.ItemDuplicateCluster{Items: clusterItems, Reason: reasons[root]})
+	}
+	slices.SortFunc(clusters, func(a, b domain.ItemDuplicateCluster) int {
+		if a.Reason != b.Reason {
+			return b.Reason.Rank() - a.Reason.Rank()
+		}
+		return cmp.Compare(a.Items[0].ID, b.Items[0].ID)
+	})
+
+	return &FindDuplicatesOutput{Clusters: clusters}, nil
+}
+
+// checkSimilarItems 이름이 비슷한 유저의 아이템을 조회하며, excludeItemID는 수정하려는 아이템의 ID입니다.
+// 자모 수가 비슷할 수 있는 범위 안인 아이템만 저장소에서 조회해 비교합니다.
+// 유저의 DuplicateItemPolicy가 block이고 비슷한 아이템이 있으면 SimilarItemsError를 반환합니다.
+func (s *Service) checkSimilarItems(c context.Context, user *domain.User, name string, excludeItemID int) ([]domain.SimilarItem, error) {
+	target, err := newItemName(name)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	minLength, maxLength := similarNameLengthRange(len(target.jamo))
+	items, err := s.findAll(c, repository.FindItemInput{
+		UserID:        user.ID,
+		MinNameLength: &minLength,
+		MaxNameLength: &maxLength,
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	similar := make([]domain.SimilarItem, 0)
+	for _, item := range items {
+		if item.ID == excludeItemID {
+			continue
+		}
+		other, err := newItemName(item.Name)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if reason, ok := target.similarTo(other); ok {
+			similar = append(similar, domain.SimilarItem{Item: item, Reason: reason})
+		}
+	}
+	slices.SortStableFunc(similar, func(a, b domain.SimilarItem) int {
+		return b.Reason.Rank() - a.Reason.Rank()
+	})
+	if len(similar) > 0 && user.DuplicateItemPolicy == domain.DuplicateItemPolicyBlock {
+		return nil, errors.WithStack(&SimilarItemsError{Items: similar})
+	}
+
+	return similar, nil
+}
+
+const (
+	// similarDistanceRatio 자모 수 대비 편집 거리가 이 비율 이하이면 비슷한 이름으로 판단합니다.
+	similarDistanceRatio = 0.2
+	// chosungDistanceRatio 초성이 같은 경우 자모 수 대비 편집 거리가 이 비율 이하이면 비슷한 이름으로 판단합니다.
+	chosungDistanceRatio = 0.4
+	// minChosungLength 초성으로 비교할 이름의 최소 글자 수이며, 짧은 이름은 초성이 같은 경우가 많아 비교하지 않습니다.
+	minChosungLength = 3
+)
+
+// itemName 비교하기 위해 정규화한 아이템 이름입니다.
+type itemName struct {
+	// normalized NFC로 정규화하고 소문자로 변환한 뒤 공백을 제거한 이름입니다.
+	normalized string
+	jamo       []rune
+	chosung    string
+}
+
+func newItemName(name string) (itemName, error) {
+	normalized := hangul.NormalizeName(name)
+	jamo, err := hangul.Disassemble(normalized)
+	if err != nil {
+		return itemName{}, errors.WithStack(err)
+	}
+	chosung, err := hangul.GetChosung(normalized)
+	if err != nil {
+		return itemName{}, errors.WithStack(err)
+	}
+
+	return itemName{normalized: normalized, jamo: jamo.Runes, chosung: chosung}, nil
+}
+
+// similarTo 두 이름이 비슷한지와 그 이유를 반환합니다.
+func (n itemName) similarTo(other itemName) (domain.ItemDuplicateReason, bool) {
+	if n.normalized == other.normalized {
+		return domain.ItemDuplicateReasonExact, true
+	}
+
+	length := max(len(n.jamo), len(other.jamo))
+	distance := editDistance(n.jamo, other.jamo)
+	switch {
+	case n.chosung == other.chosung && n.chosung != n.normalized &&
+		utf8.RuneCountInString(n.chosung) >= minChosungLength && withinDistance(distance, length, chosungDistanceRatio):
+		return domain.ItemDuplicateReasonChosung, true
+	case withinDistance(distance, length, similarDistanceRatio):
+		return domain.ItemDuplicateReasonSimilar, true
+	}
+
+	return "", false
+}
+
+// similarNameLengthRange 자모 수가 length인 이름과 비슷할 수 있는 이름의 자모 수 범위를 반환합니다.
+// 편집 거리는 자모 수의 차이 이상이므로 가장 느슨한 chosungDistanceRatio로 범위를 구합니다.
+func similarNameLengthRange(length int) (int, int) {
+	maxLength := length
+	for withinDistance(maxLength+1-length, maxLength+1, chosungDistanceRatio) {
+		maxLength++
+	}
+
+	return length - int(float64(length)*chosungDistanceRatio), maxLength
+}
+
+// withinDistance 편집 거리 distance가 자모 수 length에 대해 ratio 비율 이하인지 확인합니다.
+func withinDistance(distance, length int, ratio float64) bool {
+	return distance <= int(float64(length)*ratio)
+}
+
+// editDistance 두 자모 목록의 레벤슈타인 거리를 반환합니다.
+func editDistance(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
@@ -0,0 +1,253 @@
+package item
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/internal/db"
+	"github.com/psi59/payhere-assignment/internal/mocks/repomocks"
+	"github.com/psi59/payhere-assignment/repository"
+	"github.com/psi59/payhere-assignment/repository/memory"
+)
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "", b: "", want: 0},
+		{a: "abc", b: "", want: 3},
+		{a: "kitten", b: "sitting", want: 3},
+		{a: "ㅇㅏㅁㅔ", b: "ㅇㅏㅁㅣ", want: 1},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, editDistance([]rune(tt.a), []rune(tt.b)), "%q, %q", tt.a, tt.b)
+		assert.Equal(t, tt.want, editDistance([]rune(tt.b), []rune(tt.a)), "%q, %q", tt.b, tt.a)
+	}
+}
+
+func TestSimilarNameLengthRange(t *testing.T) {
+	for _, length := range []int{0, 1, 5, 10, 16, 40} {
+		minLength, maxLength := similarNameLengthRange(length)
+		for other := 0; other <= length*3; other++ {
+			inRange := minLength <= other && other <= maxLength
+			distance := max(length, other) - min(length, other)
+			assert.Equal(t, withinDistance(distance, max(length, other), chosungDistanceRatio), inRange, "%d, %d", length, other)
+		}
+	}
+}
+
+func TestItemName_SimilarTo(t *testing.T) {
+	tests := []struct {
+		a, b       string
+		wantReason domain.ItemDuplicateReason
+		wantOK     bool
+	}{
+		{a: "아이스 아메리카노", b: "아이스아메리카노", wantReason: domain.ItemDuplicateReasonExact, wantOK: true},
+		{a: "Cafe Latte", b: "cafelatte", wantReason: domain.ItemDuplicateReasonExact, wantOK: true},
+		{a: norm.NFD.String("카페 라떼"), b: "카페 라떼", wantReason: domain.ItemDuplicateReasonExact, wantOK: true},
+		{a: "초코라떼", b: "치킨라떼", wantReason: domain.ItemDuplicateReasonChosung, wantOK: true},
+		{a: "americano", b: "americana", wantReason: domain.ItemDuplicateReasonSimilar, wantOK: true},
+		{a: "아메리카노", b: "카페 라떼", wantOK: false},
+		// 초성이 같아도 짧은 이름은 비교하지 않습니다.
+		{a: "라떼", b: "로또", wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			a, err := newItemName(tt.a)
+			require.NoError(t, err)
+			b, err := newItemName(tt.b)
+			require.NoError(t, err)
+
+			reason, ok := a.similarTo(b)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantReason, reason)
+		})
+	}
+}
+
+func TestService_FindDuplicates(t *testing.T) {
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemRepository := repomocks.NewMockItemRepository(ctrl)
+	itemHistoryRepository := repomocks.NewMockItemHistoryRepository(ctrl)
+	srv, err := NewService(testCursorSecret, itemRepository, itemHistoryRepository)
+	assert.NoError(t, err)
+
+	t.Run("OK", func(t *testing.T) {
+		items := make([]domain.Item, 0)
+		for i, name := range []string{"americano", "아이스 아메리카노", "녹차", "아이스아메리카노", "americana", "초코라떼", "치킨라떼"} {
+			item := newTestItem(t, userDomain.ID)
+			item.ID, item.Name = i+1, name
+			items = append(items, *item)
+		}
+		itemRepository.EXPECT().Find(ctx, &repository.FindItemInput{
+			UserID: userDomain.ID,
+			Limit:  searchBatchSize,
+		}).Return(&repository.FindItemOutput{Items: items}, nil)
+
+		got, err := srv.FindDuplicates(ctx, &FindDuplicatesInput{User: userDomain})
+		require.NoError(t, err)
+		require.Len(t, got.Clusters, 3)
+		assert.Equal(t, domain.ItemDuplicateReasonExact, got.Clusters[0].Reason)
+		assert.Equal(t, []domain.Item{items[1], items[3]}, got.Clusters[0].Items)
+		assert.Equal(t, domain.ItemDuplicateReasonChosung, got.Clusters[1].Reason)
+		assert.Equal(t, []domain.Item{items[5], items[6]}, got.Clusters[1].Items)
+		assert.Equal(t, domain.ItemDuplicateReasonSimilar, got.Clusters[2].Reason)
+		assert.Equal(t, []domain.Item{items[0], items[4]}, got.Clusters[2].Items)
+	})
+
+	t.Run("nil context", func(t *testing.T) {
+		got, err := srv.FindDuplicates(nil, &FindDuplicatesInput{User: userDomain})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("nil input", func(t *testing.T) {
+		got, err := srv.FindDuplicates(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("아이템 조회 에러", func(t *testing.T) {
+		itemRepository.EXPECT().Find(ctx, gomock.Any()).Return(nil, gofakeit.Error())
+		got, err := srv.FindDuplicates(ctx, &FindDuplicatesInput{User: userDomain})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+}
+
+func TestService_Create_SimilarItems(t *testing.T) {
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemRepository := repomocks.NewMockItemRepository(ctrl)
+	itemHistoryRepository := repomocks.NewMockItemHistoryRepository(ctrl)
+	srv, err := NewService(testCursorSecret, itemRepository, itemHistoryRepository)
+	assert.NoError(t, err)
+
+	existing := newTestItem(t, userDomain.ID)
+	existing.Name = "아이스 아메리카노"
+	newInput := func(user *domain.User) *CreateInput {
+		return &CreateInput{
+			User:        user,
+			Name:        "아이스아메리카노",
+			Description: gofakeit.SentenceSimple(),
+			Price:       gofakeit.Number(1, 10000),
+			Cost:        gofakeit.Number(1, 10000),
+			Category:    "coffee",
+			Barcode:     gofakeit.Numerify("################"),
+			Size:        domain.ItemSizeSmall,
+			ExpiryAt:    gofakeit.FutureDate(),
+		}
+	}
+
+	t.Run("warn", func(t *testing.T) {
+		// "아이스아메리카노"의 자모 수는 16입니다.
+		itemRepository.EXPECT().Find(gomock.Any(), &repository.FindItemInput{
+			UserID:        userDomain.ID,
+			MinNameLength: intPtr(10),
+			MaxNameLength: intPtr(26),
+			Limit:         searchBatchSize,
+		}).Return(&repository.FindItemOutput{Items: []domain.Item{*existing}}, nil)
+		itemRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, item *domain.Item) error {
+			item.ID = existing.ID + 1
+			return nil
+		})
+		itemHistoryRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+		got, err := srv.Create(ctx, newInput(userDomain))
+		require.NoError(t, err)
+		assert.Equal(t, []domain.SimilarItem{{Item: *existing, Reason: domain.ItemDuplicateReasonExact}}, got.SimilarItems)
+	})
+
+	t.Run("block", func(t *testing.T) {
+		user := *userDomain
+		user.DuplicateItemPolicy = domain.DuplicateItemPolicyBlock
+		itemRepository.EXPECT().Find(gomock.Any(), gomock.Any()).Return(&repository.FindItemOutput{Items: []domain.Item{*existing}}, nil)
+
+		got, err := srv.Create(ctx, newInput(&user))
+		assert.ErrorIs(t, err, domain.ErrSimilarItemExists)
+		assert.Nil(t, got)
+		var similarItemsErr *SimilarItemsError
+		require.ErrorAs(t, err, &similarItemsErr)
+		assert.Equal(t, []domain.SimilarItem{{Item: *existing, Reason: domain.ItemDuplicateReasonExact}}, similarItemsErr.Items)
+	})
+
+	t.Run("block 비슷한 아이템 없음", func(t *testing.T) {
+		user := *userDomain
+		user.DuplicateItemPolicy = domain.DuplicateItemPolicyBlock
+		itemRepository.EXPECT().Find(gomock.Any(), gomock.Any()).Return(&repository.FindItemOutput{}, nil)
+		itemRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, item *domain.Item) error {
+			item.ID = existing.ID + 1
+			return nil
+		})
+		itemHistoryRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+		got, err := srv.Create(ctx, newInput(&user))
+		require.NoError(t, err)
+		assert.Empty(t, got.SimilarItems)
+	})
+}
+
+func TestService_Update_SimilarItems(t *testing.T) {
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemRepository := repomocks.NewMockItemRepository(ctrl)
+	itemHistoryRepository := repomocks.NewMockItemHistoryRepository(ctrl)
+	srv, err := NewService(testCursorSecret, itemRepository, itemHistoryRepository)
+	assert.NoError(t, err)
+
+	item := newTestItem(t, userDomain.ID)
+	item.Name = "녹차"
+	existing := newTestItem(t, userDomain.ID)
+	existing.ID, existing.Name = item.ID+1, "아이스 아메리카노"
+	name := "아이스아메리카노"
+
+	t.Run("warn", func(t *testing.T) {
+		itemRepository.EXPECT().Get(gomock.Any(), userDomain.ID, item.ID).Return(item, nil)
+		itemRepository.EXPECT().Find(gomock.Any(), gomock.Any()).Return(&repository.FindItemOutput{Items: []domain.Item{*item, *existing}}, nil)
+		itemRepository.EXPECT().Update(gomock.Any(), userDomain.ID, item.ID, &repository.UpdateItemInput{Name: &name, Version: item.Version}).Return(nil)
+		itemHistoryRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+		got, err := srv.Update(ctx, &UpdateInput{User: userDomain, ItemID: item.ID, Name: &name})
+		require.NoError(t, err)
+		assert.Equal(t, []domain.SimilarItem{{Item: *existing, Reason: domain.ItemDuplicateReasonExact}}, got.SimilarItems)
+	})
+
+	t.Run("block", func(t *testing.T) {
+		user := *userDomain
+		user.DuplicateItemPolicy = domain.DuplicateItemPolicyBlock
+		itemRepository.EXPECT().Get(gomock.Any(), user.ID, item.ID).Return(item, nil)
+		itemRepository.EXPECT().Find(gomock.Any(), gomock.Any()).Return(&repository.FindItemOutput{Items: []domain.Item{*item, *existing}}, nil)
+
+		got, err := srv.Update(ctx, &UpdateInput{User: &user, ItemID: item.ID, Name: &name})
+		assert.ErrorIs(t, err, domain.ErrSimilarItemExists)
+		assert.Nil(t, got)
+	})
+
+	t.Run("이름을 바꾸지 않으면 확인하지 않음", func(t *testing.T) {
+		user := *userDomain
+		user.DuplicateItemPolicy = domain.DuplicateItemPolicyBlock
+		price := item.Price + 1
+		itemRepository.EXPECT().Get(gomock.Any(), user.ID, item.ID).Return(item, nil)
+		itemRepository.EXPECT().Update(gomock.Any(), user.ID, item.ID, &repository.UpdateItemInput{Price: &price, Version: item.Version}).Return(nil)
+		itemHistoryRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+		got, err := srv.Update(ctx, &UpdateInput{User: &user, ItemID: item.ID, Price: &price})
+		require.NoError(t, err)
+		assert.Empty(t, got.SimilarItems)
+	})
+}
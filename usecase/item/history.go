@@ -0,0 +1,85 @@
+package item
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/internal/valid"
+	"github.com/psi59/payhere-assignment/repository"
+)
+
+// 변경 이력의 필드명은 API 응답의 필드명과 동일합니다.
+const (
+	fieldName        = "name"
+	fieldDescription = "description"
+	fieldPrice       = "price"
+	fieldCost        = "cost"
+	fieldCategory    = "category"
+	fieldBarcode     = "barcode"
+	fieldSize        = "size"
+	fieldExpiryAt    = "expiryAt"
+)
+
+// createdFields 생성된 아이템의 모든 필드를 새 값으로 기록합니다.
+func createdFields(item *domain.Item) []domain.ItemFieldChange {
+	return []domain.ItemFieldChange{
+		{Field: fieldName, NewValue: formatString(item.Name)},
+		{Field: fieldDescription, NewValue: formatString(item.Description)},
+		{Field: fieldPrice, NewValue: formatInt(item.Price)},
+		{Field: fieldCost, NewValue: formatInt(item.Cost)},
+		{Field: fieldCategory, NewValue: formatString(item.Category)},
+		{Field: fieldBarcode, NewValue: formatString(item.Barcode)},
+		{Field: fieldSize, NewValue: formatString(string(item.Size))},
+		{Field: fieldExpiryAt, NewValue: formatTime(item.ExpiryAt)},
+	}
+}
+
+// changedFields 수정 요청 중 실제로 값이 바뀌는 필드만 기록합니다.
+func changedFields(item *domain.Item, input *repository.UpdateItemInput) []domain.ItemFieldChange {
+	changes := make([]domain.ItemFieldChange, 0)
+	add := func(field string, oldValue, newValue *string) {
+		if *oldValue != *newValue {
+			changes = append(changes, domain.ItemFieldChange{Field: field, OldValue: oldValue, NewValue: newValue})
+		}
+	}
+
+	if !valid.IsNil(input.Name) {
+		add(fieldName, formatString(item.Name), formatString(*input.Name))
+	}
+	if !valid.IsNil(input.Description) {
+		add(fieldDescription, formatString(item.Description), formatString(*input.Description))
+	}
+	if !valid.IsNil(input.Price) {
+		add(fieldPrice, formatInt(item.Price), formatInt(*input.Price))
+	}
+	if !valid.IsNil(input.Cost) {
+		add(fieldCost, formatInt(item.Cost), formatInt(*input.Cost))
+	}
+	if !valid.IsNil(input.Category) {
+		add(fieldCategory, formatString(item.Category), formatString(*input.Category))
+	}
+	if !valid.IsNil(input.Barcode) {
+		add(fieldBarcode, formatString(item.Barcode), formatString(*input.Barcode))
+	}
+	if !valid.IsNil(input.Size) {
+		add(fieldSize, formatString(string(item.Size)), formatString(string(*input.Size)))
+	}
+	if !valid.IsNil(input.ExpiryAt) {
+		add(fieldExpiryAt, formatTime(item.ExpiryAt), formatTime(*input.ExpiryAt))
+	}
+
+	return changes
+}
+
+func formatString(v string) *string {
+	return &v
+}
+
+func formatInt(v int) *string {
+	return formatString(strconv.Itoa(v))
+}
+
+func formatTime(v time.Time) *string {
+	return formatString(v.UTC().Format(time.RFC3339))
+}
@@ -3,6 +3,7 @@ package item
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -15,8 +16,14 @@ type Usecase interface {
 	Create(c context.Context, input *CreateInput) (*CreateOutput, error)
 	Get(c context.Context, input *GetInput) (*GetOutput, error)
 	Delete(c context.Context, input *DeleteInput) error
-	Update(c context.Context, input *UpdateInput) error
+	Restore(c context.Context, input *RestoreInput) error
+	Update(c context.Context, input *UpdateInput) (*UpdateOutput, error)
 	Find(c context.Context, input *FindInput) (*FindOutput, error)
+	FindTrash(c context.Context, input *FindTrashInput) (*FindOutput, error)
+	PurgeTrash(c context.Context, input *PurgeTrashInput) (*PurgeTrashOutput, error)
+	History(c context.Context, input *HistoryInput) (*HistoryOutput, error)
+	Suggest(c context.Context, input *SuggestInput) (*SuggestOutput, error)
+	FindDuplicates(c context.Context, input *FindDuplicatesInput) (*FindDuplicatesOutput, error)
 }
 
 const ErrNilUsecase domain.ConstantError = "nil ItemUsecase"
@@ -46,6 +53,8 @@ func (i *CreateInput) Validate() error {
 
 type CreateOutput struct {
 	Item *domain.Item
+	// SimilarItems 이름이 비슷한 기존 아이템이며, 유저의 DuplicateItemPolicy가 warn인 경우에만 설정됩니다.
+	SimilarItems []domain.SimilarItem
 }
 
 type GetInput struct {
@@ -68,6 +77,10 @@ type GetOutput struct {
 type DeleteInput struct {
 	User   *domain.User `validate:"required"`
 	ItemID int          `validate:"required"`
+	// Permanent true일 경우 휴지통으로 이동하지 않고 영구 삭제합니다.
+	Permanent bool
+	// Version 클라이언트가 알고 있는 아이템의 버전이며, 0인 경우 버전을 확인하지 않습니다.
+	Version int `validate:"gte=0"`
 }
 
 func (i *DeleteInput) Validate() error {
@@ -78,6 +91,19 @@ func (i *DeleteInput) Validate() error {
 	return nil
 }
 
+type RestoreInput struct {
+	User   *domain.User `validate:"required"`
+	ItemID int          `validate:"required"`
+}
+
+func (i *RestoreInput) Validate() error {
+	if err := valid.ValidateStruct(i); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
 type UpdateInput struct {
 	User        *domain.User     `validate:"required"`
 	ItemID      int              `validate:"required"`
@@ -89,6 +115,8 @@ type UpdateInput struct {
 	Barcode     *string          `validate:"omitempty,required"`
 	Size        *domain.ItemSize `validate:"omitempty,required,oneof=small large"`
 	ExpiryAt    *time.Time       `validate:"omitempty,required"`
+	// Version 클라이언트가 알고 있는 아이템의 버전이며, 0인 경우 버전을 확인하지 않습니다.
+	Version int `validate:"gte=0"`
 }
 
 func (i *UpdateInput) Validate() error {
@@ -109,23 +137,149 @@ func (i *UpdateInput) Validate() error {
 	return nil
 }
 
+type UpdateOutput struct {
+	// Version 수정된 아이템의 버전입니다.
+	Version int
+	// SimilarItems 이름을 수정한 경우 이름이 비슷한 다른 아이템이며, 유저의 DuplicateItemPolicy가 warn인 경우에만 설정됩니다.
+	SimilarItems []domain.SimilarItem
+}
+
 type FindInput struct {
-	User        *domain.User `validate:"required"`
-	Keyword     string
-	SearchAfter int
+	User    *domain.User `validate:"required"`
+	Keyword string
+	Filter  domain.ItemFilter
+	Sort    domain.ItemSortOrder
+	// Cursor 이전 조회 결과의 NextCursor 또는 PrevCursor이며, 비어 있으면 첫 페이지를 조회합니다.
+	Cursor string
+	// WithTotal true인 경우에만 TotalCount를 조회합니다.
+	WithTotal bool
+	// WithFacets true인 경우에만 Facets를 조회합니다.
+	WithFacets bool
+	// Limit 한 페이지의 아이템 수이며, 0이면 저장소의 기본값을 사용합니다.
+	Limit int `validate:"gte=0"`
 }
 
 func (i *FindInput) Validate() error {
 	if err := valid.ValidateStruct(i); err != nil {
 		return errors.WithStack(err)
 	}
+	if err := i.Filter.Validate(); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := i.Sort.Validate(); err != nil {
+		return errors.WithStack(err)
+	}
+	if i.Sort.Has(domain.ItemSortKeyRelevance) && !i.hasKeyword() {
+		return fmt.Errorf("relevance sort requires keyword")
+	}
 
 	return nil
 }
 
+func (i *FindInput) hasKeyword() bool {
+	return len(strings.TrimSpace(i.Keyword)) > 0
+}
+
 type FindOutput struct {
-	TotalCount  int
-	Items       []domain.Item
+	TotalCount int
+	// Facets 페이지와 관계없이 조회 조건과 키워드를 만족하는 아이템 전체의 집계이며, Find에서 WithFacets가 true인 경우에만 설정됩니다.
+	Facets     *domain.ItemFacets
+	Items      []domain.Item
+	HasNext    bool
+	HasPrev    bool
+	NextCursor string
+	PrevCursor string
+	// Highlights 아이템별로 이름에서 키워드와 일치한 부분이며, 키워드로 검색한 경우에만 Items와 같은 순서로 설정됩니다.
+	Highlights []domain.ItemHighlight
+}
+
+type FindTrashInput struct {
+	User *domain.User `validate:"required"`
+	// Cursor 이전 조회 결과의 NextCursor 또는 PrevCursor이며, 비어 있으면 첫 페이지를 조회합니다.
+	Cursor string
+	// WithTotal true인 경우에만 TotalCount를 조회합니다.
+	WithTotal bool
+	// Limit 한 페이지의 아이템 수이며, 0이면 저장소의 기본값을 사용합니다.
+	Limit int `validate:"gte=0"`
+}
+
+func (i *FindTrashInput) Validate() error {
+	if err := valid.ValidateStruct(i); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+type PurgeTrashInput struct {
+	// Retention 휴지통 보관 기간이며, 보관 기간이 지난 아이템은 영구 삭제됩니다.
+	Retention time.Duration `validate:"gt=0"`
+}
+
+func (i *PurgeTrashInput) Validate() error {
+	if err := valid.ValidateStruct(i); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+type PurgeTrashOutput struct {
+	PurgedCount int
+}
+
+type HistoryInput struct {
+	User        *domain.User `validate:"required"`
+	ItemID      int          `validate:"required"`
+	SearchAfter int
+}
+
+func (i *HistoryInput) Validate() error {
+	if err := valid.ValidateStruct(i); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+type HistoryOutput struct {
+	Histories   []domain.ItemHistory
 	HasNext     bool
 	SearchAfter int
 }
+
+type SuggestInput struct {
+	User *domain.User `validate:"required"`
+	// Query 입력 중인 검색어이며, 비어 있으면 빈 결과를 반환합니다.
+	Query string
+	// Limit 최대 결과 수이며, 0이면 DefaultSuggestLimit를 사용합니다.
+	Limit int `validate:"gte=0,lte=20"`
+}
+
+func (i *SuggestInput) Validate() error {
+	if err := valid.ValidateStruct(i); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+type SuggestOutput struct {
+	Suggestions []domain.ItemSuggestion
+}
+
+type FindDuplicatesInput struct {
+	User *domain.User `validate:"required"`
+}
+
+func (i *FindDuplicatesInput) Validate() error {
+	if err := valid.ValidateStruct(i); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+type FindDuplicatesOutput struct {
+	Clusters []domain.ItemDuplicateCluster
+}
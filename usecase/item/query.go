@@ -0,0 +1,573 @@
+package item
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+
+	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/internal/search"
+)
+
+// QueryError 검색어의 문법이 올바르지 않은 경우입니다.
+type QueryError struct {
+	// Position 에러가 발생한 검색어의 문자(rune) 위치입니다.
+	Position int
+	Reason   string
+}
+
+func (e *QueryError) Error() string {
+	return fmt.Sprintf("%s at position %d: %s", domain.ErrInvalidItemQuery, e.Position, e.Reason)
+}
+
+func (e *QueryError) Unwrap() error {
+	return domain.ErrInvalidItemQuery
+}
+
+// queryExpr 검색어를 파싱한 조건식입니다.
+type queryExpr interface {
+	eval(item *domain.Item, name *search.Document) queryResult
+}
+
+// queryResult 아이템이 조건식을 만족하는지와 만족한 경우 이름에서 일치한 검색어의 목록입니다.
+type queryResult struct {
+	ok      bool
+	score   int
+	matches []search.Match
+}
+
+// andExpr 공백으로 구분된 조건을 모두 만족해야 합니다.
+type andExpr []queryExpr
+
+func (e andExpr) eval(item *domain.Item, name *search.Document) queryResult {
+	result := queryResult{ok: true}
+	for _, expr := range e {
+		r := expr.eval(item, name)
+		if !r.ok {
+			return queryResult{}
+		}
+		result.score += r.score
+		result.matches = append(result.matches, r.matches...)
+	}
+
+	return result
+}
+
+// orExpr OR로 구분된 조건 중 하나를 만족해야 하며, 가장 가깝게 일치한 조건의 결과를 사용합니다.
+type orExpr []queryExpr
+
+func (e orExpr) eval(item *domain.Item, name *search.Document) queryResult {
+	var result queryResult
+	for _, expr := range e {
+		if r := expr.eval(item, name); r.ok && (!result.ok || r.score > result.score) {
+			result = r
+		}
+	}
+
+	return result
+}
+
+// notExpr '-'가 붙은 조건이며, 조건을 만족하지 않아야 합니다.
+type notExpr struct {
+	expr queryExpr
+}
+
+func (e notExpr) eval(item *domain.Item, name *search.Document) queryResult {
+	return queryResult{ok: !e.expr.eval(item, name).ok}
+}
+
+// termExpr 아이템 이름과 비교하는 검색어입니다.
+type termExpr struct {
+	query *search.Query
+	// phrase 따옴표로 감싼 구문이며, 입력 중인 글자나 초성, 영문 자판으로 입력한 검색어로 보지 않고 구문 그대로 포함된 경우만 일치합니다.
+	phrase bool
+}
+
+func (e termExpr) eval(_ *domain.Item, name *search.Document) queryResult {
+	m := e.query.Match(name)
+	if m.Kind == search.MatchNone || (e.phrase && (m.Kind < search.MatchContains || m.Layout)) {
+		return queryResult{}
+	}
+
+	return queryResult{ok: true, score: m.Score, matches: []search.Match{m}}
+}
+
+// fieldExpr "category:커피"처럼 필드를 지정한 조건입니다.
+type fieldExpr struct {
+	field string
+	// value category, barcode, size 필드의 값입니다.
+	value string
+	// min, max price, cost 필드의 범위이며, 경계를 포함합니다.
+	min *int
+	max *int
+}
+
+const (
+	queryFieldCategory = "category"
+	queryFieldBarcode  = "barcode"
+	queryFieldSize     = "size"
+	queryFieldPrice    = "price"
+	queryFieldCost     = "cost"
+)
+
+func (e fieldExpr) eval(item *domain.Item, _ *search.Document) queryResult {
+	switch e.field {
+	case queryFieldCategory:
+		return queryResult{ok: item.Category == e.value}
+	case queryFieldBarcode:
+		return queryResult{ok: item.Barcode == e.value}
+	case queryFieldSize:
+		return queryResult{ok: string(item.Size) == e.value}
+	case queryFieldPrice:
+		return queryResult{ok: e.contains(item.Price)}
+	case queryFieldCost:
+		return queryResult{ok: e.contains(item.Cost)}
+	}
+
+	return queryResult{}
+}
+
+func (e fieldExpr) contains(v int) bool {
+	return (e.min == nil || *e.min <= v) && (e.max == nil || v <= *e.max)
+}
+
+// itemQuery 검색어를 파싱한 결과입니다.
+//
+//	query   = and
+//	and     = or { or }
+//	or      = unary { "OR" unary }
+//	unary   = [ "-" ] primary
+//	primary = "(" and ")" | phrase | field ":" ( value | phrase ) | word
+type itemQuery struct {
+	root queryExpr
+}
+
+// parseItemQuery 검색어를 파싱하며, 문법이 올바르지 않으면 *QueryError를 반환합니다.
+func parseItemQuery(s string) (*itemQuery, error) {
+	p := &queryParser{runes: []rune(s)}
+	root, err := p.parseAnd(0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &itemQuery{root: root}, nil
+}
+
+// match 아이템이 검색어를 만족하는지 확인하며, 만족하는 경우 일치 점수와 이름에서 일치한 부분을 반환합니다.
+func (q *itemQuery) match(item *domain.Item) (bool, int, domain.ItemHighlight, error) {
+	name, err := search.NewDocument(item.Name)
+	if err != nil {
+		return false, 0, domain.ItemHighlight{}, errors.WithStack(err)
+	}
+	result := q.root.eval(item, name)
+	if !result.ok {
+		return false, 0, domain.ItemHighlight{}, nil
+	}
+
+	var spans []search.Span
+	highlight := domain.ItemHighlight{}
+	for _, m := range result.matches {
+		highlight.Chosung = highlight.Chosung || m.Kind == search.MatchChosung
+		spans = append(spans, m.Spans...)
+	}
+	slices.SortFunc(spans, func(a, b search.Span) int {
+		return a.Start - b.Start
+	})
+	for _, span := range spans {
+		if n := len(highlight.Spans); n > 0 && highlight.Spans[n-1].End >= span.Start {
+			highlight.Spans[n-1].End = max(highlight.Spans[n-1].End, span.End)
+			continue
+		}
+		highlight.Spans = append(highlight.Spans, domain.TextSpan{Start: span.Start, End: span.End})
+	}
+
+	return true, result.score, highlight, nil
+}
+
+// filter 검색어의 필드 조건 중 저장소에서 처리할 수 있는 조건을 base에 추가합니다.
+// 검색어는 저장소에서 조회한 아이템에 다시 적용하므로, 모든 아이템이 만족해야 하는 조건 중 base에 없는 조건만 추가합니다.
+func (q *itemQuery) filter(base domain.ItemFilter) domain.ItemFilter {
+	conjuncts, ok := q.root.(andExpr)
+	if !ok {
+		conjuncts = andExpr{q.root}
+	}
+
+	filter := base
+	for _, expr := range conjuncts {
+		switch e := expr.(type) {
+		case fieldExpr:
+			switch e.field {
+			case queryFieldCategory:
+				if len(filter.Categories) == 0 {
+					filter.Categories = []string{e.value}
+				}
+			case queryFieldBarcode:
+				if len(filter.Barcode) == 0 {
+					filter.Barcode = e.value
+				}
+			case queryFieldSize:
+				if len(filter.Size) == 0 {
+					filter.Size = domain.ItemSize(e.value)
+				}
+			case queryFieldPrice:
+				filter.MinPrice = firstNonNil(filter.MinPrice, e.min)
+				filter.MaxPrice = firstNonNil(filter.MaxPrice, e.max)
+			case queryFieldCost:
+				filter.MinCost = firstNonNil(filter.MinCost, e.min)
+				filter.MaxCost = firstNonNil(filter.MaxCost, e.max)
+			}
+		case orExpr:
+			// "category:커피 OR category:차"처럼 모든 조건이 카테고리인 경우
+			categories := make([]string, 0, len(e))
+			for _, expr := range e {
+				if f, ok := expr.(fieldExpr); ok && f.field == queryFieldCategory {
+					categories = append(categories, f.value)
+				}
+			}
+			if len(categories) == len(e) && len(filter.Categories) == 0 {
+				filter.Categories = categories
+			}
+		}
+	}
+	// 조건이 서로 맞지 않으면 저장소에서 처리하지 않고, 검색어를 적용할 때 모두 제외됩니다.
+	if err := filter.Validate(); err != nil {
+		return base
+	}
+
+	return filter
+}
+
+// keywords 저장소에서 검색 대상을 미리 고르기 위한 키워드 목록이며, 검색어를 만족하는 아이템의 이름 또는 이름의 초성에는 키워드 중 하나 이상이 포함됩니다.
+// 모든 아이템이 만족해야 하는 검색어 중 가장 긴 키워드를 사용하며, 그런 검색어가 없으면 nil을 반환합니다.
+func (q *itemQuery) keywords() []string {
+	return exprKeywords(q.root)
+}
+
+func exprKeywords(expr queryExpr) []string {
+	switch e := expr.(type) {
+	case termExpr:
+		// 구문은 자모가 순서대로 포함된 경우와 영문 자판으로 입력한 경우를 제외하므로 초성을 모두 사용합니다.
+		if e.phrase {
+			if initials := e.query.Initials(); len(initials) > 0 {
+				return []string{initials}
+			}
+			return nil
+		}
+		return e.query.Keywords()
+	case andExpr:
+		var best []string
+		for _, expr := range e {
+			if keywords := exprKeywords(expr); len(keywords) > 0 && (best == nil || shortestKeyword(keywords) > shortestKeyword(best)) {
+				best = keywords
+			}
+		}
+		return best
+	case orExpr:
+		var keywords []string
+		for _, expr := range e {
+			k := exprKeywords(expr)
+			if len(k) == 0 {
+				return nil
+			}
+			for _, k := range k {
+				if !slices.Contains(keywords, k) {
+					keywords = append(keywords, k)
+				}
+			}
+		}
+		return keywords
+	}
+
+	return nil
+}
+
+// shortestKeyword 가장 짧은 키워드의 문자 수이며, 길수록 저장소에서 고르는 아이템이 적습니다.
+func shortestKeyword(keywords []string) int {
+	n := utf8.RuneCountInString(keywords[0])
+	for _, k := range keywords[1:] {
+		n = min(n, utf8.RuneCountInString(k))
+	}
+
+	return n
+}
+
+func firstNonNil(a, b *int) *int {
+	if a != nil {
+		return a
+	}
+	return b
+}
+
+type queryParser struct {
+	runes []rune
+	pos   int
+}
+
+func (p *queryParser) errorf(position int, format string, args ...any) error {
+	return errors.WithStack(&QueryError{Position: position, Reason: fmt.Sprintf(format, args...)})
+}
+
+func (p *queryParser) eof() bool {
+	return p.pos >= len(p.runes)
+}
+
+func (p *queryParser) skipSpace() {
+	for !p.eof() && unicode.IsSpace(p.runes[p.pos]) {
+		p.pos++
+	}
+}
+
+// parseAnd depth는 괄호의 깊이이며, 0이면 검색어의 끝까지 파싱합니다.
+func (p *queryParser) parseAnd(depth int) (queryExpr, error) {
+	var exprs andExpr
+	for {
+		p.skipSpace()
+		if p.eof() || (depth > 0 && p.runes[p.pos] == ')') {
+			break
+		}
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, expr)
+	}
+	switch len(exprs) {
+	case 0:
+		if depth > 0 {
+			return nil, p.errorf(p.pos, "empty group")
+		}
+		return nil, p.errorf(p.pos, "empty query")
+	case 1:
+		return exprs[0], nil
+	}
+
+	return exprs, nil
+}
+
+func (p *queryParser) parseOr() (queryExpr, error) {
+	expr, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	exprs := orExpr{expr}
+	for {
+		start := p.pos
+		p.skipSpace()
+		if !p.consumeOr() {
+			p.pos = start
+			break
+		}
+		p.skipSpace()
+		if p.eof() || p.runes[p.pos] == ')' {
+			return nil, p.errorf(p.pos, "expected term after OR")
+		}
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, expr)
+	}
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
+
+	return exprs, nil
+}
+
+// consumeOr 현재 위치가 OR 연산자이면 건너뜁니다.
+func (p *queryParser) consumeOr() bool {
+	end := p.pos + len("OR")
+	if end > len(p.runes) || string(p.runes[p.pos:end]) != "OR" {
+		return false
+	}
+	if end < len(p.runes) && !isQueryDelimiter(p.runes[end]) {
+		return false
+	}
+	p.pos = end
+
+	return true
+}
+
+func (p *queryParser) parseUnary() (queryExpr, error) {
+	if p.runes[p.pos] != '-' {
+		return p.parsePrimary()
+	}
+
+	start := p.pos
+	p.pos++
+	if p.eof() || unicode.IsSpace(p.runes[p.pos]) || p.runes[p.pos] == ')' {
+		return nil, p.errorf(start, "expected term after '-'")
+	}
+	expr, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	return notExpr{expr: expr}, nil
+}
+
+func (p *queryParser) parsePrimary() (queryExpr, error) {
+	start := p.pos
+	switch p.runes[p.pos] {
+	case '(':
+		p.pos++
+		expr, err := p.parseAnd(1)
+		if err != nil {
+			return nil, err
+		}
+		if p.eof() {
+			return nil, p.errorf(start, "unclosed '('")
+		}
+		p.pos++
+		return expr, nil
+	case ')':
+		return nil, p.errorf(start, "unexpected ')'")
+	case '"':
+		phrase, err := p.parsePhrase()
+		if err != nil {
+			return nil, err
+		}
+		return newTermExpr(phrase, true)
+	}
+
+	for !p.eof() && !isQueryDelimiter(p.runes[p.pos]) && p.runes[p.pos] != ':' {
+		p.pos++
+	}
+	word := string(p.runes[start:p.pos])
+	if word == "OR" {
+		return nil, p.errorf(start, "unexpected OR")
+	}
+	if p.eof() || p.runes[p.pos] != ':' {
+		return newTermExpr(word, false)
+	}
+
+	// 필드 조건
+	p.pos++
+	valueStart := p.pos
+	var value string
+	if !p.eof() && p.runes[p.pos] == '"' {
+		phrase, err := p.parsePhrase()
+		if err != nil {
+			return nil, err
+		}
+		value = phrase
+	} else {
+		for !p.eof() && !isQueryDelimiter(p.runes[p.pos]) {
+			p.pos++
+		}
+		value = string(p.runes[valueStart:p.pos])
+	}
+	if len(value) == 0 {
+		return nil, p.errorf(valueStart, "empty value for %q", word)
+	}
+
+	return p.newFieldExpr(start, strings.ToLower(word), value, valueStart)
+}
+
+// parsePhrase 따옴표로 감싼 구문을 파싱합니다.
+func (p *queryParser) parsePhrase() (string, error) {
+	start := p.pos
+	p.pos++
+	end := slices.Index(p.runes[p.pos:], '"')
+	if end < 0 {
+		return "", p.errorf(start, "unclosed quote")
+	}
+	phrase := string(p.runes[p.pos : p.pos+end])
+	p.pos += end + 1
+	if len(strings.TrimSpace(phrase)) == 0 {
+		return "", p.errorf(start, "empty phrase")
+	}
+
+	return phrase, nil
+}
+
+func (p *queryParser) newFieldExpr(start int, field, value string, valueStart int) (queryExpr, error) {
+	expr := fieldExpr{field: field, value: value}
+	switch field {
+	case queryFieldCategory, queryFieldBarcode:
+	case queryFieldSize:
+		if err := domain.ItemSize(value).Validate(); err != nil {
+			return nil, p.errorf(valueStart, "invalid size %q", value)
+		}
+	case queryFieldPrice, queryFieldCost:
+		minValue, maxValue, err := parseQueryRange(value)
+		if err != nil {
+			return nil, p.errorf(valueStart, "invalid %s range %q: %v", field, value, err)
+		}
+		expr.value, expr.min, expr.max = "", minValue, maxValue
+	default:
+		return nil, p.errorf(start, "unknown field %q", field)
+	}
+
+	return expr, nil
+}
+
+// parseQueryRange "<5000", "<=5000", ">5000", ">=5000", "5000", "1000..5000" 형태의 범위를 경계를 포함하는 최솟값과 최댓값으로 변환합니다.
+func parseQueryRange(s string) (*int, *int, error) {
+	parse := func(s string) (*int, error) {
+		v, err := strconv.Atoi(s)
+		if err != nil || v < 0 {
+			return nil, fmt.Errorf("%q is not a non-negative integer", s)
+		}
+		return &v, nil
+	}
+	offset := func(v *int, delta int) *int {
+		n := *v + delta
+		return &n
+	}
+
+	var (
+		minValue, maxValue *int
+		err                error
+	)
+	switch {
+	case strings.HasPrefix(s, "<="):
+		maxValue, err = parse(s[2:])
+	case strings.HasPrefix(s, ">="):
+		minValue, err = parse(s[2:])
+	case strings.HasPrefix(s, "<"):
+		if maxValue, err = parse(s[1:]); err == nil {
+			maxValue = offset(maxValue, -1)
+		}
+	case strings.HasPrefix(s, ">"):
+		if minValue, err = parse(s[1:]); err == nil {
+			minValue = offset(minValue, 1)
+		}
+	case strings.Contains(s, ".."):
+		lower, upper, _ := strings.Cut(s, "..")
+		if minValue, err = parse(lower); err == nil {
+			maxValue, err = parse(upper)
+		}
+	default:
+		if minValue, err = parse(strings.TrimPrefix(s, "=")); err == nil {
+			maxValue = minValue
+		}
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if maxValue != nil && (*maxValue < 0 || (minValue != nil && *minValue > *maxValue)) {
+		return nil, nil, fmt.Errorf("empty range")
+	}
+
+	return minValue, maxValue, nil
+}
+
+func newTermExpr(s string, phrase bool) (queryExpr, error) {
+	query, err := search.NewQuery(s)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return termExpr{query: query, phrase: phrase}, nil
+}
+
+func isQueryDelimiter(r rune) bool {
+	return unicode.IsSpace(r) || r == '(' || r == ')' || r == '"'
+}
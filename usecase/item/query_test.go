@@ -0,0 +1,190 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/psi59/payhere-assignment/domain"
+)
+
+func TestParseItemQuery_Error(t *testing.T) {
+	tests := []struct {
+		query        string
+		wantPosition int
+	}{
+		{query: `"아이스 라떼`, wantPosition: 0},
+		{query: `라떼 (모카 OR 초코`, wantPosition: 3},
+		{query: `라떼 )`, wantPosition: 3},
+		{query: `라떼 ()`, wantPosition: 4},
+		{query: `OR 라떼`, wantPosition: 0},
+		{query: `라떼 OR`, wantPosition: 5},
+		{query: `라떼 - 모카`, wantPosition: 3},
+		{query: `라떼 ""`, wantPosition: 3},
+		{query: `price:<abc`, wantPosition: 6},
+		{query: `price:5000..1000`, wantPosition: 6},
+		{query: `cost:<0`, wantPosition: 5},
+		{query: `size:medium`, wantPosition: 5},
+		{query: `category:`, wantPosition: 9},
+		{query: `라떼 color:red`, wantPosition: 3},
+		{query: ` `, wantPosition: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			got, err := parseItemQuery(tt.query)
+			assert.Nil(t, got)
+			require.ErrorIs(t, err, domain.ErrInvalidItemQuery)
+			var queryErr *QueryError
+			require.ErrorAs(t, err, &queryErr)
+			assert.Equal(t, tt.wantPosition, queryErr.Position, queryErr.Reason)
+		})
+	}
+}
+
+func TestItemQuery_match(t *testing.T) {
+	item := &domain.Item{
+		Name:     "아이스 카페 라떼",
+		Category: "커피",
+		Barcode:  "8801234567890",
+		Size:     domain.ItemSizeLarge,
+		Price:    4500,
+		Cost:     1500,
+	}
+	tests := []struct {
+		query string
+		want  bool
+	}{
+		{query: "라떼", want: true},
+		{query: "아이스 라떼", want: true},
+		{query: "아이스 모카", want: false},
+		{query: `"카페 라떼"`, want: true},
+		{query: `"아이스 라떼"`, want: false},
+		{query: `"ㅋㅍ"`, want: false},
+		{query: "라떼 -아이스", want: false},
+		{query: "라떼 -모카", want: true},
+		{query: "모카 OR 라떼", want: true},
+		{query: "(모카 OR 초코) 라떼", want: false},
+		{query: "-(모카 OR 초코)", want: true},
+		{query: "category:커피", want: true},
+		{query: "category:차 OR category:커피", want: true},
+		{query: `category:"커피"`, want: true},
+		{query: "-category:커피", want: false},
+		{query: "barcode:8801234567890", want: true},
+		{query: "barcode:880", want: false},
+		{query: "size:large", want: true},
+		{query: "price:<5000", want: true},
+		{query: "price:<4500", want: false},
+		{query: "price:<=4500", want: true},
+		{query: "price:>4500", want: false},
+		{query: "price:>=4500", want: true},
+		{query: "price:4500", want: true},
+		{query: "price:1000..4000", want: false},
+		{query: "cost:1000..2000 라떼", want: true},
+		{query: "Price:<5000", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			query, err := parseItemQuery(tt.query)
+			require.NoError(t, err)
+			got, _, _, err := query.match(item)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("highlight", func(t *testing.T) {
+		query, err := parseItemQuery("라떼 (아이스 OR 핫) category:커피")
+		require.NoError(t, err)
+		got, _, highlight, err := query.match(item)
+		require.NoError(t, err)
+		require.True(t, got)
+		assert.Equal(t, domain.ItemHighlight{Spans: []domain.TextSpan{{Start: 0, End: 3}, {Start: 7, End: 9}}}, highlight)
+	})
+}
+
+func TestItemQuery_filter(t *testing.T) {
+	minPrice := 1000
+	tests := []struct {
+		name  string
+		query string
+		base  domain.ItemFilter
+		want  domain.ItemFilter
+	}{
+		{
+			name:  "필드 조건",
+			query: "라떼 category:커피 barcode:880 size:small price:<5000 cost:>=100",
+			want: domain.ItemFilter{
+				Categories: []string{"커피"},
+				Barcode:    "880",
+				Size:       domain.ItemSizeSmall,
+				MaxPrice:   intPtr(4999),
+				MinCost:    intPtr(100),
+			},
+		},
+		{
+			name:  "카테고리 OR 조건",
+			query: "category:커피 OR category:차",
+			want:  domain.ItemFilter{Categories: []string{"커피", "차"}},
+		},
+		{
+			name:  "다른 조건과 OR로 묶인 조건",
+			query: "category:커피 OR 라떼",
+			want:  domain.ItemFilter{},
+		},
+		{
+			name:  "제외 조건",
+			query: "-category:커피 -(price:<5000)",
+			want:  domain.ItemFilter{},
+		},
+		{
+			name:  "조회 조건에 이미 있는 조건",
+			query: "price:500..3000",
+			base:  domain.ItemFilter{MinPrice: &minPrice},
+			want:  domain.ItemFilter{MinPrice: &minPrice, MaxPrice: intPtr(3000)},
+		},
+		{
+			name:  "서로 맞지 않는 조건",
+			query: "price:>5000",
+			base:  domain.ItemFilter{MaxPrice: &minPrice},
+			want:  domain.ItemFilter{MaxPrice: &minPrice},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := parseItemQuery(tt.query)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, query.filter(tt.base))
+		})
+	}
+}
+
+func TestItemQuery_keywords(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{name: "검색어의 첫 자모", query: "아멬", want: []string{"ㅇ"}},
+		{name: "영문 자판", query: "dkapf", want: []string{"d", "ㅇ"}},
+		{name: "구문은 글자의 초성", query: `"아메리카노"`, want: []string{"ㅇㅁㄹㅋㄴ"}},
+		{name: "구문은 영문 자판으로 변환하지 않음", query: `"dkapf"`, want: []string{"dkapf"}},
+		{name: "가장 긴 키워드", query: `라떼 "아이스" category:커피`, want: []string{"ㅇㅇㅅ"}},
+		{name: "OR 조건", query: "라떼 OR 모카 OR 라떼", want: []string{"ㄹ", "ㅁ"}},
+		{name: "괄호", query: `("아이스" 라떼) OR 모카`, want: []string{"ㅇㅇㅅ", "ㅁ"}},
+		{name: "제외 조건", query: "-라떼", want: nil},
+		{name: "다른 조건과 OR로 묶인 조건", query: "라떼 OR category:커피", want: nil},
+		{name: "필드 조건", query: "category:커피", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := parseItemQuery(tt.query)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, query.keywords())
+		})
+	}
+}
+
+func intPtr(v int) *int {
+	return &v
+}
@@ -0,0 +1,137 @@
+package item
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/pkg/errors"
+
+	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/repository"
+)
+
+const (
+	// searchBatchSize 검색 대상 아이템을 저장소에서 나눠 조회할 때 한 번에 조회하는 아이템 수입니다.
+	searchBatchSize = 500
+	// defaultSearchLimit Limit을 지정하지 않은 경우 검색 결과 한 페이지의 아이템 수입니다.
+	defaultSearchLimit = 10
+)
+
+type searchHit struct {
+	item      domain.Item
+	score     int
+	highlight domain.ItemHighlight
+}
+
+// search 조회 조건을 만족하는 아이템 중 키워드를 만족하는 아이템을 조회하며, 반환하는 hits는 아이템과 같은 순서입니다.
+// 키워드는 검색어 문법(parseItemQuery)으로 파싱하며, 필드 조건 중 일부와 검색어 글자의 초성(itemQuery.keywords)을 저장소의 조회 조건으로 전달해
+// 검색 대상을 모두 고른 뒤, 저장소에 관계없이 같은 방식으로 검색하도록 아이템 이름을 자모 단위로 비교합니다.
+// 따라서 입력 중인 글자("아멜")나 영문 자판으로 입력한 키워드("dkapflzksh")도 검색할 수 있습니다.
+// 정렬 순서를 지정하지 않으면 키워드와 가깝게 일치한 순서(relevance)로 정렬합니다.
+func (s *Service) search(c context.Context, input *FindInput, cursor *domain.ItemCursor) (*repository.FindItemOutput, []searchHit, error) {
+	query, err := parseItemQuery(input.Keyword)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	candidates, err := s.findAll(c, repository.FindItemInput{
+		UserID:   input.User.ID,
+		Keywords: query.keywords(),
+		Filter:   query.filter(input.Filter),
+	})
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	hits := make([]searchHit, 0)
+	for _, item := range candidates {
+		ok, score, highlight, err := query.match(&item)
+		if err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+		if ok {
+			hits = append(hits, searchHit{item: item, score: score, highlight: highlight})
+		}
+	}
+	order := input.Sort
+	if len(order) == 0 {
+		order = domain.ItemSortOrder{{Key: domain.ItemSortKeyRelevance}}
+	}
+	compare := func(a, b searchHit) int {
+		for _, sort := range order {
+			r := sort.Compare(&a.item, &b.item)
+			if sort.Key == domain.ItemSortKeyRelevance {
+				r = cmp.Compare(b.score, a.score)
+				if sort.Desc {
+					r = -r
+				}
+			}
+			if r != 0 {
+				return r
+			}
+		}
+		return cmp.Compare(a.item.ID, b.item.ID)
+	}
+	slices.SortFunc(hits, compare)
+
+	limit := input.Limit
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+
+	// [start, end) 범위가 조회할 페이지입니다.
+	start, end := 0, min(len(hits), limit)
+	if cursor != nil {
+		position, found := slices.BinarySearchFunc(hits, searchHit{item: *cursor.Item(), score: cursor.Score}, compare)
+		if cursor.Backward {
+			end = position
+			start = max(0, end-limit)
+		} else {
+			start = position
+			if found {
+				start++
+			}
+			end = min(len(hits), start+limit)
+		}
+	}
+
+	output := &repository.FindItemOutput{
+		Items:   make([]domain.Item, 0, end-start),
+		HasNext: end > start && end < len(hits),
+		HasPrev: end > start && start > 0,
+	}
+	for _, hit := range hits[start:end] {
+		output.Items = append(output.Items, hit.item)
+	}
+	if input.WithTotal {
+		output.TotalCount = len(hits)
+	}
+	if input.WithFacets {
+		counter := domain.NewItemFacetCounter()
+		for i := range hits {
+			counter.Add(&hits[i].item)
+		}
+		output.Facets = counter.Facets()
+	}
+
+	return output, hits[start:end], nil
+}
+
+// findAll 조회 조건을 만족하는 유저의 아이템을 모두 조회하며, input의 Cursor와 Limit은 무시합니다.
+func (s *Service) findAll(c context.Context, input repository.FindItemInput) ([]domain.Item, error) {
+	items := make([]domain.Item, 0)
+	input.Cursor = nil
+	input.Limit = searchBatchSize
+	for {
+		param := input
+		output, err := s.itemRepository.Find(c, &param)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		items = append(items, output.Items...)
+		if !output.HasNext || len(output.Items) == 0 {
+			return items, nil
+		}
+		input.Cursor = domain.NewItemCursor(nil, &output.Items[len(output.Items)-1], false)
+	}
+}
@@ -2,25 +2,43 @@ package item
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 
 	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/internal/db"
 	"github.com/psi59/payhere-assignment/internal/valid"
 
 	"github.com/psi59/payhere-assignment/repository"
 )
 
 type Service struct {
-	itemRepository repository.ItemRepository
+	// cursorSecret 아이템 목록 커서의 서명 키입니다.
+	cursorSecret          []byte
+	itemRepository        repository.ItemRepository
+	itemHistoryRepository repository.ItemHistoryRepository
+	// suggestIndexes 유저별 자동 완성 색인이며, 아이템을 생성, 수정, 삭제, 복원하면 무효화합니다.
+	suggestIndexes *suggestIndexCache
 }
 
-func NewService(itemRepository repository.ItemRepository) (*Service, error) {
-	if valid.IsNil(itemRepository) {
+func NewService(cursorSecret string, itemRepository repository.ItemRepository, itemHistoryRepository repository.ItemHistoryRepository) (*Service, error) {
+	switch {
+	case len(cursorSecret) == 0:
+		return nil, fmt.Errorf("empty cursorSecret")
+	case valid.IsNil(itemRepository):
 		return nil, repository.ErrNilItemRepository
+	case valid.IsNil(itemHistoryRepository):
+		return nil, repository.ErrNilItemHistoryRepository
 	}
 
-	return &Service{itemRepository: itemRepository}, nil
+	return &Service{
+		cursorSecret:          []byte(cursorSecret),
+		itemRepository:        itemRepository,
+		itemHistoryRepository: itemHistoryRepository,
+		suggestIndexes:        newSuggestIndexCache(),
+	}, nil
 }
 
 func (s *Service) Create(c context.Context, input *CreateInput) (*CreateOutput, error) {
@@ -52,14 +70,26 @@ func (s *Service) Create(c context.Context, input *CreateInput) (*CreateOutput,
 		return nil, errors.WithStack(err)
 	}
 
-	// 3. 아이템 생성
-	if err := s.itemRepository.Create(c, item); err != nil {
+	// 3. 이름이 비슷한 아이템을 확인하고 아이템과 생성 이력을 함께 저장
+	var similarItems []domain.SimilarItem
+	if err := db.Transaction(c, func(c context.Context) error {
+		if similarItems, err = s.checkSimilarItems(c, user, item.Name, 0); err != nil {
+			return errors.WithStack(err)
+		}
+		if err := s.itemRepository.Create(c, item); err != nil {
+			return errors.WithStack(err)
+		}
+
+		return s.createHistory(c, item.UserID, item.ID, user.ID, domain.ItemHistoryActionCreate, createdFields(item))
+	}); err != nil {
 		return nil, errors.WithStack(err)
 	}
+	s.suggestIndexes.invalidate(user.ID)
 
 	// 4. 결과 반환
 	return &CreateOutput{
-		Item: item,
+		Item:         item,
+		SimilarItems: similarItems,
 	}, nil
 }
 
@@ -101,22 +131,38 @@ func (s *Service) Delete(c context.Context, input *DeleteInput) error {
 
 	user := input.User
 
-	// 2. 아이템 조회
-	item, err := s.itemRepository.Get(c, user.ID, input.ItemID)
-	if err != nil {
-		return errors.WithStack(err)
-	}
+	if err := db.Transaction(c, func(c context.Context) error {
+		// 2. 영구 삭제, 휴지통의 아이템도 삭제할 수 있으므로 조회하지 않습니다.
+		if input.Permanent {
+			if err := s.itemRepository.Purge(c, user.ID, input.ItemID, input.Version); err != nil {
+				return errors.WithStack(err)
+			}
 
-	// 3. 아이템 삭제
-	if err := s.itemRepository.Delete(c, item.UserID, item.ID); err != nil {
+			return s.createHistory(c, user.ID, input.ItemID, user.ID, domain.ItemHistoryActionPurge, nil)
+		}
+
+		// 3. 아이템 조회
+		item, err := s.itemRepository.Get(c, user.ID, input.ItemID)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		// 4. 아이템을 휴지통으로 이동
+		if err := s.itemRepository.Delete(c, item.UserID, item.ID, input.Version); err != nil {
+			return errors.WithStack(err)
+		}
+
+		return s.createHistory(c, item.UserID, item.ID, user.ID, domain.ItemHistoryActionDelete, nil)
+	}); err != nil {
 		return errors.WithStack(err)
 	}
+	s.suggestIndexes.invalidate(user.ID)
 
-	// 3. 결과 반환
+	// 5. 결과 반환
 	return nil
 }
 
-func (s *Service) Update(c context.Context, input *UpdateInput) error {
+func (s *Service) Restore(c context.Context, input *RestoreInput) error {
 	// 1. 파라메터 체크
 	switch {
 	case valid.IsNil(c):
@@ -128,31 +174,100 @@ func (s *Service) Update(c context.Context, input *UpdateInput) error {
 		return errors.WithStack(err)
 	}
 
+	// 2. 아이템 복원
 	user := input.User
+	if err := db.Transaction(c, func(c context.Context) error {
+		if err := s.itemRepository.Restore(c, user.ID, input.ItemID); err != nil {
+			return errors.WithStack(err)
+		}
 
-	// 2. 아이템 조회
-	item, err := s.itemRepository.Get(c, user.ID, input.ItemID)
-	if err != nil {
+		return s.createHistory(c, user.ID, input.ItemID, user.ID, domain.ItemHistoryActionRestore, nil)
+	}); err != nil {
 		return errors.WithStack(err)
 	}
+	s.suggestIndexes.invalidate(user.ID)
+
+	// 3. 결과 반환
+	return nil
+}
 
-	// 3. 아이템 수정
-	param := &repository.UpdateItemInput{
-		Name:        input.Name,
-		Description: input.Description,
-		Price:       input.Price,
-		Cost:        input.Cost,
-		Category:    input.Category,
-		Barcode:     input.Barcode,
-		Size:        input.Size,
-		ExpiryAt:    input.ExpiryAt,
+func (s *Service) Update(c context.Context, input *UpdateInput) (*UpdateOutput, error) {
+	// 1. 파라메터 체크
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case valid.IsNil(input):
+		return nil, domain.ErrNilInput
 	}
-	if err := s.itemRepository.Update(c, item.UserID, item.ID, param); err != nil {
-		return errors.WithStack(err)
+	if err := input.Validate(); err != nil {
+		return nil, errors.WithStack(err)
 	}
 
+	// 2. 아이템 수정, If-Match 없이 수정하는 중 다른 요청이 먼저 수정한 경우 다시 조회해 수정합니다.
+	var (
+		output *UpdateOutput
+		err    error
+	)
+	for attempt := 1; ; attempt++ {
+		output, err = s.update(c, input)
+		if err == nil || input.Version > 0 || attempt >= maxUpdateAttempts || !errors.Is(err, domain.ErrItemVersionMismatch) {
+			break
+		}
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	s.suggestIndexes.invalidate(input.User.ID)
+
 	// 3. 결과 반환
-	return nil
+	return output, nil
+}
+
+// maxUpdateAttempts If-Match 없이 수정하는 경우 다른 요청과 동시에 수정해 버전이 달라졌을 때 시도하는 최대 횟수입니다.
+const maxUpdateAttempts = 3
+
+// update 아이템을 조회하고 조회한 버전인 경우에만 수정하며, 조회한 값과 비교한 변경 이력을 함께 저장합니다.
+// 조회와 수정 사이에 다른 요청이 아이템을 수정하면 domain.ErrItemVersionMismatch를 반환하므로, 이력의 이전 값은 항상 수정 직전의 값입니다.
+func (s *Service) update(c context.Context, input *UpdateInput) (*UpdateOutput, error) {
+	user := input.User
+	output := &UpdateOutput{}
+	if err := db.Transaction(c, func(c context.Context) error {
+		item, err := s.itemRepository.Get(c, user.ID, input.ItemID)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		// 버전이 다른 경우 저장소에서도 확인하지만 불필요한 쓰기를 피하기 위해 먼저 확인합니다.
+		if input.Version > 0 && input.Version != item.Version {
+			return errors.WithStack(domain.ErrItemVersionMismatch)
+		}
+		if !valid.IsNil(input.Name) && *input.Name != item.Name {
+			if output.SimilarItems, err = s.checkSimilarItems(c, user, *input.Name, item.ID); err != nil {
+				return errors.WithStack(err)
+			}
+		}
+		param := &repository.UpdateItemInput{
+			Name:        input.Name,
+			Description: input.Description,
+			Price:       input.Price,
+			Cost:        input.Cost,
+			Category:    input.Category,
+			Barcode:     input.Barcode,
+			Size:        input.Size,
+			ExpiryAt:    input.ExpiryAt,
+			Version:     item.Version,
+		}
+		if err := s.itemRepository.Update(c, item.UserID, item.ID, param); err != nil {
+			return errors.WithStack(err)
+		}
+		output.Version = item.Version + 1
+
+		return s.createHistory(c, item.UserID, item.ID, user.ID, domain.ItemHistoryActionUpdate, changedFields(item, param))
+	}); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return output, nil
 }
 
 func (s *Service) Find(c context.Context, input *FindInput) (*FindOutput, error) {
@@ -166,20 +281,186 @@ func (s *Service) Find(c context.Context, input *FindInput) (*FindOutput, error)
 		return nil, errors.WithStack(err)
 	}
 
-	param := &repository.FindItemInput{
+	scope, err := cursorScope(false, input.Keyword, input.Filter)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	cursor, err := s.decodePageCursor(input.Cursor, input.Sort, scope)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var (
+		findItemOutput *repository.FindItemOutput
+		// hits 키워드로 검색한 경우 아이템별 일치 점수와 일치한 범위입니다.
+		hits []searchHit
+	)
+	if input.hasKeyword() {
+		findItemOutput, hits, err = s.search(c, input, cursor)
+	} else {
+		findItemOutput, err = s.itemRepository.Find(c, &repository.FindItemInput{
+			UserID:     input.User.ID,
+			Filter:     input.Filter,
+			Sort:       input.Sort,
+			Cursor:     cursor,
+			WithTotal:  input.WithTotal,
+			WithFacets: input.WithFacets,
+			Limit:      input.Limit,
+		})
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	output := &FindOutput{
+		TotalCount: findItemOutput.TotalCount,
+		Facets:     findItemOutput.Facets,
+		Items:      findItemOutput.Items,
+		HasNext:    findItemOutput.HasNext,
+		HasPrev:    findItemOutput.HasPrev,
+	}
+	for _, hit := range hits {
+		output.Highlights = append(output.Highlights, hit.highlight)
+	}
+	if items := findItemOutput.Items; len(items) > 0 {
+		if output.HasNext {
+			nextCursor := domain.NewItemCursor(input.Sort, &items[len(items)-1], false)
+			if hits != nil {
+				nextCursor.Score = hits[len(items)-1].score
+			}
+			if output.NextCursor, err = s.encodeCursor(nextCursor, scope); err != nil {
+				return nil, errors.WithStack(err)
+			}
+		}
+		if output.HasPrev {
+			prevCursor := domain.NewItemCursor(input.Sort, &items[0], true)
+			if hits != nil {
+				prevCursor.Score = hits[0].score
+			}
+			if output.PrevCursor, err = s.encodeCursor(prevCursor, scope); err != nil {
+				return nil, errors.WithStack(err)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func (s *Service) FindTrash(c context.Context, input *FindTrashInput) (*FindOutput, error) {
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case valid.IsNil(input):
+		return nil, domain.ErrNilInput
+	}
+	if err := input.Validate(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	scope, err := cursorScope(true, "", domain.ItemFilter{})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	cursor, err := s.decodePageCursor(input.Cursor, nil, scope)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	param := &repository.FindDeletedItemInput{
+		UserID:    input.User.ID,
+		Cursor:    cursor,
+		WithTotal: input.WithTotal,
+		Limit:     input.Limit,
+	}
+	findItemOutput, err := s.itemRepository.FindDeleted(c, param)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	output := &FindOutput{
+		TotalCount: findItemOutput.TotalCount,
+		Items:      findItemOutput.Items,
+		HasNext:    findItemOutput.HasNext,
+		HasPrev:    findItemOutput.HasPrev,
+	}
+	if items := findItemOutput.Items; len(items) > 0 {
+		if output.HasNext {
+			if output.NextCursor, err = s.encodeCursor(domain.NewItemCursor(nil, &items[len(items)-1], false), scope); err != nil {
+				return nil, errors.WithStack(err)
+			}
+		}
+		if output.HasPrev {
+			if output.PrevCursor, err = s.encodeCursor(domain.NewItemCursor(nil, &items[0], true), scope); err != nil {
+				return nil, errors.WithStack(err)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func (s *Service) PurgeTrash(c context.Context, input *PurgeTrashInput) (*PurgeTrashOutput, error) {
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case valid.IsNil(input):
+		return nil, domain.ErrNilInput
+	}
+	if err := input.Validate(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	purgedCount, err := s.itemRepository.PurgeDeleted(c, time.Now().Add(-input.Retention))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &PurgeTrashOutput{PurgedCount: purgedCount}, nil
+}
+
+func (s *Service) History(c context.Context, input *HistoryInput) (*HistoryOutput, error) {
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case valid.IsNil(input):
+		return nil, domain.ErrNilInput
+	}
+	if err := input.Validate(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// 영구 삭제된 아이템의 이력도 조회할 수 있도록 아이템을 조회하지 않습니다.
+	param := &repository.FindItemHistoryInput{
 		UserID:      input.User.ID,
-		Keyword:     input.Keyword,
+		ItemID:      input.ItemID,
 		SearchAfter: input.SearchAfter,
 	}
-	findItemOutput, err := s.itemRepository.Find(c, param)
+	findItemHistoryOutput, err := s.itemHistoryRepository.Find(c, param)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
-	return &FindOutput{
-		TotalCount:  findItemOutput.TotalCount,
-		Items:       findItemOutput.Items,
-		HasNext:     findItemOutput.HasNext,
-		SearchAfter: findItemOutput.SearchAfter,
+	return &HistoryOutput{
+		Histories:   findItemHistoryOutput.Histories,
+		HasNext:     findItemHistoryOutput.HasNext,
+		SearchAfter: findItemHistoryOutput.SearchAfter,
 	}, nil
 }
+
+// createHistory 아이템 변경 이력을 저장하며, 아이템 변경과 같은 트랜잭션에서 호출해야 합니다.
+func (s *Service) createHistory(
+	c context.Context,
+	userID, itemID, actorID int,
+	action domain.ItemHistoryAction,
+	changes []domain.ItemFieldChange,
+) error {
+	history, err := domain.NewItemHistory(userID, itemID, actorID, domain.RequestIDFromContext(c), action, changes)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := s.itemHistoryRepository.Create(c, history); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
@@ -2,6 +2,8 @@ package item
 
 import (
 	"context"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,7 +13,9 @@ import (
 
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/internal/db"
 	"github.com/psi59/payhere-assignment/internal/mocks/repomocks"
+	"github.com/psi59/payhere-assignment/repository/memory"
 	"github.com/rs/zerolog/log"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -19,6 +23,8 @@ import (
 
 var userDomain *domain.User
 
+const testCursorSecret = "cursor-secret"
+
 func init() {
 	u, err := domain.NewUser(
 		gofakeit.Regex(`^01\d{8,9}$`),
@@ -34,13 +40,16 @@ func init() {
 }
 
 func TestService_Create(t *testing.T) {
-	ctx := context.TODO()
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	itemRepository := repomocks.NewMockItemRepository(ctrl)
-	srv, err := NewService(itemRepository)
+	itemHistoryRepository := repomocks.NewMockItemHistoryRepository(ctrl)
+	srv, err := NewService(testCursorSecret, itemRepository, itemHistoryRepository)
 	assert.NoError(t, err)
+	// 이름이 비슷한 아이템 확인을 위한 조회이며, 비슷한 아이템이 없는 경우입니다.
+	itemRepository.EXPECT().Find(gomock.Any(), gomock.Any()).Return(&repository.FindItemOutput{}, nil).AnyTimes()
 
 	t.Run("OK", func(t *testing.T) {
 		input := &CreateInput{
@@ -54,10 +63,20 @@ func TestService_Create(t *testing.T) {
 			Size:        domain.ItemSizeSmall,
 			ExpiryAt:    gofakeit.FutureDate(),
 		}
-		itemRepository.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, item *domain.Item) error {
+		itemRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, item *domain.Item) error {
 			item.ID = gofakeit.Number(1, 10)
 			return nil
 		})
+		itemHistoryRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, history *domain.ItemHistory) error {
+			assert.Equal(t, domain.ItemHistoryActionCreate, history.Action)
+			assert.Equal(t, userDomain.ID, history.ActorID)
+			assert.Len(t, history.Changes, 8)
+			for _, change := range history.Changes {
+				assert.Nil(t, change.OldValue)
+				assert.NotNil(t, change.NewValue)
+			}
+			return nil
+		})
 
 		got, err := srv.Create(ctx, input)
 		assert.NoError(t, err)
@@ -65,6 +84,29 @@ func TestService_Create(t *testing.T) {
 		assert.True(t, got.Item.ID > 0)
 	})
 
+	t.Run("이력 생성 실패", func(t *testing.T) {
+		input := &CreateInput{
+			User:        userDomain,
+			Name:        gofakeit.Drink(),
+			Description: gofakeit.SentenceSimple(),
+			Price:       gofakeit.Number(1, 10000),
+			Cost:        gofakeit.Number(1, 10000),
+			Category:    gofakeit.RandomString([]string{"coffee", "tea", "desert"}),
+			Barcode:     gofakeit.Numerify("################"),
+			Size:        domain.ItemSizeSmall,
+			ExpiryAt:    gofakeit.FutureDate(),
+		}
+		itemRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, item *domain.Item) error {
+			item.ID = gofakeit.Number(1, 10)
+			return nil
+		})
+		itemHistoryRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(gofakeit.Error())
+
+		got, err := srv.Create(ctx, input)
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
 	t.Run("nil context", func(t *testing.T) {
 		input := &CreateInput{
 			User:        userDomain,
@@ -119,7 +161,7 @@ func TestService_Create(t *testing.T) {
 			Size:        domain.ItemSizeSmall,
 			ExpiryAt:    gofakeit.FutureDate(),
 		}
-		itemRepository.EXPECT().Create(ctx, gomock.Any()).Return(domain.ErrItemAlreadyExists)
+		itemRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(domain.ErrItemAlreadyExists)
 
 		got, err := srv.Create(ctx, input)
 		assert.Error(t, err)
@@ -138,7 +180,7 @@ func TestService_Create(t *testing.T) {
 			Size:        domain.ItemSizeSmall,
 			ExpiryAt:    gofakeit.FutureDate(),
 		}
-		itemRepository.EXPECT().Create(ctx, gomock.Any()).Return(gofakeit.Error())
+		itemRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(gofakeit.Error())
 
 		got, err := srv.Create(ctx, input)
 		assert.Error(t, err)
@@ -147,12 +189,12 @@ func TestService_Create(t *testing.T) {
 }
 
 func TestService_Get(t *testing.T) {
-	ctx := context.TODO()
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	itemRepository := repomocks.NewMockItemRepository(ctrl)
-	srv, err := NewService(itemRepository)
+	srv, err := NewService(testCursorSecret, itemRepository, repomocks.NewMockItemHistoryRepository(ctrl))
 	assert.NoError(t, err)
 
 	t.Run("OK", func(t *testing.T) {
@@ -221,18 +263,24 @@ func TestService_Get(t *testing.T) {
 }
 
 func TestService_Delete(t *testing.T) {
-	ctx := context.TODO()
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	itemRepository := repomocks.NewMockItemRepository(ctrl)
-	srv, err := NewService(itemRepository)
+	itemHistoryRepository := repomocks.NewMockItemHistoryRepository(ctrl)
+	srv, err := NewService(testCursorSecret, itemRepository, itemHistoryRepository)
 	assert.NoError(t, err)
 
 	t.Run("OK", func(t *testing.T) {
 		item := newTestItem(t, userDomain.ID)
-		itemRepository.EXPECT().Get(ctx, userDomain.ID, item.ID).Return(item, nil)
-		itemRepository.EXPECT().Delete(ctx, userDomain.ID, item.ID).Return(nil)
+		itemRepository.EXPECT().Get(gomock.Any(), userDomain.ID, item.ID).Return(item, nil)
+		itemRepository.EXPECT().Delete(gomock.Any(), userDomain.ID, item.ID, 0).Return(nil)
+		itemHistoryRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, history *domain.ItemHistory) error {
+			assert.Equal(t, domain.ItemHistoryActionDelete, history.Action)
+			assert.Equal(t, item.ID, history.ItemID)
+			return nil
+		})
 		input := &DeleteInput{
 			User:   userDomain,
 			ItemID: item.ID,
@@ -267,7 +315,7 @@ func TestService_Delete(t *testing.T) {
 
 	t.Run("item not found", func(t *testing.T) {
 		item := newTestItem(t, userDomain.ID)
-		itemRepository.EXPECT().Get(ctx, userDomain.ID, item.ID).Return(nil, domain.ErrItemNotFound)
+		itemRepository.EXPECT().Get(gomock.Any(), userDomain.ID, item.ID).Return(nil, domain.ErrItemNotFound)
 		input := &DeleteInput{
 			User:   userDomain,
 			ItemID: item.ID,
@@ -278,8 +326,8 @@ func TestService_Delete(t *testing.T) {
 
 	t.Run("아이템 삭제 에러", func(t *testing.T) {
 		item := newTestItem(t, userDomain.ID)
-		itemRepository.EXPECT().Get(ctx, userDomain.ID, item.ID).Return(item, nil)
-		itemRepository.EXPECT().Delete(ctx, userDomain.ID, item.ID).Return(gofakeit.Error())
+		itemRepository.EXPECT().Get(gomock.Any(), userDomain.ID, item.ID).Return(item, nil)
+		itemRepository.EXPECT().Delete(gomock.Any(), userDomain.ID, item.ID, 0).Return(gofakeit.Error())
 		input := &DeleteInput{
 			User:   userDomain,
 			ItemID: item.ID,
@@ -287,35 +335,247 @@ func TestService_Delete(t *testing.T) {
 		err := srv.Delete(ctx, input)
 		assert.Error(t, err)
 	})
+
+	t.Run("영구 삭제", func(t *testing.T) {
+		item := newTestItem(t, userDomain.ID)
+		itemRepository.EXPECT().Purge(gomock.Any(), userDomain.ID, item.ID, 0).Return(nil)
+		itemHistoryRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, history *domain.ItemHistory) error {
+			assert.Equal(t, domain.ItemHistoryActionPurge, history.Action)
+			assert.Equal(t, item.ID, history.ItemID)
+			return nil
+		})
+		input := &DeleteInput{
+			User:      userDomain,
+			ItemID:    item.ID,
+			Permanent: true,
+		}
+		err := srv.Delete(ctx, input)
+		assert.NoError(t, err)
+	})
+
+	t.Run("버전 불일치", func(t *testing.T) {
+		item := newTestItem(t, userDomain.ID)
+		itemRepository.EXPECT().Get(gomock.Any(), userDomain.ID, item.ID).Return(item, nil)
+		itemRepository.EXPECT().Delete(gomock.Any(), userDomain.ID, item.ID, 2).Return(domain.ErrItemVersionMismatch)
+		input := &DeleteInput{
+			User:    userDomain,
+			ItemID:  item.ID,
+			Version: 2,
+		}
+		err := srv.Delete(ctx, input)
+		assert.ErrorIs(t, err, domain.ErrItemVersionMismatch)
+	})
+
+	t.Run("영구 삭제 item not found", func(t *testing.T) {
+		item := newTestItem(t, userDomain.ID)
+		itemRepository.EXPECT().Purge(gomock.Any(), userDomain.ID, item.ID, 0).Return(domain.ErrItemNotFound)
+		input := &DeleteInput{
+			User:      userDomain,
+			ItemID:    item.ID,
+			Permanent: true,
+		}
+		err := srv.Delete(ctx, input)
+		assert.ErrorIs(t, err, domain.ErrItemNotFound)
+	})
+}
+
+func TestService_Restore(t *testing.T) {
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemRepository := repomocks.NewMockItemRepository(ctrl)
+	itemHistoryRepository := repomocks.NewMockItemHistoryRepository(ctrl)
+	srv, err := NewService(testCursorSecret, itemRepository, itemHistoryRepository)
+	assert.NoError(t, err)
+
+	t.Run("OK", func(t *testing.T) {
+		item := newTestItem(t, userDomain.ID)
+		itemRepository.EXPECT().Restore(gomock.Any(), userDomain.ID, item.ID).Return(nil)
+		itemHistoryRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, history *domain.ItemHistory) error {
+			assert.Equal(t, domain.ItemHistoryActionRestore, history.Action)
+			return nil
+		})
+		err := srv.Restore(ctx, &RestoreInput{
+			User:   userDomain,
+			ItemID: item.ID,
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("nil context", func(t *testing.T) {
+		err := srv.Restore(nil, &RestoreInput{
+			User:   userDomain,
+			ItemID: 1,
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("nil input", func(t *testing.T) {
+		err := srv.Restore(ctx, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		err := srv.Restore(ctx, &RestoreInput{
+			User:   userDomain,
+			ItemID: 0,
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("item not found", func(t *testing.T) {
+		item := newTestItem(t, userDomain.ID)
+		itemRepository.EXPECT().Restore(gomock.Any(), userDomain.ID, item.ID).Return(domain.ErrItemNotFound)
+		err := srv.Restore(ctx, &RestoreInput{
+			User:   userDomain,
+			ItemID: item.ID,
+		})
+		assert.ErrorIs(t, err, domain.ErrItemNotFound)
+	})
+
+	t.Run("이름 중복", func(t *testing.T) {
+		item := newTestItem(t, userDomain.ID)
+		itemRepository.EXPECT().Restore(gomock.Any(), userDomain.ID, item.ID).Return(domain.ErrItemAlreadyExists)
+		err := srv.Restore(ctx, &RestoreInput{
+			User:   userDomain,
+			ItemID: item.ID,
+		})
+		assert.ErrorIs(t, err, domain.ErrItemAlreadyExists)
+	})
 }
 
 func TestService_Update(t *testing.T) {
-	ctx := context.TODO()
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	itemRepository := repomocks.NewMockItemRepository(ctrl)
-	srv, err := NewService(itemRepository)
+	itemHistoryRepository := repomocks.NewMockItemHistoryRepository(ctrl)
+	srv, err := NewService(testCursorSecret, itemRepository, itemHistoryRepository)
 	assert.NoError(t, err)
+	// 이름이 비슷한 아이템 확인을 위한 조회이며, 비슷한 아이템이 없는 경우입니다.
+	itemRepository.EXPECT().Find(gomock.Any(), gomock.Any()).Return(&repository.FindItemOutput{}, nil).AnyTimes()
 	item := newTestItem(t, userDomain.ID)
 
-	name := gofakeit.Drink()
+	// 이름이 같으면 변경 이력이 남지 않으므로 기존 이름과 다른 이름을 사용합니다.
+	name := item.Name + " " + gofakeit.Drink()
 	updateInput := &repository.UpdateItemInput{
-		Name: &name,
+		Name:    &name,
+		Version: item.Version,
 	}
 
 	t.Run("OK", func(t *testing.T) {
-		itemRepository.EXPECT().Get(ctx, userDomain.ID, item.ID).Return(item, nil)
-		itemRepository.EXPECT().Update(ctx, userDomain.ID, item.ID, updateInput).Return(nil)
+		itemRepository.EXPECT().Get(gomock.Any(), userDomain.ID, item.ID).Return(item, nil)
+		itemRepository.EXPECT().Update(gomock.Any(), userDomain.ID, item.ID, updateInput).Return(nil)
+		itemHistoryRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, history *domain.ItemHistory) error {
+			assert.Equal(t, domain.ItemHistoryActionUpdate, history.Action)
+			require.Len(t, history.Changes, 1)
+			assert.Equal(t, "name", history.Changes[0].Field)
+			assert.Equal(t, item.Name, *history.Changes[0].OldValue)
+			assert.Equal(t, name, *history.Changes[0].NewValue)
+			return nil
+		})
+		input := &UpdateInput{
+			User:   userDomain,
+			ItemID: item.ID,
+			Name:   &name,
+		}
+		output, err := srv.Update(ctx, input)
+		assert.NoError(t, err)
+		assert.Equal(t, item.Version+1, output.Version)
+	})
+
+	t.Run("이력 생성 실패", func(t *testing.T) {
+		itemRepository.EXPECT().Get(gomock.Any(), userDomain.ID, item.ID).Return(item, nil)
+		itemRepository.EXPECT().Update(gomock.Any(), userDomain.ID, item.ID, updateInput).Return(nil)
+		itemHistoryRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(gofakeit.Error())
 		input := &UpdateInput{
 			User:   userDomain,
 			ItemID: item.ID,
 			Name:   &name,
 		}
-		err := srv.Update(ctx, input)
+		_, err := srv.Update(ctx, input)
+		assert.Error(t, err)
+	})
+
+	t.Run("버전 불일치", func(t *testing.T) {
+		itemRepository.EXPECT().Get(gomock.Any(), userDomain.ID, item.ID).Return(item, nil)
+		input := &UpdateInput{
+			User:    userDomain,
+			ItemID:  item.ID,
+			Name:    &name,
+			Version: item.Version + 1,
+		}
+		_, err := srv.Update(ctx, input)
+		assert.ErrorIs(t, err, domain.ErrItemVersionMismatch)
+	})
+
+	t.Run("버전 일치", func(t *testing.T) {
+		itemRepository.EXPECT().Get(gomock.Any(), userDomain.ID, item.ID).Return(item, nil)
+		itemRepository.EXPECT().Update(gomock.Any(), userDomain.ID, item.ID, &repository.UpdateItemInput{
+			Name:    &name,
+			Version: item.Version,
+		}).Return(nil)
+		itemHistoryRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+		input := &UpdateInput{
+			User:    userDomain,
+			ItemID:  item.ID,
+			Name:    &name,
+			Version: item.Version,
+		}
+		_, err := srv.Update(ctx, input)
 		assert.NoError(t, err)
 	})
 
+	t.Run("동시에 수정된 경우 다시 조회해 수정", func(t *testing.T) {
+		updated := *item
+		updated.Version++
+		gomock.InOrder(
+			itemRepository.EXPECT().Get(gomock.Any(), userDomain.ID, item.ID).Return(item, nil),
+			itemRepository.EXPECT().Update(gomock.Any(), userDomain.ID, item.ID, updateInput).Return(domain.ErrItemVersionMismatch),
+			itemRepository.EXPECT().Get(gomock.Any(), userDomain.ID, item.ID).Return(&updated, nil),
+			itemRepository.EXPECT().Update(gomock.Any(), userDomain.ID, item.ID, &repository.UpdateItemInput{
+				Name:    &name,
+				Version: updated.Version,
+			}).Return(nil),
+		)
+		itemHistoryRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+		input := &UpdateInput{
+			User:   userDomain,
+			ItemID: item.ID,
+			Name:   &name,
+		}
+		output, err := srv.Update(ctx, input)
+		assert.NoError(t, err)
+		assert.Equal(t, updated.Version+1, output.Version)
+	})
+
+	t.Run("계속 동시에 수정되는 경우", func(t *testing.T) {
+		itemRepository.EXPECT().Get(gomock.Any(), userDomain.ID, item.ID).Return(item, nil).Times(maxUpdateAttempts)
+		itemRepository.EXPECT().Update(gomock.Any(), userDomain.ID, item.ID, updateInput).Return(domain.ErrItemVersionMismatch).Times(maxUpdateAttempts)
+		input := &UpdateInput{
+			User:   userDomain,
+			ItemID: item.ID,
+			Name:   &name,
+		}
+		_, err := srv.Update(ctx, input)
+		assert.ErrorIs(t, err, domain.ErrItemVersionMismatch)
+	})
+
+	t.Run("If-Match로 수정하면 다시 시도하지 않음", func(t *testing.T) {
+		itemRepository.EXPECT().Get(gomock.Any(), userDomain.ID, item.ID).Return(item, nil)
+		itemRepository.EXPECT().Update(gomock.Any(), userDomain.ID, item.ID, updateInput).Return(domain.ErrItemVersionMismatch)
+		input := &UpdateInput{
+			User:    userDomain,
+			ItemID:  item.ID,
+			Name:    &name,
+			Version: item.Version,
+		}
+		_, err := srv.Update(ctx, input)
+		assert.ErrorIs(t, err, domain.ErrItemVersionMismatch)
+	})
+
 	t.Run("nil context", func(t *testing.T) {
 		item := newTestItem(t, userDomain.ID)
 		input := &UpdateInput{
@@ -323,12 +583,12 @@ func TestService_Update(t *testing.T) {
 			ItemID: item.ID,
 			Name:   &name,
 		}
-		err := srv.Update(nil, input)
+		_, err := srv.Update(nil, input)
 		assert.Error(t, err)
 	})
 
 	t.Run("nil input", func(t *testing.T) {
-		err := srv.Update(ctx, nil)
+		_, err := srv.Update(ctx, nil)
 		assert.Error(t, err)
 	})
 
@@ -338,87 +598,393 @@ func TestService_Update(t *testing.T) {
 			ItemID: 0,
 			Name:   &name,
 		}
-		err := srv.Update(ctx, input)
+		_, err := srv.Update(ctx, input)
 		assert.Error(t, err)
 	})
 
 	t.Run("item not found", func(t *testing.T) {
 		item := newTestItem(t, userDomain.ID)
-		itemRepository.EXPECT().Get(ctx, userDomain.ID, item.ID).Return(nil, domain.ErrItemNotFound)
+		itemRepository.EXPECT().Get(gomock.Any(), userDomain.ID, item.ID).Return(nil, domain.ErrItemNotFound)
 		input := &UpdateInput{
 			User:   userDomain,
 			ItemID: item.ID,
 			Name:   &name,
 		}
-		err := srv.Update(ctx, input)
+		_, err := srv.Update(ctx, input)
 		assert.Error(t, err)
 	})
 
 	t.Run("아이템 수정 에러", func(t *testing.T) {
 		item := newTestItem(t, userDomain.ID)
-		itemRepository.EXPECT().Get(ctx, userDomain.ID, item.ID).Return(item, nil)
-		itemRepository.EXPECT().Update(ctx, userDomain.ID, item.ID, updateInput).Return(gofakeit.Error())
+		itemRepository.EXPECT().Get(gomock.Any(), userDomain.ID, item.ID).Return(item, nil)
+		itemRepository.EXPECT().Update(gomock.Any(), userDomain.ID, item.ID, updateInput).Return(gofakeit.Error())
 		input := &UpdateInput{
 			User:   userDomain,
 			ItemID: item.ID,
 			Name:   &name,
 		}
-		err := srv.Update(ctx, input)
+		_, err := srv.Update(ctx, input)
 		assert.Error(t, err)
 	})
 }
 
 func TestService_Find(t *testing.T) {
-	ctx := context.TODO()
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	itemRepository := repomocks.NewMockItemRepository(ctrl)
-	srv, err := NewService(itemRepository)
+	srv, err := NewService(testCursorSecret, itemRepository, repomocks.NewMockItemHistoryRepository(ctrl))
 	assert.NoError(t, err)
 
-	t.Run("OK", func(t *testing.T) {
-		input := &FindInput{
-			User:        userDomain,
-			Keyword:     "ㄹㄸ",
-			SearchAfter: gofakeit.Number(1, 100),
-		}
-		findItemOutput := &repository.FindItemOutput{
-			TotalCount: 10,
+	order := domain.ItemSortOrder{{Key: domain.ItemSortKeyPrice, Desc: true}, {Key: domain.ItemSortKeyExpiryAt}}
+	scope := newTestCursorScope(t, false, "", domain.ItemFilter{})
+	newFindItemOutput := func() *repository.FindItemOutput {
+		return &repository.FindItemOutput{
+			TotalCount: 30,
 			Items: []domain.Item{
 				*(newTestItem(t, userDomain.ID)),
 				*(newTestItem(t, userDomain.ID)),
 				*(newTestItem(t, userDomain.ID)),
-				*(newTestItem(t, userDomain.ID)),
-				*(newTestItem(t, userDomain.ID)),
-				*(newTestItem(t, userDomain.ID)),
-				*(newTestItem(t, userDomain.ID)),
-				*(newTestItem(t, userDomain.ID)),
-				*(newTestItem(t, userDomain.ID)),
-				*(newTestItem(t, userDomain.ID)),
 			},
-			HasNext:     true,
-			SearchAfter: 10,
+			HasNext: true,
+			HasPrev: true,
 		}
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		input := &FindInput{
+			User:      userDomain,
+			Sort:      order,
+			WithTotal: true,
+			Limit:     20,
+		}
+		findItemOutput := newFindItemOutput()
+		findItemOutput.HasPrev = false
 		itemRepository.EXPECT().Find(ctx, &repository.FindItemInput{
-			UserID:      userDomain.ID,
-			Keyword:     input.Keyword,
-			SearchAfter: input.SearchAfter,
+			UserID:    userDomain.ID,
+			Sort:      order,
+			WithTotal: true,
+			Limit:     input.Limit,
 		}).Return(findItemOutput, nil)
 		got, err := srv.Find(ctx, input)
 		assert.NoError(t, err)
 		assert.NotNil(t, got)
 		assert.Equal(t, findItemOutput.Items, got.Items)
 		assert.Equal(t, findItemOutput.HasNext, got.HasNext)
+		assert.False(t, got.HasPrev)
 		assert.Equal(t, findItemOutput.TotalCount, got.TotalCount)
-		assert.Equal(t, findItemOutput.SearchAfter, got.SearchAfter)
+		assert.Empty(t, got.PrevCursor)
+
+		nextCursor, err := srv.encodeCursor(domain.NewItemCursor(order, &findItemOutput.Items[2], false), scope)
+		assert.NoError(t, err)
+		assert.Equal(t, nextCursor, got.NextCursor)
+	})
+
+	t.Run("커서로 조회", func(t *testing.T) {
+		findItemOutput := newFindItemOutput()
+		encodedCursor, err := srv.encodeCursor(domain.NewItemCursor(order, &findItemOutput.Items[0], true), scope)
+		require.NoError(t, err)
+		// 시각은 직렬화 과정에서 시간대 정보가 바뀔 수 있으므로 디코딩한 커서와 비교합니다.
+		cursor, _, err := srv.decodeCursor(encodedCursor)
+		require.NoError(t, err)
+		itemRepository.EXPECT().Find(ctx, &repository.FindItemInput{
+			UserID: userDomain.ID,
+			Sort:   order,
+			Cursor: cursor,
+		}).Return(findItemOutput, nil)
+
+		got, err := srv.Find(ctx, &FindInput{User: userDomain, Sort: order, Cursor: encodedCursor})
+		assert.NoError(t, err)
+		assert.True(t, got.HasNext)
+		assert.True(t, got.HasPrev)
+
+		assert.Equal(t, encodedCursor, got.PrevCursor)
+		nextCursor, err := srv.encodeCursor(domain.NewItemCursor(order, &findItemOutput.Items[2], false), scope)
+		assert.NoError(t, err)
+		assert.Equal(t, nextCursor, got.NextCursor)
+	})
+
+	t.Run("조회 조건 전달", func(t *testing.T) {
+		minPrice := 1000
+		input := &FindInput{
+			User:   userDomain,
+			Filter: domain.ItemFilter{Categories: []string{"coffee"}, MinPrice: &minPrice},
+		}
+		findItemOutput := &repository.FindItemOutput{Items: []domain.Item{}}
+		itemRepository.EXPECT().Find(ctx, &repository.FindItemInput{
+			UserID: userDomain.ID,
+			Filter: input.Filter,
+		}).Return(findItemOutput, nil)
+		got, err := srv.Find(ctx, input)
+		assert.NoError(t, err)
+		assert.NotNil(t, got)
+	})
+
+	t.Run("변조된 커서", func(t *testing.T) {
+		encodedCursor, err := srv.encodeCursor(domain.NewItemCursor(order, newTestItem(t, userDomain.ID), false), scope)
+		require.NoError(t, err)
+		otherSrv, err := NewService("other-secret", itemRepository, repomocks.NewMockItemHistoryRepository(ctrl))
+		require.NoError(t, err)
+		forgedCursor, err := otherSrv.encodeCursor(domain.NewItemCursor(order, newTestItem(t, userDomain.ID), false), scope)
+		require.NoError(t, err)
+		payload, signature, _ := strings.Cut(encodedCursor, ".")
+		forgedPayload, _, _ := strings.Cut(forgedCursor, ".")
+
+		for _, cursor := range []string{
+			"invalid",
+			forgedCursor,
+			forgedPayload + "." + signature,
+			payload + "." + signature[1:],
+			payload + ".!",
+		} {
+			got, err := srv.Find(ctx, &FindInput{User: userDomain, Sort: order, Cursor: cursor})
+			assert.ErrorIs(t, err, domain.ErrInvalidItemCursor, cursor)
+			assert.Nil(t, got)
+		}
+	})
+
+	t.Run("집계 조회", func(t *testing.T) {
+		findItemOutput := newFindItemOutput()
+		findItemOutput.Facets = &domain.ItemFacets{Categories: []domain.ItemFacetCount{{Value: "coffee", Count: 3}}}
+		itemRepository.EXPECT().Find(ctx, &repository.FindItemInput{
+			UserID:     userDomain.ID,
+			WithFacets: true,
+		}).Return(findItemOutput, nil)
+
+		got, err := srv.Find(ctx, &FindInput{User: userDomain, WithFacets: true})
+		require.NoError(t, err)
+		assert.Equal(t, findItemOutput.Facets, got.Facets)
+	})
+
+	t.Run("키워드 검색 결과 집계", func(t *testing.T) {
+		var items []domain.Item
+		for i, v := range []struct {
+			name     string
+			category string
+			price    int
+		}{
+			{name: "아메리카노", category: "커피", price: 3000},
+			{name: "아이스 아메리카노", category: "커피", price: 3500},
+			{name: "아메리칸 쿠키", category: "디저트", price: 2500},
+			{name: "카페 라떼", category: "커피", price: 4000},
+		} {
+			item := newTestItem(t, userDomain.ID)
+			item.ID = i + 1
+			item.Name, item.Category, item.Price = v.name, v.category, v.price
+			items = append(items, *item)
+		}
+		itemRepository.EXPECT().Find(ctx, &repository.FindItemInput{
+			UserID:   userDomain.ID,
+			Keywords: []string{"ㅇ"},
+			Limit:    searchBatchSize,
+		}).Return(&repository.FindItemOutput{Items: items}, nil)
+
+		// 집계는 현재 페이지가 아닌 키워드와 일치한 아이템 전체로 계산합니다.
+		got, err := srv.Find(ctx, &FindInput{User: userDomain, Keyword: "아메리", WithFacets: true, Limit: 1})
+		require.NoError(t, err)
+		assert.Len(t, got.Items, 1)
+		require.NotNil(t, got.Facets)
+		assert.Equal(t, []domain.ItemFacetCount{{Value: "커피", Count: 2}, {Value: "디저트", Count: 1}}, got.Facets.Categories)
+		assert.Equal(t, []domain.ItemFacetCount{{Value: "small", Count: 3}, {Value: "large", Count: 0}}, got.Facets.Sizes)
+		assert.Equal(t, 1, got.Facets.PriceBuckets[1].Count)
+		assert.Equal(t, 2, got.Facets.PriceBuckets[2].Count)
+	})
+
+	t.Run("키워드 검색 대상을 모두 비교", func(t *testing.T) {
+		var items []domain.Item
+		for i, name := range []string{"아이스 아메리카노", "아메리칸 쿠키", "아메리카노"} {
+			item := newTestItem(t, userDomain.ID)
+			item.ID = i + 1
+			item.Name = name
+			items = append(items, *item)
+		}
+		// 첫 번째 배치 이후의 아이템도 비교하므로, 마지막 배치의 정확히 일치한 아이템이 가장 앞에 옵니다.
+		itemRepository.EXPECT().Find(ctx, &repository.FindItemInput{
+			UserID:   userDomain.ID,
+			Keywords: []string{"ㅇ"},
+			Limit:    searchBatchSize,
+		}).Return(&repository.FindItemOutput{Items: items[:2], HasNext: true}, nil)
+		itemRepository.EXPECT().Find(ctx, &repository.FindItemInput{
+			UserID:   userDomain.ID,
+			Keywords: []string{"ㅇ"},
+			Cursor:   domain.NewItemCursor(nil, &items[1], false),
+			Limit:    searchBatchSize,
+		}).Return(&repository.FindItemOutput{Items: items[2:]}, nil)
+
+		got, err := srv.Find(ctx, &FindInput{User: userDomain, Keyword: "아메리카노", WithTotal: true, Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, got.Items, 1)
+		assert.Equal(t, items[2].ID, got.Items[0].ID)
+		assert.Equal(t, 2, got.TotalCount)
+		assert.True(t, got.HasNext)
+	})
+
+	t.Run("정렬 순서가 다른 커서", func(t *testing.T) {
+		encodedCursor, err := srv.encodeCursor(domain.NewItemCursor(order, newTestItem(t, userDomain.ID), false), scope)
+		require.NoError(t, err)
+		got, err := srv.Find(ctx, &FindInput{User: userDomain, Cursor: encodedCursor})
+		assert.ErrorIs(t, err, domain.ErrInvalidItemCursor)
+		assert.Nil(t, got)
+	})
+
+	t.Run("다른 목록에서 생성한 커서", func(t *testing.T) {
+		minPrice := 1000
+		filter := domain.ItemFilter{MinPrice: &minPrice}
+		for _, tt := range []struct {
+			name  string
+			scope string
+			input *FindInput
+		}{
+			{name: "휴지통", scope: newTestCursorScope(t, true, "", domain.ItemFilter{}), input: &FindInput{User: userDomain}},
+			{name: "다른 검색어", scope: newTestCursorScope(t, false, "라떼", domain.ItemFilter{}), input: &FindInput{User: userDomain, Keyword: "모카"}},
+			{name: "다른 필터", scope: newTestCursorScope(t, false, "", filter), input: &FindInput{User: userDomain}},
+		} {
+			encodedCursor, err := srv.encodeCursor(domain.NewItemCursor(nil, newTestItem(t, userDomain.ID), false), tt.scope)
+			require.NoError(t, err)
+			tt.input.Cursor = encodedCursor
+			got, err := srv.Find(ctx, tt.input)
+			assert.ErrorIs(t, err, domain.ErrInvalidItemCursor, tt.name)
+			assert.Nil(t, got, tt.name)
+		}
+	})
+
+	t.Run("키워드 검색", func(t *testing.T) {
+		var items []domain.Item
+		for i, name := range []string{"아메리카노", "아이스 아메리카노", "카페 라떼", "아메리칸 쿠키"} {
+			item := newTestItem(t, userDomain.ID)
+			item.ID = i + 1
+			item.Name = name
+			items = append(items, *item)
+		}
+		minPrice := 1000
+		filter := domain.ItemFilter{MinPrice: &minPrice}
+		// 검색 대상 아이템은 검색어의 첫 자모로 저장소에서 고릅니다.
+		expectFindCandidates := func(keywords ...string) {
+			itemRepository.EXPECT().Find(ctx, &repository.FindItemInput{
+				UserID:   userDomain.ID,
+				Keywords: keywords,
+				Filter:   filter,
+				Limit:    searchBatchSize,
+			}).Return(&repository.FindItemOutput{Items: items}, nil)
+		}
+
+		// 영문 자판으로 입력한 "아메리카"이며, 키워드와 가깝게 일치한 순서로 정렬합니다.
+		expectFindCandidates("d", "ㅇ")
+		input := &FindInput{User: userDomain, Keyword: "dkapflzk", Filter: filter, WithTotal: true, Limit: 2}
+		got, err := srv.Find(ctx, input)
+		require.NoError(t, err)
+		assert.Equal(t, []domain.Item{items[0], items[1]}, got.Items)
+		assert.Equal(t, []domain.ItemHighlight{
+			{Spans: []domain.TextSpan{{Start: 0, End: 4}}},
+			{Spans: []domain.TextSpan{{Start: 4, End: 8}}},
+		}, got.Highlights)
+		assert.Equal(t, 3, got.TotalCount)
+		assert.True(t, got.HasNext)
+		assert.False(t, got.HasPrev)
+
+		expectFindCandidates("d", "ㅇ")
+		input.Cursor = got.NextCursor
+		got, err = srv.Find(ctx, input)
+		require.NoError(t, err)
+		assert.Equal(t, []domain.Item{items[3]}, got.Items)
+		assert.False(t, got.HasNext)
+		assert.True(t, got.HasPrev)
+
+		expectFindCandidates("d", "ㅇ")
+		input.Cursor = got.PrevCursor
+		got, err = srv.Find(ctx, input)
+		require.NoError(t, err)
+		assert.Equal(t, []domain.Item{items[0], items[1]}, got.Items)
+
+		// 정렬 순서를 지정하면 일치한 아이템을 정렬 순서로 정렬합니다.
+		expectFindCandidates("ㅇ")
+		got, err = srv.Find(ctx, &FindInput{User: userDomain, Keyword: "아멬", Filter: filter, Sort: domain.ItemSortOrder{{Key: domain.ItemSortKeyName}}})
+		require.NoError(t, err)
+		assert.Equal(t, []domain.Item{items[0], items[3], items[1]}, got.Items)
+
+		// 키워드와 일치한 정도가 낮은 순서
+		expectFindCandidates("ㅇ")
+		got, err = srv.Find(ctx, &FindInput{User: userDomain, Keyword: "아메리카", Filter: filter, Sort: domain.ItemSortOrder{{Key: domain.ItemSortKeyRelevance, Desc: true}}})
+		require.NoError(t, err)
+		assert.Equal(t, []domain.Item{items[3], items[1], items[0]}, got.Items)
+
+		// 초성으로 일치한 경우
+		expectFindCandidates("ㄹ")
+		got, err = srv.Find(ctx, &FindInput{User: userDomain, Keyword: "ㄹㄸ", Filter: filter})
+		require.NoError(t, err)
+		assert.Equal(t, []domain.Item{items[2]}, got.Items)
+		assert.Equal(t, []domain.ItemHighlight{{Chosung: true, Spans: []domain.TextSpan{{Start: 3, End: 5}}}}, got.Highlights)
+	})
+
+	t.Run("자모가 순서대로 포함된 아이템", func(t *testing.T) {
+		memDB := memory.NewDB()
+		ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memDB))
+		user := *userDomain
+		require.NoError(t, memory.NewUserRepository(memDB).Create(ctx, &user))
+		srv, err := NewService(testCursorSecret, memory.NewItemRepository(memDB), memory.NewItemHistoryRepository(memDB))
+		require.NoError(t, err)
+		for _, name := range []string{"아메리카노", "카페 라떼"} {
+			_, err := srv.Create(ctx, &CreateInput{
+				User:        &user,
+				Name:        name,
+				Description: gofakeit.SentenceSimple(),
+				Price:       3000,
+				Cost:        1000,
+				Category:    "커피",
+				Barcode:     gofakeit.Numerify("################"),
+				Size:        domain.ItemSizeSmall,
+				ExpiryAt:    gofakeit.FutureDate(),
+			})
+			require.NoError(t, err)
+		}
+
+		// "아리"는 "아메리카노"의 초성 "ㅇㅁㄹㅋㄴ"에 이어서 포함되지 않지만, 자모가 순서대로 포함되므로 일치합니다.
+		got, err := srv.Find(ctx, &FindInput{User: &user, Keyword: "아리"})
+		require.NoError(t, err)
+		require.Len(t, got.Items, 1)
+		assert.Equal(t, "아메리카노", got.Items[0].Name)
+	})
+
+	t.Run("검색어의 필드 조건 전달", func(t *testing.T) {
+		latte := newTestItem(t, userDomain.ID)
+		latte.Name, latte.Category = "카페 라떼", "커피"
+		mocha := newTestItem(t, userDomain.ID)
+		mocha.Name, mocha.Category = "카페 모카", "커피"
+		itemRepository.EXPECT().Find(ctx, &repository.FindItemInput{
+			UserID:   userDomain.ID,
+			Keywords: []string{"ㅋㅍ"},
+			Filter:   domain.ItemFilter{Categories: []string{"커피"}},
+			Limit:    searchBatchSize,
+		}).Return(&repository.FindItemOutput{Items: []domain.Item{*latte, *mocha}}, nil)
+
+		got, err := srv.Find(ctx, &FindInput{User: userDomain, Keyword: `"카페" -모카 category:커피`})
+		require.NoError(t, err)
+		assert.Equal(t, []domain.Item{*latte}, got.Items)
+	})
+
+	t.Run("잘못된 검색어", func(t *testing.T) {
+		got, err := srv.Find(ctx, &FindInput{User: userDomain, Keyword: "라떼 price:<abc"})
+		assert.ErrorIs(t, err, domain.ErrInvalidItemQuery)
+		var queryErr *QueryError
+		require.ErrorAs(t, err, &queryErr)
+		assert.Equal(t, 9, queryErr.Position)
+		assert.Nil(t, got)
+	})
+
+	t.Run("키워드 없이 일치한 정도 순 정렬", func(t *testing.T) {
+		got, err := srv.Find(ctx, &FindInput{
+			User: userDomain,
+			Sort: domain.ItemSortOrder{{Key: domain.ItemSortKeyRelevance}},
+		})
+		assert.Error(t, err)
+		assert.Nil(t, got)
 	})
 
 	t.Run("nil context", func(t *testing.T) {
 		got, err := srv.Find(nil, &FindInput{
-			User:        userDomain,
-			Keyword:     "",
-			SearchAfter: 0,
+			User:    userDomain,
+			Keyword: "",
 		})
 		assert.Error(t, err)
 		assert.Nil(t, got)
@@ -432,9 +998,27 @@ func TestService_Find(t *testing.T) {
 
 	t.Run("invalid input", func(t *testing.T) {
 		got, err := srv.Find(ctx, &FindInput{
-			User:        nil,
-			Keyword:     "",
-			SearchAfter: 0,
+			User:    nil,
+			Keyword: "",
+		})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		minPrice, maxPrice := 5000, 1000
+		got, err := srv.Find(ctx, &FindInput{
+			User:   userDomain,
+			Filter: domain.ItemFilter{MinPrice: &minPrice, MaxPrice: &maxPrice},
+		})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("invalid sort", func(t *testing.T) {
+		got, err := srv.Find(ctx, &FindInput{
+			User: userDomain,
+			Sort: domain.ItemSortOrder{{Key: "barcode"}},
 		})
 		assert.Error(t, err)
 		assert.Nil(t, got)
@@ -442,14 +1026,13 @@ func TestService_Find(t *testing.T) {
 
 	t.Run("unexpected error", func(t *testing.T) {
 		input := &FindInput{
-			User:        userDomain,
-			Keyword:     "ㄹㄸ",
-			SearchAfter: gofakeit.Number(1, 100),
+			User:    userDomain,
+			Keyword: "ㄹㄸ",
 		}
 		itemRepository.EXPECT().Find(ctx, &repository.FindItemInput{
-			UserID:      userDomain.ID,
-			Keyword:     input.Keyword,
-			SearchAfter: input.SearchAfter,
+			UserID:   userDomain.ID,
+			Keywords: []string{"ㄹ"},
+			Limit:    searchBatchSize,
 		}).Return(nil, gofakeit.Error())
 		got, err := srv.Find(ctx, input)
 		assert.Error(t, err)
@@ -457,6 +1040,379 @@ func TestService_Find(t *testing.T) {
 	})
 }
 
+func TestService_FindTrash(t *testing.T) {
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemRepository := repomocks.NewMockItemRepository(ctrl)
+	srv, err := NewService(testCursorSecret, itemRepository, repomocks.NewMockItemHistoryRepository(ctrl))
+	assert.NoError(t, err)
+	scope := newTestCursorScope(t, true, "", domain.ItemFilter{})
+
+	t.Run("OK", func(t *testing.T) {
+		deletedAt := time.Now()
+		items := make([]domain.Item, 3)
+		for i := range items {
+			items[i] = *newTestItem(t, userDomain.ID)
+			items[i].DeletedAt = &deletedAt
+		}
+		cursor := domain.NewItemCursor(nil, newTestItem(t, userDomain.ID), false)
+		encodedCursor, err := srv.encodeCursor(cursor, scope)
+		require.NoError(t, err)
+		findItemOutput := &repository.FindItemOutput{
+			TotalCount: 10,
+			Items:      items,
+			HasNext:    true,
+			HasPrev:    true,
+		}
+		itemRepository.EXPECT().FindDeleted(ctx, &repository.FindDeletedItemInput{
+			UserID:    userDomain.ID,
+			Cursor:    cursor,
+			WithTotal: true,
+			Limit:     3,
+		}).Return(findItemOutput, nil)
+		got, err := srv.FindTrash(ctx, &FindTrashInput{User: userDomain, Cursor: encodedCursor, WithTotal: true, Limit: 3})
+		assert.NoError(t, err)
+		assert.Equal(t, findItemOutput.Items, got.Items)
+		assert.Equal(t, findItemOutput.TotalCount, got.TotalCount)
+		assert.True(t, got.HasNext)
+		assert.True(t, got.HasPrev)
+
+		nextCursor, _, err := srv.decodeCursor(got.NextCursor)
+		require.NoError(t, err)
+		assert.Equal(t, domain.NewItemCursor(nil, &items[2], false), nextCursor)
+		prevCursor, _, err := srv.decodeCursor(got.PrevCursor)
+		require.NoError(t, err)
+		assert.Equal(t, domain.NewItemCursor(nil, &items[0], true), prevCursor)
+	})
+
+	t.Run("잘못된 커서", func(t *testing.T) {
+		sortedCursor, err := srv.encodeCursor(domain.NewItemCursor(domain.ItemSortOrder{{Key: domain.ItemSortKeyName}}, newTestItem(t, userDomain.ID), false), scope)
+		require.NoError(t, err)
+		// 아이템 목록에서 생성한 커서
+		listCursor, err := srv.encodeCursor(domain.NewItemCursor(nil, newTestItem(t, userDomain.ID), false), newTestCursorScope(t, false, "", domain.ItemFilter{}))
+		require.NoError(t, err)
+		for _, cursor := range []string{"invalid", sortedCursor, listCursor} {
+			got, err := srv.FindTrash(ctx, &FindTrashInput{User: userDomain, Cursor: cursor})
+			assert.ErrorIs(t, err, domain.ErrInvalidItemCursor, cursor)
+			assert.Nil(t, got)
+		}
+	})
+
+	t.Run("nil context", func(t *testing.T) {
+		got, err := srv.FindTrash(nil, &FindTrashInput{User: userDomain})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("nil input", func(t *testing.T) {
+		got, err := srv.FindTrash(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		got, err := srv.FindTrash(ctx, &FindTrashInput{})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("unexpected error", func(t *testing.T) {
+		itemRepository.EXPECT().FindDeleted(ctx, &repository.FindDeletedItemInput{UserID: userDomain.ID}).Return(nil, gofakeit.Error())
+		got, err := srv.FindTrash(ctx, &FindTrashInput{User: userDomain})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+}
+
+func TestService_PurgeTrash(t *testing.T) {
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemRepository := repomocks.NewMockItemRepository(ctrl)
+	srv, err := NewService(testCursorSecret, itemRepository, repomocks.NewMockItemHistoryRepository(ctrl))
+	assert.NoError(t, err)
+
+	t.Run("OK", func(t *testing.T) {
+		retention := 24 * time.Hour
+		now := time.Now()
+		itemRepository.EXPECT().PurgeDeleted(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, deletedBefore time.Time) (int, error) {
+			assert.WithinDuration(t, now.Add(-retention), deletedBefore, time.Minute)
+			return 3, nil
+		})
+		got, err := srv.PurgeTrash(ctx, &PurgeTrashInput{Retention: retention})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, got.PurgedCount)
+	})
+
+	t.Run("nil context", func(t *testing.T) {
+		got, err := srv.PurgeTrash(nil, &PurgeTrashInput{Retention: time.Hour})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("nil input", func(t *testing.T) {
+		got, err := srv.PurgeTrash(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		got, err := srv.PurgeTrash(ctx, &PurgeTrashInput{})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("unexpected error", func(t *testing.T) {
+		itemRepository.EXPECT().PurgeDeleted(ctx, gomock.Any()).Return(0, gofakeit.Error())
+		got, err := srv.PurgeTrash(ctx, &PurgeTrashInput{Retention: time.Hour})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+}
+
+func TestService_History(t *testing.T) {
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemHistoryRepository := repomocks.NewMockItemHistoryRepository(ctrl)
+	srv, err := NewService(testCursorSecret, repomocks.NewMockItemRepository(ctrl), itemHistoryRepository)
+	assert.NoError(t, err)
+
+	t.Run("OK", func(t *testing.T) {
+		itemID := gofakeit.Number(1, 10000)
+		history, err := domain.NewItemHistory(userDomain.ID, itemID, userDomain.ID, gofakeit.UUID(), domain.ItemHistoryActionDelete, nil)
+		require.NoError(t, err)
+		itemHistoryRepository.EXPECT().Find(ctx, &repository.FindItemHistoryInput{
+			UserID:      userDomain.ID,
+			ItemID:      itemID,
+			SearchAfter: 3,
+		}).Return(&repository.FindItemHistoryOutput{
+			Histories:   []domain.ItemHistory{*history},
+			HasNext:     true,
+			SearchAfter: 4,
+		}, nil)
+		got, err := srv.History(ctx, &HistoryInput{User: userDomain, ItemID: itemID, SearchAfter: 3})
+		assert.NoError(t, err)
+		require.NotNil(t, got)
+		assert.Len(t, got.Histories, 1)
+		assert.True(t, got.HasNext)
+		assert.Equal(t, 4, got.SearchAfter)
+	})
+
+	t.Run("nil context", func(t *testing.T) {
+		got, err := srv.History(nil, &HistoryInput{User: userDomain, ItemID: 1})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("nil input", func(t *testing.T) {
+		got, err := srv.History(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		got, err := srv.History(ctx, &HistoryInput{User: userDomain})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("unexpected error", func(t *testing.T) {
+		itemHistoryRepository.EXPECT().Find(ctx, gomock.Any()).Return(nil, gofakeit.Error())
+		got, err := srv.History(ctx, &HistoryInput{User: userDomain, ItemID: 1})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+}
+
+func TestService_Suggest(t *testing.T) {
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemRepository := repomocks.NewMockItemRepository(ctrl)
+	itemHistoryRepository := repomocks.NewMockItemHistoryRepository(ctrl)
+	srv, err := NewService(testCursorSecret, itemRepository, itemHistoryRepository)
+	assert.NoError(t, err)
+
+	items := make([]domain.Item, 0)
+	for _, v := range []struct{ name, category string }{
+		{name: "아메리카노", category: "커피"},
+		{name: "아이스 아메리카노", category: "커피"},
+		{name: "아이스 카페 라떼", category: "커피"},
+		{name: "얼그레이 밀크티", category: "음료"},
+	} {
+		item := newTestItem(t, userDomain.ID)
+		item.Name, item.Category = v.name, v.category
+		items = append(items, *item)
+	}
+	// 다른 서버에서 아이템을 변경하면 stamp가 달라집니다.
+	stamp := repository.ItemStamp{Count: len(items), MaxItemID: len(items), VersionSum: len(items)}
+	var stampErr error
+	itemRepository.EXPECT().GetStamp(ctx, userDomain.ID).DoAndReturn(func(context.Context, int) (*repository.ItemStamp, error) {
+		if stampErr != nil {
+			return nil, stampErr
+		}
+		v := stamp
+		return &v, nil
+	}).AnyTimes()
+	itemRepository.EXPECT().Find(ctx, &repository.FindItemInput{
+		UserID: userDomain.ID,
+		Limit:  searchBatchSize,
+	}).Return(&repository.FindItemOutput{Items: items}, nil)
+
+	t.Run("OK", func(t *testing.T) {
+		tests := []struct {
+			query string
+			limit int
+			want  []domain.ItemSuggestion
+		}{
+			{
+				query: "ㅇㅁ",
+				want: []domain.ItemSuggestion{
+					{Text: "아메리카노", Type: domain.ItemSuggestionTypeName},
+					{Text: "아이스 아메리카노", Type: domain.ItemSuggestionTypeName},
+				},
+			},
+			{
+				query: "아멜",
+				want: []domain.ItemSuggestion{
+					{Text: "아메리카노", Type: domain.ItemSuggestionTypeName},
+					{Text: "아이스 아메리카노", Type: domain.ItemSuggestionTypeName},
+				},
+			},
+			{
+				query: "라떼",
+				want:  []domain.ItemSuggestion{{Text: "아이스 카페 라떼", Type: domain.ItemSuggestionTypeName}},
+			},
+			{
+				query: "ㅋ",
+				want: []domain.ItemSuggestion{
+					{Text: "커피", Type: domain.ItemSuggestionTypeCategory},
+					{Text: "아이스 카페 라떼", Type: domain.ItemSuggestionTypeName},
+				},
+			},
+			{
+				query: "dkdl",
+				limit: 1,
+				want:  []domain.ItemSuggestion{{Text: "아이스 아메리카노", Type: domain.ItemSuggestionTypeName}},
+			},
+			{
+				query: "녹차",
+				want:  []domain.ItemSuggestion{},
+			},
+			{
+				query: " ",
+				want:  []domain.ItemSuggestion{},
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.query, func(t *testing.T) {
+				got, err := srv.Suggest(ctx, &SuggestInput{User: userDomain, Query: tt.query, Limit: tt.limit})
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got.Suggestions)
+			})
+		}
+	})
+
+	t.Run("아이템 생성 후 색인 무효화", func(t *testing.T) {
+		itemRepository.EXPECT().Find(gomock.Any(), gomock.Any()).Return(&repository.FindItemOutput{Items: items}, nil)
+		itemRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, item *domain.Item) error {
+			item.ID = gofakeit.Number(1, 10)
+			return nil
+		})
+		itemHistoryRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+		_, err := srv.Create(ctx, &CreateInput{
+			User:        userDomain,
+			Name:        "녹차 라떼",
+			Description: gofakeit.SentenceSimple(),
+			Price:       gofakeit.Number(1, 10000),
+			Cost:        gofakeit.Number(1, 10000),
+			Category:    "음료",
+			Barcode:     gofakeit.Numerify("################"),
+			Size:        domain.ItemSizeSmall,
+			ExpiryAt:    gofakeit.FutureDate(),
+		})
+		require.NoError(t, err)
+
+		item := newTestItem(t, userDomain.ID)
+		item.Name, item.Category = "녹차 라떼", "음료"
+		itemRepository.EXPECT().Find(ctx, gomock.Any()).Return(&repository.FindItemOutput{Items: append(items, *item)}, nil)
+		got, err := srv.Suggest(ctx, &SuggestInput{User: userDomain, Query: "ㄴㅊ"})
+		require.NoError(t, err)
+		assert.Equal(t, []domain.ItemSuggestion{{Text: "녹차 라떼", Type: domain.ItemSuggestionTypeName}}, got.Suggestions)
+	})
+
+	t.Run("다른 서버에서 변경한 아이템", func(t *testing.T) {
+		item := newTestItem(t, userDomain.ID)
+		item.Name, item.Category = "녹차 빙수", "디저트"
+		stamp.Count++
+		stamp.MaxItemID++
+		stamp.VersionSum++
+		itemRepository.EXPECT().Find(ctx, gomock.Any()).Return(&repository.FindItemOutput{Items: append(items, *item)}, nil)
+		got, err := srv.Suggest(ctx, &SuggestInput{User: userDomain, Query: "ㄴㅊ ㅂ"})
+		require.NoError(t, err)
+		assert.Equal(t, []domain.ItemSuggestion{{Text: "녹차 빙수", Type: domain.ItemSuggestionTypeName}}, got.Suggestions)
+
+		// stamp가 같으면 저장소에서 아이템을 다시 조회하지 않습니다.
+		got, err = srv.Suggest(ctx, &SuggestInput{User: userDomain, Query: "ㄷㅈ"})
+		require.NoError(t, err)
+		assert.Equal(t, []domain.ItemSuggestion{{Text: "디저트", Type: domain.ItemSuggestionTypeCategory}}, got.Suggestions)
+	})
+
+	t.Run("nil context", func(t *testing.T) {
+		got, err := srv.Suggest(nil, &SuggestInput{User: userDomain, Query: "ㅇ"})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("nil input", func(t *testing.T) {
+		got, err := srv.Suggest(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		got, err := srv.Suggest(ctx, &SuggestInput{User: userDomain, Query: "ㅇ", Limit: MaxSuggestLimit + 1})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("unexpected error", func(t *testing.T) {
+		srv, err := NewService(testCursorSecret, itemRepository, itemHistoryRepository)
+		require.NoError(t, err)
+		itemRepository.EXPECT().Find(ctx, gomock.Any()).Return(nil, gofakeit.Error())
+		got, err := srv.Suggest(ctx, &SuggestInput{User: userDomain, Query: "ㅇ"})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+
+		stampErr = gofakeit.Error()
+		got, err = srv.Suggest(ctx, &SuggestInput{User: userDomain, Query: "ㅇ"})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+}
+
+func Test_changedFields(t *testing.T) {
+	item := newTestItem(t, userDomain.ID)
+	samePrice := item.Price
+	newCost := item.Cost + 1
+	sameExpiryAt := item.ExpiryAt.In(time.FixedZone("KST", 9*60*60))
+	changes := changedFields(item, &repository.UpdateItemInput{
+		Price:    &samePrice,
+		Cost:     &newCost,
+		ExpiryAt: &sameExpiryAt,
+	})
+	require.Len(t, changes, 1)
+	assert.Equal(t, "cost", changes[0].Field)
+	assert.Equal(t, strconv.Itoa(item.Cost), *changes[0].OldValue)
+	assert.Equal(t, strconv.Itoa(newCost), *changes[0].NewValue)
+}
+
 func newTestItem(t *testing.T, userID int) *domain.Item {
 	item := &domain.Item{
 		ID:          gofakeit.Number(1, 10000),
@@ -470,9 +1426,17 @@ func newTestItem(t *testing.T, userID int) *domain.Item {
 		ExpiryAt:    gofakeit.FutureDate(),
 		Size:        domain.ItemSizeSmall,
 		CreatedAt:   time.Now(),
+		Version:     1,
 	}
 	err := item.Validate()
 	assert.NoError(t, err)
 
 	return item
 }
+
+func newTestCursorScope(t *testing.T, trash bool, keyword string, filter domain.ItemFilter) string {
+	scope, err := cursorScope(trash, keyword, filter)
+	require.NoError(t, err)
+
+	return scope
+}
@@ -0,0 +1,306 @@
+package item
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/internal/hangul"
+	"github.com/psi59/payhere-assignment/internal/valid"
+	"github.com/psi59/payhere-assignment/repository"
+)
+
+const (
+	// maxSuggestIndexes 색인을 보관하는 최대 유저 수입니다.
+	maxSuggestIndexes = 10000
+	// DefaultSuggestLimit, MaxSuggestLimit 자동 완성 결과의 기본 개수와 최대 개수입니다.
+	DefaultSuggestLimit = 10
+	MaxSuggestLimit     = 20
+)
+
+func (s *Service) Suggest(c context.Context, input *SuggestInput) (*SuggestOutput, error) {
+	// 1. 파라메터 체크
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case valid.IsNil(input):
+		return nil, domain.ErrNilInput
+	}
+	if err := input.Validate(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	query := strings.ToLower(strings.TrimSpace(input.Query))
+	if len(query) == 0 {
+		return &SuggestOutput{Suggestions: make([]domain.ItemSuggestion, 0)}, nil
+	}
+
+	// 2. 색인 조회, 없거나 다른 서버에서 아이템이 변경되었으면 유저의 아이템으로 색인을 만듭니다.
+	userID := input.User.ID
+	stamp, err := s.itemRepository.GetStamp(c, userID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	index, generation := s.suggestIndexes.load(userID, *stamp)
+	if index == nil {
+		items, err := s.findAll(c, repository.FindItemInput{UserID: userID})
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		index, err = newSuggestIndex(items, *stamp)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		s.suggestIndexes.store(userID, generation, index)
+	}
+
+	// 3. 결과 반환
+	limit := input.Limit
+	if limit == 0 {
+		limit = DefaultSuggestLimit
+	}
+	suggestions, err := index.lookup(query, limit)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &SuggestOutput{Suggestions: suggestions}, nil
+}
+
+// suggestEntry 자동 완성 후보이며, count는 같은 이름 또는 카테고리를 가진 아이템 수입니다.
+type suggestEntry struct {
+	suggestion domain.ItemSuggestion
+	count      int
+}
+
+// suggestKey 후보의 단어 시작 위치부터 만든 색인 키이며, offset이 0이면 후보의 처음부터 만든 키입니다.
+type suggestKey struct {
+	key    string
+	entry  int
+	offset int
+}
+
+// suggestIndex 유저의 아이템 이름과 카테고리로 만든 접두사 색인입니다.
+type suggestIndex struct {
+	entries []suggestEntry
+	// jamo, chosung 자모와 초성으로 만든 키이며, 키 순으로 정렬되어 있습니다.
+	jamo    []suggestKey
+	chosung []suggestKey
+	// stamp 색인을 만들기 전에 조회한 값이며, 저장소의 값과 다르면 색인을 다시 만듭니다.
+	stamp repository.ItemStamp
+}
+
+func newSuggestIndex(items []domain.Item, stamp repository.ItemStamp) (*suggestIndex, error) {
+	index := &suggestIndex{stamp: stamp}
+	positions := make(map[domain.ItemSuggestion]int)
+	add := func(suggestion domain.ItemSuggestion) error {
+		if i, ok := positions[suggestion]; ok {
+			index.entries[i].count++
+			return nil
+		}
+		entry := len(index.entries)
+		positions[suggestion] = entry
+		index.entries = append(index.entries, suggestEntry{suggestion: suggestion, count: 1})
+
+		// 단어마다 키를 만들어 중간 단어로 입력해도 찾을 수 있도록 합니다. ("라떼" → 아이스 카페 라떼)
+		runes := []rune(strings.ToLower(suggestion.Text))
+		for offset := range runes {
+			if runes[offset] == ' ' || (offset > 0 && runes[offset-1] != ' ') {
+				continue
+			}
+			word := string(runes[offset:])
+			jamo, err := hangul.Disassemble(word)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			chosung, err := hangul.GetChosung(word)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			index.jamo = append(index.jamo, suggestKey{key: string(jamo.Runes), entry: entry, offset: offset})
+			index.chosung = append(index.chosung, suggestKey{key: chosung, entry: entry, offset: offset})
+		}
+
+		return nil
+	}
+	for _, item := range items {
+		if err := add(domain.ItemSuggestion{Text: item.Name, Type: domain.ItemSuggestionTypeName}); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if err := add(domain.ItemSuggestion{Text: item.Category, Type: domain.ItemSuggestionTypeCategory}); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+
+	compare := func(a, b suggestKey) int {
+		return strings.Compare(a.key, b.key)
+	}
+	slices.SortFunc(index.jamo, compare)
+	slices.SortFunc(index.chosung, compare)
+
+	return index, nil
+}
+
+// lookup 소문자로 변환한 검색어 query로 시작하는 후보를 최대 limit개 반환합니다.
+// 처음부터 일치한 후보, 아이템 수가 많은 후보, 이름, 카테고리 순으로 정렬합니다.
+func (i *suggestIndex) lookup(query string, limit int) ([]domain.ItemSuggestion, error) {
+	jamo, err := hangul.Disassemble(query)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// 후보별로 일치한 가장 앞의 위치
+	offsets := make(map[int]int)
+	scan := func(keys []suggestKey, prefix string) {
+		start := sort.Search(len(keys), func(n int) bool {
+			return keys[n].key >= prefix
+		})
+		for _, key := range keys[start:] {
+			if !strings.HasPrefix(key.key, prefix) {
+				break
+			}
+			if offset, ok := offsets[key.entry]; !ok || key.offset < offset {
+				offsets[key.entry] = key.offset
+			}
+		}
+	}
+	scan(i.jamo, string(jamo.Runes))
+	// 영문 자판으로 입력한 한글도 찾습니다. ("dkaptl" → 아메리카노)
+	if layout, ok := hangul.FromQwerty(query); ok {
+		scan(i.jamo, string(layout))
+	}
+	// 자음만 입력한 경우 초성으로도 찾습니다. ("ㅇㅁ" → 아메리카노)
+	if isChosungQuery(query) {
+		scan(i.chosung, query)
+	}
+
+	entries := make([]int, 0, len(offsets))
+	for entry := range offsets {
+		entries = append(entries, entry)
+	}
+	slices.SortFunc(entries, func(a, b int) int {
+		ea, eb := i.entries[a], i.entries[b]
+		if pa, pb := offsets[a] == 0, offsets[b] == 0; pa != pb {
+			if pa {
+				return -1
+			}
+			return 1
+		}
+		if ea.count != eb.count {
+			return eb.count - ea.count
+		}
+		if c := strings.Compare(ea.suggestion.Text, eb.suggestion.Text); c != 0 {
+			return c
+		}
+		// 이름이 카테고리보다 앞에 오도록 정렬합니다.
+		return -strings.Compare(string(ea.suggestion.Type), string(eb.suggestion.Type))
+	})
+
+	suggestions := make([]domain.ItemSuggestion, 0, min(limit, len(entries)))
+	for _, entry := range entries[:min(limit, len(entries))] {
+		suggestions = append(suggestions, i.entries[entry].suggestion)
+	}
+
+	return suggestions, nil
+}
+
+// isChosungQuery 검색어가 공백과 한글 자음으로만 구성되어 있는지 확인합니다.
+func isChosungQuery(query string) bool {
+	for _, r := range query {
+		if r != ' ' && !hangul.IsConsonant(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// suggestIndexCache 유저별 자동 완성 색인이며, 아이템이 변경되면 해당 유저의 색인을 무효화합니다.
+type suggestIndexCache struct {
+	mu      sync.Mutex
+	entries map[int]*suggestCacheEntry
+}
+
+type suggestCacheEntry struct {
+	index *suggestIndex
+	// generation 무효화할 때마다 증가하며, 색인을 만드는 동안 무효화된 경우 만든 색인을 저장하지 않습니다.
+	generation uint64
+}
+
+func newSuggestIndexCache() *suggestIndexCache {
+	return &suggestIndexCache{entries: make(map[int]*suggestCacheEntry)}
+}
+
+// load 유효한 색인과 현재 generation을 반환하며, 유효한 색인이 없으면 nil을 반환합니다.
+// 다른 서버에서 아이템이 변경되어 색인의 stamp가 저장소의 stamp와 다른 경우에도 nil을 반환합니다.
+func (c *suggestIndexCache) load(userID int, stamp repository.ItemStamp) (*suggestIndex, uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[userID]
+	if !ok {
+		return nil, 0
+	}
+	if entry.index == nil || entry.index.stamp != stamp {
+		return nil, entry.generation
+	}
+
+	return entry.index, entry.generation
+}
+
+// store load에서 받은 generation 이후로 무효화되지 않은 경우에만 색인을 저장합니다.
+func (c *suggestIndexCache) store(userID int, generation uint64, index *suggestIndex) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[userID]
+	if !ok {
+		if generation != 0 {
+			return
+		}
+		c.evict()
+		entry = &suggestCacheEntry{}
+		c.entries[userID] = entry
+	}
+	if entry.generation != generation {
+		return
+	}
+	entry.index = index
+}
+
+func (c *suggestIndexCache) invalidate(userID int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[userID]
+	if !ok {
+		c.evict()
+		entry = &suggestCacheEntry{}
+		c.entries[userID] = entry
+	}
+	entry.index = nil
+	entry.generation++
+}
+
+// evict 보관한 유저 수가 maxSuggestIndexes 이상이면 무효화된 색인을 먼저 지우고, 그래도 많으면 임의의 색인을 지웁니다.
+func (c *suggestIndexCache) evict() {
+	if len(c.entries) < maxSuggestIndexes {
+		return
+	}
+	for userID, entry := range c.entries {
+		if entry.index == nil {
+			delete(c.entries, userID)
+		}
+	}
+	for userID := range c.entries {
+		if len(c.entries) < maxSuggestIndexes {
+			return
+		}
+		delete(c.entries, userID)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/pkg/errors"
+
 	"github.com/psi59/payhere-assignment/domain"
 
 	"github.com/psi59/payhere-assignment/internal/valid"
@@ -15,6 +17,9 @@ type Usecase interface {
 	Create(c context.Context, input *CreateInput) (*CreateOutput, error)
 	Get(c context.Context, input *GetInput) (*GetOutput, error)
 	GetByPhoneNumber(c context.Context, input *GetByPhoneNumberInput) (*GetOutput, error)
+	UpdatePreferences(c context.Context, input *UpdatePreferencesInput) (*UpdatePreferencesOutput, error)
+	ChangePassword(c context.Context, input *ChangePasswordInput) (*ChangePasswordOutput, error)
+	InvalidateTokens(c context.Context, input *InvalidateTokensInput) (*InvalidateTokensOutput, error)
 }
 
 type CreateInput struct {
@@ -57,3 +62,57 @@ func (i *GetByPhoneNumberInput) Validate() error {
 
 	return nil
 }
+
+type UpdatePreferencesInput struct {
+	User                *domain.User `validate:"required"`
+	DuplicateItemPolicy *domain.DuplicateItemPolicy
+}
+
+func (i *UpdatePreferencesInput) Validate() error {
+	if err := valid.ValidateStruct(i); err != nil {
+		return errors.WithStack(err)
+	}
+	if valid.IsNil(i.DuplicateItemPolicy) {
+		return fmt.Errorf("invalid input")
+	}
+	if err := i.DuplicateItemPolicy.Validate(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+type UpdatePreferencesOutput struct {
+	User *domain.User
+}
+
+type ChangePasswordInput struct {
+	User            *domain.User `validate:"required"`
+	CurrentPassword string       `validate:"required"`
+	NewPassword     string
+}
+
+func (i *ChangePasswordInput) Validate() error {
+	if err := valid.ValidateStruct(i); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := valid.ValidatePassword(i.NewPassword); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+type ChangePasswordOutput struct {
+	User *domain.User
+}
+
+type InvalidateTokensInput struct {
+	User *domain.User `validate:"required"`
+}
+
+type InvalidateTokensOutput struct {
+	User *domain.User
+	// RevokedSessionCount 폐기된 세션 수입니다.
+	RevokedSessionCount int
+}
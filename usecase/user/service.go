@@ -2,26 +2,42 @@ package user
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
 
 	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/internal/db"
 	"github.com/psi59/payhere-assignment/internal/valid"
 	"github.com/psi59/payhere-assignment/repository"
 )
 
 type Service struct {
-	userRepository repository.UserRepository
+	userRepository         repository.UserRepository
+	refreshTokenRepository repository.RefreshTokenRepository
+	sessionRepository      repository.SessionRepository
 }
 
-func NewService(userRepository repository.UserRepository) (*Service, error) {
+func NewService(
+	userRepository repository.UserRepository,
+	refreshTokenRepository repository.RefreshTokenRepository,
+	sessionRepository repository.SessionRepository,
+) (*Service, error) {
 	if valid.IsNil(userRepository) {
 		return nil, repository.ErrNilUserRepository
 	}
+	if valid.IsNil(refreshTokenRepository) {
+		return nil, repository.ErrNilRefreshTokenRepository
+	}
+	if valid.IsNil(sessionRepository) {
+		return nil, repository.ErrNilSessionRepository
+	}
 
 	return &Service{
-		userRepository: userRepository,
+		userRepository:         userRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		sessionRepository:      sessionRepository,
 	}, nil
 }
 
@@ -82,3 +98,127 @@ func (s *Service) GetByPhoneNumber(c context.Context, input *GetByPhoneNumberInp
 
 	return &GetOutput{User: user}, nil
 }
+
+func (s *Service) UpdatePreferences(c context.Context, input *UpdatePreferencesInput) (*UpdatePreferencesOutput, error) {
+	// 1. 파라메터 체크
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case valid.IsNil(input):
+		return nil, domain.ErrNilInput
+	}
+	if err := input.Validate(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// 2. 설정 수정
+	if err := s.userRepository.Update(c, input.User.ID, &repository.UpdateUserInput{
+		DuplicateItemPolicy: input.DuplicateItemPolicy,
+	}); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// 3. 결과 반환
+	user := *input.User
+	user.DuplicateItemPolicy = *input.DuplicateItemPolicy
+
+	return &UpdatePreferencesOutput{User: &user}, nil
+}
+
+// ChangePassword 비밀번호를 변경하고 변경 전에 발급된 토큰을 모두 무효화하며, 세션과 리프레시 토큰을 폐기합니다.
+func (s *Service) ChangePassword(c context.Context, input *ChangePasswordInput) (*ChangePasswordOutput, error) {
+	// 1. 파라메터 체크
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case valid.IsNil(input):
+		return nil, domain.ErrNilInput
+	}
+	if err := input.Validate(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// 2. 현재 비밀번호 확인
+	if err := input.User.ComparePassword(input.CurrentPassword); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// 3. 비밀번호 변경 및 토큰 무효화
+	hashed, err := domain.HashPassword(input.NewPassword)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	tokensValidAfter := tokensValidAfterNow()
+	if _, err := s.invalidateTokens(c, input.User.ID, &repository.UpdateUserInput{
+		Password:         &hashed,
+		TokensValidAfter: &tokensValidAfter,
+	}); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// 4. 결과 반환
+	user := *input.User
+	user.Password = hashed
+	user.TokensValidAfter = &tokensValidAfter
+
+	return &ChangePasswordOutput{User: &user}, nil
+}
+
+// InvalidateTokens 지금까지 발급된 유저의 토큰을 모두 무효화하고, 세션과 리프레시 토큰을 폐기합니다.
+func (s *Service) InvalidateTokens(c context.Context, input *InvalidateTokensInput) (*InvalidateTokensOutput, error) {
+	// 1. 파라메터 체크
+	switch {
+	case valid.IsNil(c):
+		return nil, domain.ErrNilContext
+	case valid.IsNil(input):
+		return nil, domain.ErrNilInput
+	}
+	if err := valid.ValidateStruct(input); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// 2. 토큰 무효화 시각 갱신
+	tokensValidAfter := tokensValidAfterNow()
+	revokedCount, err := s.invalidateTokens(c, input.User.ID, &repository.UpdateUserInput{
+		TokensValidAfter: &tokensValidAfter,
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// 3. 결과 반환
+	user := *input.User
+	user.TokensValidAfter = &tokensValidAfter
+
+	return &InvalidateTokensOutput{User: &user, RevokedSessionCount: revokedCount}, nil
+}
+
+// invalidateTokens 유저의 토큰 무효화 시각을 갱신하고 세션과 리프레시 토큰을 폐기하며, 폐기된 세션 수를 반환합니다.
+// 일부만 반영되면 폐기되지 않은 리프레시 토큰으로 새 토큰을 발급받을 수 있으므로 하나의 트랜잭션으로 처리합니다.
+func (s *Service) invalidateTokens(c context.Context, userID int, input *repository.UpdateUserInput) (int, error) {
+	identifier := strconv.Itoa(userID)
+	now := time.Now()
+	var revokedCount int
+	if err := db.Transaction(c, func(c context.Context) error {
+		if err := s.userRepository.Update(c, userID, input); err != nil {
+			return errors.WithStack(err)
+		}
+		if err := s.refreshTokenRepository.RevokeAll(c, identifier, now); err != nil {
+			return errors.WithStack(err)
+		}
+		var err error
+		revokedCount, err = s.sessionRepository.RevokeAll(c, identifier, now)
+
+		return errors.WithStack(err)
+	}); err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return revokedCount, nil
+}
+
+// tokensValidAfterNow 토큰 무효화 시각을 반환합니다.
+// 토큰의 iat와 같은 domain.TokenTimePrecision 단위로 내림하므로, 무효화 직후 같은 초에 다시 로그인해 발급한 토큰은 거부되지 않습니다.
+func tokensValidAfterNow() time.Time {
+	return time.Now().UTC().Truncate(domain.TokenTimePrecision)
+}
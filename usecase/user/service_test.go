@@ -2,12 +2,16 @@ package user
 
 import (
 	"context"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/psi59/payhere-assignment/domain"
+	"github.com/psi59/payhere-assignment/internal/db"
 	"github.com/psi59/payhere-assignment/internal/mocks/repomocks"
+	"github.com/psi59/payhere-assignment/repository"
+	"github.com/psi59/payhere-assignment/repository/memory"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
@@ -20,7 +24,7 @@ func TestService_Create(t *testing.T) {
 		defer ctrl.Finish()
 
 		userRepo := repomocks.NewMockUserRepository(ctrl)
-		srv, err := NewService(userRepo)
+		srv, err := NewService(userRepo, repomocks.NewMockRefreshTokenRepository(ctrl), repomocks.NewMockSessionRepository(ctrl))
 		require.NoError(t, err)
 
 		userRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, user *domain.User) error {
@@ -42,7 +46,7 @@ func TestService_Create(t *testing.T) {
 		defer ctrl.Finish()
 
 		userRepo := repomocks.NewMockUserRepository(ctrl)
-		srv, err := NewService(userRepo)
+		srv, err := NewService(userRepo, repomocks.NewMockRefreshTokenRepository(ctrl), repomocks.NewMockSessionRepository(ctrl))
 		require.NoError(t, err)
 
 		input := &CreateInput{
@@ -59,7 +63,7 @@ func TestService_Create(t *testing.T) {
 		defer ctrl.Finish()
 
 		userRepo := repomocks.NewMockUserRepository(ctrl)
-		srv, err := NewService(userRepo)
+		srv, err := NewService(userRepo, repomocks.NewMockRefreshTokenRepository(ctrl), repomocks.NewMockSessionRepository(ctrl))
 		require.NoError(t, err)
 
 		input := &CreateInput{
@@ -76,7 +80,7 @@ func TestService_Create(t *testing.T) {
 		defer ctrl.Finish()
 
 		userRepo := repomocks.NewMockUserRepository(ctrl)
-		srv, err := NewService(userRepo)
+		srv, err := NewService(userRepo, repomocks.NewMockRefreshTokenRepository(ctrl), repomocks.NewMockSessionRepository(ctrl))
 		require.NoError(t, err)
 
 		input := &CreateInput{
@@ -93,7 +97,7 @@ func TestService_Create(t *testing.T) {
 		defer ctrl.Finish()
 
 		userRepo := repomocks.NewMockUserRepository(ctrl)
-		srv, err := NewService(userRepo)
+		srv, err := NewService(userRepo, repomocks.NewMockRefreshTokenRepository(ctrl), repomocks.NewMockSessionRepository(ctrl))
 		require.NoError(t, err)
 
 		userRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, user *domain.User) error {
@@ -117,7 +121,7 @@ func TestService_Get(t *testing.T) {
 		defer ctrl.Finish()
 
 		userRepo := repomocks.NewMockUserRepository(ctrl)
-		srv, err := NewService(userRepo)
+		srv, err := NewService(userRepo, repomocks.NewMockRefreshTokenRepository(ctrl), repomocks.NewMockSessionRepository(ctrl))
 		require.NoError(t, err)
 
 		user, err := domain.NewUser(
@@ -143,7 +147,7 @@ func TestService_Get(t *testing.T) {
 		defer ctrl.Finish()
 
 		userRepo := repomocks.NewMockUserRepository(ctrl)
-		srv, err := NewService(userRepo)
+		srv, err := NewService(userRepo, repomocks.NewMockRefreshTokenRepository(ctrl), repomocks.NewMockSessionRepository(ctrl))
 		require.NoError(t, err)
 
 		user, err := domain.NewUser(
@@ -165,7 +169,7 @@ func TestService_Get(t *testing.T) {
 		defer ctrl.Finish()
 
 		userRepo := repomocks.NewMockUserRepository(ctrl)
-		srv, err := NewService(userRepo)
+		srv, err := NewService(userRepo, repomocks.NewMockRefreshTokenRepository(ctrl), repomocks.NewMockSessionRepository(ctrl))
 		require.NoError(t, err)
 
 		got, err := srv.Get(ctx, nil)
@@ -178,7 +182,7 @@ func TestService_Get(t *testing.T) {
 		defer ctrl.Finish()
 
 		userRepo := repomocks.NewMockUserRepository(ctrl)
-		srv, err := NewService(userRepo)
+		srv, err := NewService(userRepo, repomocks.NewMockRefreshTokenRepository(ctrl), repomocks.NewMockSessionRepository(ctrl))
 		require.NoError(t, err)
 
 		got, err := srv.Get(ctx, &GetInput{
@@ -193,7 +197,7 @@ func TestService_Get(t *testing.T) {
 		defer ctrl.Finish()
 
 		userRepo := repomocks.NewMockUserRepository(ctrl)
-		srv, err := NewService(userRepo)
+		srv, err := NewService(userRepo, repomocks.NewMockRefreshTokenRepository(ctrl), repomocks.NewMockSessionRepository(ctrl))
 		require.NoError(t, err)
 
 		user, err := domain.NewUser(
@@ -220,7 +224,7 @@ func TestService_GetByPhoneNumber(t *testing.T) {
 		defer ctrl.Finish()
 
 		userRepo := repomocks.NewMockUserRepository(ctrl)
-		srv, err := NewService(userRepo)
+		srv, err := NewService(userRepo, repomocks.NewMockRefreshTokenRepository(ctrl), repomocks.NewMockSessionRepository(ctrl))
 		require.NoError(t, err)
 
 		user, err := domain.NewUser(
@@ -245,7 +249,7 @@ func TestService_GetByPhoneNumber(t *testing.T) {
 		defer ctrl.Finish()
 
 		userRepo := repomocks.NewMockUserRepository(ctrl)
-		srv, err := NewService(userRepo)
+		srv, err := NewService(userRepo, repomocks.NewMockRefreshTokenRepository(ctrl), repomocks.NewMockSessionRepository(ctrl))
 		require.NoError(t, err)
 
 		user, err := domain.NewUser(
@@ -266,7 +270,7 @@ func TestService_GetByPhoneNumber(t *testing.T) {
 		defer ctrl.Finish()
 
 		userRepo := repomocks.NewMockUserRepository(ctrl)
-		srv, err := NewService(userRepo)
+		srv, err := NewService(userRepo, repomocks.NewMockRefreshTokenRepository(ctrl), repomocks.NewMockSessionRepository(ctrl))
 		require.NoError(t, err)
 
 		got, err := srv.GetByPhoneNumber(ctx, nil)
@@ -279,7 +283,7 @@ func TestService_GetByPhoneNumber(t *testing.T) {
 		defer ctrl.Finish()
 
 		userRepo := repomocks.NewMockUserRepository(ctrl)
-		srv, err := NewService(userRepo)
+		srv, err := NewService(userRepo, repomocks.NewMockRefreshTokenRepository(ctrl), repomocks.NewMockSessionRepository(ctrl))
 		require.NoError(t, err)
 
 		got, err := srv.GetByPhoneNumber(ctx, &GetByPhoneNumberInput{
@@ -294,7 +298,7 @@ func TestService_GetByPhoneNumber(t *testing.T) {
 		defer ctrl.Finish()
 
 		userRepo := repomocks.NewMockUserRepository(ctrl)
-		srv, err := NewService(userRepo)
+		srv, err := NewService(userRepo, repomocks.NewMockRefreshTokenRepository(ctrl), repomocks.NewMockSessionRepository(ctrl))
 		require.NoError(t, err)
 
 		user, err := domain.NewUser(
@@ -313,3 +317,334 @@ func TestService_GetByPhoneNumber(t *testing.T) {
 		require.Nil(t, got)
 	})
 }
+
+func TestService_UpdatePreferences(t *testing.T) {
+	ctx := context.TODO()
+	user, err := domain.NewUser(
+		gofakeit.Regex(`^01\d{8,9}$`),
+		gofakeit.Password(true, true, true, true, true, 10),
+		time.Unix(time.Now().Unix(), 0).UTC(),
+	)
+	require.NoError(t, err)
+	user.ID = gofakeit.Number(1, 100)
+	policy := domain.DuplicateItemPolicyBlock
+
+	t.Run("OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := repomocks.NewMockUserRepository(ctrl)
+		srv, err := NewService(userRepo, repomocks.NewMockRefreshTokenRepository(ctrl), repomocks.NewMockSessionRepository(ctrl))
+		require.NoError(t, err)
+
+		userRepo.EXPECT().Update(ctx, user.ID, &repository.UpdateUserInput{DuplicateItemPolicy: &policy}).Return(nil)
+		got, err := srv.UpdatePreferences(ctx, &UpdatePreferencesInput{
+			User:                user,
+			DuplicateItemPolicy: &policy,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		require.Equal(t, domain.DuplicateItemPolicyBlock, got.User.DuplicateItemPolicy)
+		require.Equal(t, domain.DuplicateItemPolicyWarn, user.DuplicateItemPolicy)
+	})
+
+	t.Run("nil Context", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := repomocks.NewMockUserRepository(ctrl)
+		srv, err := NewService(userRepo, repomocks.NewMockRefreshTokenRepository(ctrl), repomocks.NewMockSessionRepository(ctrl))
+		require.NoError(t, err)
+
+		got, err := srv.UpdatePreferences(nil, &UpdatePreferencesInput{
+			User:                user,
+			DuplicateItemPolicy: &policy,
+		})
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+
+	t.Run("nil input", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := repomocks.NewMockUserRepository(ctrl)
+		srv, err := NewService(userRepo, repomocks.NewMockRefreshTokenRepository(ctrl), repomocks.NewMockSessionRepository(ctrl))
+		require.NoError(t, err)
+
+		got, err := srv.UpdatePreferences(ctx, nil)
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := repomocks.NewMockUserRepository(ctrl)
+		srv, err := NewService(userRepo, repomocks.NewMockRefreshTokenRepository(ctrl), repomocks.NewMockSessionRepository(ctrl))
+		require.NoError(t, err)
+
+		invalid := domain.DuplicateItemPolicy("ignore")
+		for _, input := range []*UpdatePreferencesInput{
+			{DuplicateItemPolicy: &policy},
+			{User: user},
+			{User: user, DuplicateItemPolicy: &invalid},
+		} {
+			got, err := srv.UpdatePreferences(ctx, input)
+			require.Error(t, err)
+			require.Nil(t, got)
+		}
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := repomocks.NewMockUserRepository(ctrl)
+		srv, err := NewService(userRepo, repomocks.NewMockRefreshTokenRepository(ctrl), repomocks.NewMockSessionRepository(ctrl))
+		require.NoError(t, err)
+
+		userRepo.EXPECT().Update(ctx, user.ID, gomock.Any()).Return(domain.ErrUserNotFound)
+		got, err := srv.UpdatePreferences(ctx, &UpdatePreferencesInput{
+			User:                user,
+			DuplicateItemPolicy: &policy,
+		})
+		require.ErrorIs(t, err, domain.ErrUserNotFound)
+		require.Nil(t, got)
+	})
+}
+
+func TestService_ChangePassword(t *testing.T) {
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
+	password := gofakeit.Password(true, true, true, true, true, 10)
+	newPassword := gofakeit.Password(true, true, true, true, true, 12)
+	user, err := domain.NewUser(
+		gofakeit.Regex(`^01\d{8,9}$`),
+		password,
+		time.Unix(time.Now().Unix(), 0).UTC(),
+	)
+	require.NoError(t, err)
+	user.ID = gofakeit.Number(1, 100)
+
+	t.Run("OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := repomocks.NewMockUserRepository(ctrl)
+		refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+		sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+		srv, err := NewService(userRepo, refreshTokenRepo, sessionRepo)
+		require.NoError(t, err)
+
+		before := time.Now()
+		var updateInput *repository.UpdateUserInput
+		gomock.InOrder(
+			userRepo.EXPECT().Update(gomock.Any(), user.ID, gomock.Any()).DoAndReturn(func(_ context.Context, _ int, input *repository.UpdateUserInput) error {
+				updateInput = input
+				return nil
+			}),
+			refreshTokenRepo.EXPECT().RevokeAll(gomock.Any(), strconv.Itoa(user.ID), gomock.Any()).Return(nil),
+			sessionRepo.EXPECT().RevokeAll(gomock.Any(), strconv.Itoa(user.ID), gomock.Any()).Return(1, nil),
+		)
+		got, err := srv.ChangePassword(ctx, &ChangePasswordInput{
+			User:            user,
+			CurrentPassword: password,
+			NewPassword:     newPassword,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		require.NoError(t, got.User.ComparePassword(newPassword))
+		require.Equal(t, got.User.Password, *updateInput.Password)
+		require.Equal(t, got.User.TokensValidAfter, updateInput.TokensValidAfter)
+		require.Nil(t, updateInput.DuplicateItemPolicy)
+
+		// 변경 전에 발급된 토큰은 무효화되고, 변경 직후 같은 초에 다시 로그인해 발급한 토큰은 거부되지 않습니다.
+		require.True(t, got.User.IsTokenInvalidated(before.Truncate(domain.TokenTimePrecision)))
+		require.False(t, got.User.IsTokenInvalidated(time.Now().Truncate(domain.TokenTimePrecision)))
+		require.Nil(t, user.TokensValidAfter)
+	})
+
+	t.Run("password mismatch", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := repomocks.NewMockUserRepository(ctrl)
+		refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+		sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+		srv, err := NewService(userRepo, refreshTokenRepo, sessionRepo)
+		require.NoError(t, err)
+
+		got, err := srv.ChangePassword(ctx, &ChangePasswordInput{
+			User:            user,
+			CurrentPassword: newPassword,
+			NewPassword:     newPassword,
+		})
+		require.ErrorIs(t, err, domain.ErrPasswordMismatch)
+		require.Nil(t, got)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := repomocks.NewMockUserRepository(ctrl)
+		refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+		sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+		srv, err := NewService(userRepo, refreshTokenRepo, sessionRepo)
+		require.NoError(t, err)
+
+		got, err := srv.ChangePassword(nil, &ChangePasswordInput{User: user, CurrentPassword: password, NewPassword: newPassword})
+		require.Error(t, err)
+		require.Nil(t, got)
+
+		for _, input := range []*ChangePasswordInput{
+			nil,
+			{CurrentPassword: password, NewPassword: newPassword},
+			{User: user, NewPassword: newPassword},
+			{User: user, CurrentPassword: password, NewPassword: "short"},
+		} {
+			got, err := srv.ChangePassword(ctx, input)
+			require.Error(t, err)
+			require.Nil(t, got)
+		}
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := repomocks.NewMockUserRepository(ctrl)
+		refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+		sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+		srv, err := NewService(userRepo, refreshTokenRepo, sessionRepo)
+		require.NoError(t, err)
+
+		userRepo.EXPECT().Update(gomock.Any(), user.ID, gomock.Any()).Return(domain.ErrUserNotFound)
+		got, err := srv.ChangePassword(ctx, &ChangePasswordInput{
+			User:            user,
+			CurrentPassword: password,
+			NewPassword:     newPassword,
+		})
+		require.ErrorIs(t, err, domain.ErrUserNotFound)
+		require.Nil(t, got)
+	})
+
+	t.Run("리프레시 토큰 폐기 실패", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := repomocks.NewMockUserRepository(ctrl)
+		refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+		sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+		srv, err := NewService(userRepo, refreshTokenRepo, sessionRepo)
+		require.NoError(t, err)
+
+		userRepo.EXPECT().Update(gomock.Any(), user.ID, gomock.Any()).Return(nil)
+		refreshTokenRepo.EXPECT().RevokeAll(gomock.Any(), strconv.Itoa(user.ID), gomock.Any()).Return(gofakeit.Error())
+		got, err := srv.ChangePassword(ctx, &ChangePasswordInput{
+			User:            user,
+			CurrentPassword: password,
+			NewPassword:     newPassword,
+		})
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+}
+
+func TestService_InvalidateTokens(t *testing.T) {
+	ctx := db.ContextWithTransactor(context.TODO(), memory.NewTransactor(memory.NewDB()))
+	user, err := domain.NewUser(
+		gofakeit.Regex(`^01\d{8,9}$`),
+		gofakeit.Password(true, true, true, true, true, 10),
+		time.Unix(time.Now().Unix(), 0).UTC(),
+	)
+	require.NoError(t, err)
+	user.ID = gofakeit.Number(1, 100)
+
+	t.Run("OK", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := repomocks.NewMockUserRepository(ctrl)
+		refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+		sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+		srv, err := NewService(userRepo, refreshTokenRepo, sessionRepo)
+		require.NoError(t, err)
+
+		before := time.Now()
+		var updateInput *repository.UpdateUserInput
+		gomock.InOrder(
+			userRepo.EXPECT().Update(gomock.Any(), user.ID, gomock.Any()).DoAndReturn(func(_ context.Context, _ int, input *repository.UpdateUserInput) error {
+				updateInput = input
+				return nil
+			}),
+			refreshTokenRepo.EXPECT().RevokeAll(gomock.Any(), strconv.Itoa(user.ID), gomock.Any()).Return(nil),
+			sessionRepo.EXPECT().RevokeAll(gomock.Any(), strconv.Itoa(user.ID), gomock.Any()).Return(3, nil),
+		)
+		got, err := srv.InvalidateTokens(ctx, &InvalidateTokensInput{User: user})
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		require.Equal(t, 3, got.RevokedSessionCount)
+		require.Equal(t, &repository.UpdateUserInput{TokensValidAfter: got.User.TokensValidAfter}, updateInput)
+		require.Equal(t, got.User.TokensValidAfter.Truncate(domain.TokenTimePrecision), *got.User.TokensValidAfter)
+		require.True(t, got.User.IsTokenInvalidated(before.Truncate(domain.TokenTimePrecision)))
+		require.False(t, got.User.IsTokenInvalidated(time.Now().Truncate(domain.TokenTimePrecision)))
+		require.Nil(t, user.TokensValidAfter)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := repomocks.NewMockUserRepository(ctrl)
+		refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+		sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+		srv, err := NewService(userRepo, refreshTokenRepo, sessionRepo)
+		require.NoError(t, err)
+
+		got, err := srv.InvalidateTokens(nil, &InvalidateTokensInput{User: user})
+		require.Error(t, err)
+		require.Nil(t, got)
+		got, err = srv.InvalidateTokens(ctx, nil)
+		require.Error(t, err)
+		require.Nil(t, got)
+		got, err = srv.InvalidateTokens(ctx, &InvalidateTokensInput{})
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := repomocks.NewMockUserRepository(ctrl)
+		refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+		sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+		srv, err := NewService(userRepo, refreshTokenRepo, sessionRepo)
+		require.NoError(t, err)
+
+		userRepo.EXPECT().Update(gomock.Any(), user.ID, gomock.Any()).Return(domain.ErrUserNotFound)
+		got, err := srv.InvalidateTokens(ctx, &InvalidateTokensInput{User: user})
+		require.ErrorIs(t, err, domain.ErrUserNotFound)
+		require.Nil(t, got)
+	})
+
+	t.Run("세션 폐기 실패", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := repomocks.NewMockUserRepository(ctrl)
+		refreshTokenRepo := repomocks.NewMockRefreshTokenRepository(ctrl)
+		sessionRepo := repomocks.NewMockSessionRepository(ctrl)
+		srv, err := NewService(userRepo, refreshTokenRepo, sessionRepo)
+		require.NoError(t, err)
+
+		userRepo.EXPECT().Update(gomock.Any(), user.ID, gomock.Any()).Return(nil)
+		refreshTokenRepo.EXPECT().RevokeAll(gomock.Any(), strconv.Itoa(user.ID), gomock.Any()).Return(nil)
+		sessionRepo.EXPECT().RevokeAll(gomock.Any(), strconv.Itoa(user.ID), gomock.Any()).Return(0, gofakeit.Error())
+		got, err := srv.InvalidateTokens(ctx, &InvalidateTokensInput{User: user})
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+}